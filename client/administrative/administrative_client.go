@@ -34,16 +34,24 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	DeleteQuotasProjectID(params *DeleteQuotasProjectIDParams, opts ...ClientOption) (*DeleteQuotasProjectIDNoContent, error)
 
+	GetAgents(params *GetAgentsParams, opts ...ClientOption) (*GetAgentsOK, error)
+
 	GetCidrBlocks(params *GetCidrBlocksParams, opts ...ClientOption) (*GetCidrBlocksOK, error)
 
+	GetF5Diff(params *GetF5DiffParams, opts ...ClientOption) (*GetF5DiffOK, error)
+
 	GetQuotas(params *GetQuotasParams, opts ...ClientOption) (*GetQuotasOK, error)
 
 	GetQuotasDefaults(params *GetQuotasDefaultsParams, opts ...ClientOption) (*GetQuotasDefaultsOK, error)
 
 	GetQuotasProjectID(params *GetQuotasProjectIDParams, opts ...ClientOption) (*GetQuotasProjectIDOK, error)
 
+	GetReset(params *GetResetParams, opts ...ClientOption) (*GetResetOK, error)
+
 	GetServices(params *GetServicesParams, opts ...ClientOption) (*GetServicesOK, error)
 
+	PostResetResourceTypeResourceID(params *PostResetResourceTypeResourceIDParams, opts ...ClientOption) (*PostResetResourceTypeResourceIDAccepted, error)
+
 	PostSync(params *PostSyncParams, opts ...ClientOption) (*PostSyncAccepted, error)
 
 	PutQuotasProjectID(params *PutQuotasProjectIDParams, opts ...ClientOption) (*PutQuotasProjectIDAccepted, error)
@@ -88,6 +96,47 @@ func (a *Client) DeleteQuotasProjectID(params *DeleteQuotasProjectIDParams, opts
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+	GetAgents lists agents
+
+	Lists the agents registered by their heartbeats. Agents without heartbeat within the agent TTL, or disabled
+
+by an administrator, are STALE.
+*/
+func (a *Client) GetAgents(params *GetAgentsParams, opts ...ClientOption) (*GetAgentsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAgentsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetAgents",
+		Method:             "GET",
+		PathPattern:        "/agents",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAgentsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAgentsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetAgentsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetCidrBlocks lists c ID r blocks of a service
 */
@@ -125,6 +174,47 @@ func (a *Client) GetCidrBlocks(params *GetCidrBlocksParams, opts ...ClientOption
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+	GetF5Diff previews the f5 a s3 declaration
+
+	Builds the AS3 declaration of all F5 domains (dry-run) and returns its difference to the declaration
+
+currently deployed on the active F5 device per tenant and application. Nothing is applied.
+*/
+func (a *Client) GetF5Diff(params *GetF5DiffParams, opts ...ClientOption) (*GetF5DiffOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetF5DiffParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetF5Diff",
+		Method:             "GET",
+		PathPattern:        "/f5/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetF5DiffReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetF5DiffOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetF5DiffDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetQuotas lists quotas
 */
@@ -236,6 +326,45 @@ func (a *Client) GetQuotasProjectID(params *GetQuotasProjectIDParams, opts ...Cl
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+GetReset lists stuck resources
+
+Lists resources in a pending provisioning status or in ERROR which have not been updated within the threshold.
+*/
+func (a *Client) GetReset(params *GetResetParams, opts ...ClientOption) (*GetResetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetResetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetReset",
+		Method:             "GET",
+		PathPattern:        "/reset",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetResetReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetResetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetResetDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetServices lists services
 */
@@ -274,7 +403,53 @@ func (a *Client) GetServices(params *GetServicesParams, opts ...ClientOption) (*
 }
 
 /*
-PostSync enqueues a full sync
+	PostResetResourceTypeResourceID resets the provisioning status of a resource
+
+	Requeues the resource for provisioning (ERROR resources become PENDING_UPDATE), or forces the provisioning
+
+status to ACTIVE or ERROR.
+*/
+func (a *Client) PostResetResourceTypeResourceID(params *PostResetResourceTypeResourceIDParams, opts ...ClientOption) (*PostResetResourceTypeResourceIDAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostResetResourceTypeResourceIDParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostResetResourceTypeResourceID",
+		Method:             "POST",
+		PathPattern:        "/reset/{resource_type}/{resource_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostResetResourceTypeResourceIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostResetResourceTypeResourceIDAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostResetResourceTypeResourceIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+	PostSync syncs domains
+
+	Requests a sync of the given domains from the agents of their providers, a full sync is requested if no
+
+domains are given. The F5 agent replies once the sync is completed, errors are returned to the caller.
+Unknown domains are rejected.
 */
 func (a *Client) PostSync(params *PostSyncParams, opts ...ClientOption) (*PostSyncAccepted, error) {
 	// TODO: Validate the params before sending
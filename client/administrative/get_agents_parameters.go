@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetAgentsParams creates a new GetAgentsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetAgentsParams() *GetAgentsParams {
+	return &GetAgentsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetAgentsParamsWithTimeout creates a new GetAgentsParams object
+// with the ability to set a timeout on a request.
+func NewGetAgentsParamsWithTimeout(timeout time.Duration) *GetAgentsParams {
+	return &GetAgentsParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetAgentsParamsWithContext creates a new GetAgentsParams object
+// with the ability to set a context for a request.
+func NewGetAgentsParamsWithContext(ctx context.Context) *GetAgentsParams {
+	return &GetAgentsParams{
+		Context: ctx,
+	}
+}
+
+// NewGetAgentsParamsWithHTTPClient creates a new GetAgentsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetAgentsParamsWithHTTPClient(client *http.Client) *GetAgentsParams {
+	return &GetAgentsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetAgentsParams contains all the parameters to send to the API endpoint
+
+	for the get agents operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetAgentsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get agents params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAgentsParams) WithDefaults() *GetAgentsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get agents params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAgentsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get agents params
+func (o *GetAgentsParams) WithTimeout(timeout time.Duration) *GetAgentsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get agents params
+func (o *GetAgentsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get agents params
+func (o *GetAgentsParams) WithContext(ctx context.Context) *GetAgentsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get agents params
+func (o *GetAgentsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get agents params
+func (o *GetAgentsParams) WithHTTPClient(client *http.Client) *GetAgentsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get agents params
+func (o *GetAgentsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetAgentsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
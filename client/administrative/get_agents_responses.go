@@ -0,0 +1,292 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetAgentsReader is a Reader for the GetAgents structure.
+type GetAgentsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetAgentsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetAgentsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetAgentsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetAgentsOK creates a GetAgentsOK with default headers values
+func NewGetAgentsOK() *GetAgentsOK {
+	return &GetAgentsOK{}
+}
+
+/*
+GetAgentsOK describes a response with status code 200, with default header values.
+
+A JSON array of agents
+*/
+type GetAgentsOK struct {
+	Payload *GetAgentsOKBody
+}
+
+// IsSuccess returns true when this get agents o k response has a 2xx status code
+func (o *GetAgentsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get agents o k response has a 3xx status code
+func (o *GetAgentsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get agents o k response has a 4xx status code
+func (o *GetAgentsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get agents o k response has a 5xx status code
+func (o *GetAgentsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get agents o k response a status code equal to that given
+func (o *GetAgentsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get agents o k response
+func (o *GetAgentsOK) Code() int {
+	return 200
+}
+
+func (o *GetAgentsOK) Error() string {
+	return fmt.Sprintf("[GET /agents][%d] getAgentsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetAgentsOK) String() string {
+	return fmt.Sprintf("[GET /agents][%d] getAgentsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetAgentsOK) GetPayload() *GetAgentsOKBody {
+	return o.Payload
+}
+
+func (o *GetAgentsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(GetAgentsOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAgentsDefault creates a GetAgentsDefault with default headers values
+func NewGetAgentsDefault(code int) *GetAgentsDefault {
+	return &GetAgentsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetAgentsDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type GetAgentsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get agents default response has a 2xx status code
+func (o *GetAgentsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get agents default response has a 3xx status code
+func (o *GetAgentsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get agents default response has a 4xx status code
+func (o *GetAgentsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get agents default response has a 5xx status code
+func (o *GetAgentsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get agents default response a status code equal to that given
+func (o *GetAgentsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get agents default response
+func (o *GetAgentsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetAgentsDefault) Error() string {
+	return fmt.Sprintf("[GET /agents][%d] GetAgents default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetAgentsDefault) String() string {
+	return fmt.Sprintf("[GET /agents][%d] GetAgents default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetAgentsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetAgentsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+GetAgentsOKBody get agents o k body
+swagger:model GetAgentsOKBody
+*/
+type GetAgentsOKBody struct {
+
+	// agents
+	Agents []*models.Agent `json:"agents"`
+}
+
+// Validate validates this get agents o k body
+func (o *GetAgentsOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAgents(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAgentsOKBody) validateAgents(formats strfmt.Registry) error {
+	if swag.IsZero(o.Agents) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Agents); i++ {
+		if swag.IsZero(o.Agents[i]) { // not required
+			continue
+		}
+
+		if o.Agents[i] != nil {
+			if err := o.Agents[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getAgentsOK" + "." + "agents" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("getAgentsOK" + "." + "agents" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get agents o k body based on the context it is used
+func (o *GetAgentsOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateAgents(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAgentsOKBody) contextValidateAgents(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Agents); i++ {
+
+		if o.Agents[i] != nil {
+			if err := o.Agents[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getAgentsOK" + "." + "agents" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("getAgentsOK" + "." + "agents" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetAgentsOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetAgentsOKBody) UnmarshalBinary(b []byte) error {
+	var res GetAgentsOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetF5DiffParams creates a new GetF5DiffParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetF5DiffParams() *GetF5DiffParams {
+	return &GetF5DiffParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetF5DiffParamsWithTimeout creates a new GetF5DiffParams object
+// with the ability to set a timeout on a request.
+func NewGetF5DiffParamsWithTimeout(timeout time.Duration) *GetF5DiffParams {
+	return &GetF5DiffParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetF5DiffParamsWithContext creates a new GetF5DiffParams object
+// with the ability to set a context for a request.
+func NewGetF5DiffParamsWithContext(ctx context.Context) *GetF5DiffParams {
+	return &GetF5DiffParams{
+		Context: ctx,
+	}
+}
+
+// NewGetF5DiffParamsWithHTTPClient creates a new GetF5DiffParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetF5DiffParamsWithHTTPClient(client *http.Client) *GetF5DiffParams {
+	return &GetF5DiffParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetF5DiffParams contains all the parameters to send to the API endpoint
+
+	for the get f5 diff operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetF5DiffParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get f5 diff params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetF5DiffParams) WithDefaults() *GetF5DiffParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get f5 diff params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetF5DiffParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get f5 diff params
+func (o *GetF5DiffParams) WithTimeout(timeout time.Duration) *GetF5DiffParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get f5 diff params
+func (o *GetF5DiffParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get f5 diff params
+func (o *GetF5DiffParams) WithContext(ctx context.Context) *GetF5DiffParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get f5 diff params
+func (o *GetF5DiffParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get f5 diff params
+func (o *GetF5DiffParams) WithHTTPClient(client *http.Client) *GetF5DiffParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get f5 diff params
+func (o *GetF5DiffParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetF5DiffParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetF5DiffReader is a Reader for the GetF5Diff structure.
+type GetF5DiffReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetF5DiffReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetF5DiffOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetF5DiffDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetF5DiffOK creates a GetF5DiffOK with default headers values
+func NewGetF5DiffOK() *GetF5DiffOK {
+	return &GetF5DiffOK{}
+}
+
+/*
+GetF5DiffOK describes a response with status code 200, with default header values.
+
+Difference between the deployed and the desired AS3 declaration.
+*/
+type GetF5DiffOK struct {
+	Payload *models.DeclarationDiff
+}
+
+// IsSuccess returns true when this get f5 diff o k response has a 2xx status code
+func (o *GetF5DiffOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get f5 diff o k response has a 3xx status code
+func (o *GetF5DiffOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get f5 diff o k response has a 4xx status code
+func (o *GetF5DiffOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get f5 diff o k response has a 5xx status code
+func (o *GetF5DiffOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get f5 diff o k response a status code equal to that given
+func (o *GetF5DiffOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get f5 diff o k response
+func (o *GetF5DiffOK) Code() int {
+	return 200
+}
+
+func (o *GetF5DiffOK) Error() string {
+	return fmt.Sprintf("[GET /f5/diff][%d] getF5DiffOK  %+v", 200, o.Payload)
+}
+
+func (o *GetF5DiffOK) String() string {
+	return fmt.Sprintf("[GET /f5/diff][%d] getF5DiffOK  %+v", 200, o.Payload)
+}
+
+func (o *GetF5DiffOK) GetPayload() *models.DeclarationDiff {
+	return o.Payload
+}
+
+func (o *GetF5DiffOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DeclarationDiff)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetF5DiffDefault creates a GetF5DiffDefault with default headers values
+func NewGetF5DiffDefault(code int) *GetF5DiffDefault {
+	return &GetF5DiffDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetF5DiffDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type GetF5DiffDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get f5 diff default response has a 2xx status code
+func (o *GetF5DiffDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get f5 diff default response has a 3xx status code
+func (o *GetF5DiffDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get f5 diff default response has a 4xx status code
+func (o *GetF5DiffDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get f5 diff default response has a 5xx status code
+func (o *GetF5DiffDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get f5 diff default response a status code equal to that given
+func (o *GetF5DiffDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get f5 diff default response
+func (o *GetF5DiffDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetF5DiffDefault) Error() string {
+	return fmt.Sprintf("[GET /f5/diff][%d] GetF5Diff default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetF5DiffDefault) String() string {
+	return fmt.Sprintf("[GET /f5/diff][%d] GetF5Diff default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetF5DiffDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetF5DiffDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetResetParams creates a new GetResetParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetResetParams() *GetResetParams {
+	return &GetResetParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetResetParamsWithTimeout creates a new GetResetParams object
+// with the ability to set a timeout on a request.
+func NewGetResetParamsWithTimeout(timeout time.Duration) *GetResetParams {
+	return &GetResetParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetResetParamsWithContext creates a new GetResetParams object
+// with the ability to set a context for a request.
+func NewGetResetParamsWithContext(ctx context.Context) *GetResetParams {
+	return &GetResetParams{
+		Context: ctx,
+	}
+}
+
+// NewGetResetParamsWithHTTPClient creates a new GetResetParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetResetParamsWithHTTPClient(client *http.Client) *GetResetParams {
+	return &GetResetParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetResetParams contains all the parameters to send to the API endpoint
+
+	for the get reset operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetResetParams struct {
+
+	/* OlderThan.
+
+	   Threshold in seconds since the last update of the resource.
+
+	   Default: 3600
+	*/
+	OlderThan *int64
+
+	/* ResourceType.
+
+	   Only list resources of this type.
+	*/
+	ResourceType *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get reset params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetResetParams) WithDefaults() *GetResetParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get reset params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetResetParams) SetDefaults() {
+	var (
+		olderThanDefault = int64(3600)
+	)
+
+	val := GetResetParams{
+		OlderThan: &olderThanDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the get reset params
+func (o *GetResetParams) WithTimeout(timeout time.Duration) *GetResetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get reset params
+func (o *GetResetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get reset params
+func (o *GetResetParams) WithContext(ctx context.Context) *GetResetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get reset params
+func (o *GetResetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get reset params
+func (o *GetResetParams) WithHTTPClient(client *http.Client) *GetResetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get reset params
+func (o *GetResetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithOlderThan adds the olderThan to the get reset params
+func (o *GetResetParams) WithOlderThan(olderThan *int64) *GetResetParams {
+	o.SetOlderThan(olderThan)
+	return o
+}
+
+// SetOlderThan adds the olderThan to the get reset params
+func (o *GetResetParams) SetOlderThan(olderThan *int64) {
+	o.OlderThan = olderThan
+}
+
+// WithResourceType adds the resourceType to the get reset params
+func (o *GetResetParams) WithResourceType(resourceType *string) *GetResetParams {
+	o.SetResourceType(resourceType)
+	return o
+}
+
+// SetResourceType adds the resourceType to the get reset params
+func (o *GetResetParams) SetResourceType(resourceType *string) {
+	o.ResourceType = resourceType
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetResetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.OlderThan != nil {
+
+		// query param older_than
+		var qrOlderThan int64
+
+		if o.OlderThan != nil {
+			qrOlderThan = *o.OlderThan
+		}
+		qOlderThan := swag.FormatInt64(qrOlderThan)
+		if qOlderThan != "" {
+
+			if err := r.SetQueryParam("older_than", qOlderThan); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.ResourceType != nil {
+
+		// query param resource_type
+		var qrResourceType string
+
+		if o.ResourceType != nil {
+			qrResourceType = *o.ResourceType
+		}
+		qResourceType := qrResourceType
+		if qResourceType != "" {
+
+			if err := r.SetQueryParam("resource_type", qResourceType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetResetReader is a Reader for the GetReset structure.
+type GetResetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetResetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetResetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetResetDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetResetOK creates a GetResetOK with default headers values
+func NewGetResetOK() *GetResetOK {
+	return &GetResetOK{}
+}
+
+/*
+GetResetOK describes a response with status code 200, with default header values.
+
+A JSON array of stuck resources.
+*/
+type GetResetOK struct {
+	Payload *GetResetOKBody
+}
+
+// IsSuccess returns true when this get reset o k response has a 2xx status code
+func (o *GetResetOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get reset o k response has a 3xx status code
+func (o *GetResetOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get reset o k response has a 4xx status code
+func (o *GetResetOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get reset o k response has a 5xx status code
+func (o *GetResetOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get reset o k response a status code equal to that given
+func (o *GetResetOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get reset o k response
+func (o *GetResetOK) Code() int {
+	return 200
+}
+
+func (o *GetResetOK) Error() string {
+	return fmt.Sprintf("[GET /reset][%d] getResetOK  %+v", 200, o.Payload)
+}
+
+func (o *GetResetOK) String() string {
+	return fmt.Sprintf("[GET /reset][%d] getResetOK  %+v", 200, o.Payload)
+}
+
+func (o *GetResetOK) GetPayload() *GetResetOKBody {
+	return o.Payload
+}
+
+func (o *GetResetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(GetResetOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetResetDefault creates a GetResetDefault with default headers values
+func NewGetResetDefault(code int) *GetResetDefault {
+	return &GetResetDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetResetDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type GetResetDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get reset default response has a 2xx status code
+func (o *GetResetDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get reset default response has a 3xx status code
+func (o *GetResetDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get reset default response has a 4xx status code
+func (o *GetResetDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get reset default response has a 5xx status code
+func (o *GetResetDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get reset default response a status code equal to that given
+func (o *GetResetDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get reset default response
+func (o *GetResetDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetResetDefault) Error() string {
+	return fmt.Sprintf("[GET /reset][%d] GetReset default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetResetDefault) String() string {
+	return fmt.Sprintf("[GET /reset][%d] GetReset default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetResetDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetResetDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+GetResetOKBody get reset o k body
+swagger:model GetResetOKBody
+*/
+type GetResetOKBody struct {
+
+	// resources
+	Resources []*models.StuckResource `json:"resources"`
+}
+
+// Validate validates this get reset o k body
+func (o *GetResetOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateResources(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetResetOKBody) validateResources(formats strfmt.Registry) error {
+	if swag.IsZero(o.Resources) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Resources); i++ {
+		if swag.IsZero(o.Resources[i]) { // not required
+			continue
+		}
+
+		if o.Resources[i] != nil {
+			if err := o.Resources[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getResetOK" + "." + "resources" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("getResetOK" + "." + "resources" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get reset o k body based on the context it is used
+func (o *GetResetOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateResources(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetResetOKBody) contextValidateResources(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Resources); i++ {
+
+		if o.Resources[i] != nil {
+			if err := o.Resources[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getResetOK" + "." + "resources" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("getResetOK" + "." + "resources" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetResetOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetResetOKBody) UnmarshalBinary(b []byte) error {
+	var res GetResetOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
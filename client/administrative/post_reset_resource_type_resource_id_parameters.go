@@ -0,0 +1,196 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPostResetResourceTypeResourceIDParams creates a new PostResetResourceTypeResourceIDParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPostResetResourceTypeResourceIDParams() *PostResetResourceTypeResourceIDParams {
+	return &PostResetResourceTypeResourceIDParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPostResetResourceTypeResourceIDParamsWithTimeout creates a new PostResetResourceTypeResourceIDParams object
+// with the ability to set a timeout on a request.
+func NewPostResetResourceTypeResourceIDParamsWithTimeout(timeout time.Duration) *PostResetResourceTypeResourceIDParams {
+	return &PostResetResourceTypeResourceIDParams{
+		timeout: timeout,
+	}
+}
+
+// NewPostResetResourceTypeResourceIDParamsWithContext creates a new PostResetResourceTypeResourceIDParams object
+// with the ability to set a context for a request.
+func NewPostResetResourceTypeResourceIDParamsWithContext(ctx context.Context) *PostResetResourceTypeResourceIDParams {
+	return &PostResetResourceTypeResourceIDParams{
+		Context: ctx,
+	}
+}
+
+// NewPostResetResourceTypeResourceIDParamsWithHTTPClient creates a new PostResetResourceTypeResourceIDParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPostResetResourceTypeResourceIDParamsWithHTTPClient(client *http.Client) *PostResetResourceTypeResourceIDParams {
+	return &PostResetResourceTypeResourceIDParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PostResetResourceTypeResourceIDParams contains all the parameters to send to the API endpoint
+
+	for the post reset resource type resource ID operation.
+
+	Typically these are written to a http.Request.
+*/
+type PostResetResourceTypeResourceIDParams struct {
+
+	// Reset.
+	Reset PostResetResourceTypeResourceIDBody
+
+	/* ResourceID.
+
+	   The UUID of the resource
+
+	   Format: uuid
+	*/
+	ResourceID strfmt.UUID
+
+	/* ResourceType.
+
+	   The type of the resource
+	*/
+	ResourceType string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the post reset resource type resource ID params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostResetResourceTypeResourceIDParams) WithDefaults() *PostResetResourceTypeResourceIDParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the post reset resource type resource ID params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostResetResourceTypeResourceIDParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the post reset resource type resource ID params
+func (o *PostResetResourceTypeResourceIDParams) WithTimeout(timeout time.Duration) *PostResetResourceTypeResourceIDParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the post reset resource type resource ID params
+func (o *PostResetResourceTypeResourceIDParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the post reset resource type resource ID params
+func (o *PostResetResourceTypeResourceIDParams) WithContext(ctx context.Context) *PostResetResourceTypeResourceIDParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the post reset resource type resource ID params
+func (o *PostResetResourceTypeResourceIDParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the post reset resource type resource ID params
+func (o *PostResetResourceTypeResourceIDParams) WithHTTPClient(client *http.Client) *PostResetResourceTypeResourceIDParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the post reset resource type resource ID params
+func (o *PostResetResourceTypeResourceIDParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithReset adds the reset to the post reset resource type resource ID params
+func (o *PostResetResourceTypeResourceIDParams) WithReset(reset PostResetResourceTypeResourceIDBody) *PostResetResourceTypeResourceIDParams {
+	o.SetReset(reset)
+	return o
+}
+
+// SetReset adds the reset to the post reset resource type resource ID params
+func (o *PostResetResourceTypeResourceIDParams) SetReset(reset PostResetResourceTypeResourceIDBody) {
+	o.Reset = reset
+}
+
+// WithResourceID adds the resourceID to the post reset resource type resource ID params
+func (o *PostResetResourceTypeResourceIDParams) WithResourceID(resourceID strfmt.UUID) *PostResetResourceTypeResourceIDParams {
+	o.SetResourceID(resourceID)
+	return o
+}
+
+// SetResourceID adds the resourceId to the post reset resource type resource ID params
+func (o *PostResetResourceTypeResourceIDParams) SetResourceID(resourceID strfmt.UUID) {
+	o.ResourceID = resourceID
+}
+
+// WithResourceType adds the resourceType to the post reset resource type resource ID params
+func (o *PostResetResourceTypeResourceIDParams) WithResourceType(resourceType string) *PostResetResourceTypeResourceIDParams {
+	o.SetResourceType(resourceType)
+	return o
+}
+
+// SetResourceType adds the resourceType to the post reset resource type resource ID params
+func (o *PostResetResourceTypeResourceIDParams) SetResourceType(resourceType string) {
+	o.ResourceType = resourceType
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PostResetResourceTypeResourceIDParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Reset); err != nil {
+		return err
+	}
+
+	// path param resource_id
+	if err := r.SetPathParam("resource_id", o.ResourceID.String()); err != nil {
+		return err
+	}
+
+	// path param resource_type
+	if err := r.SetPathParam("resource_type", o.ResourceType); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
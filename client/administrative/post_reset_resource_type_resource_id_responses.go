@@ -0,0 +1,471 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostResetResourceTypeResourceIDReader is a Reader for the PostResetResourceTypeResourceID structure.
+type PostResetResourceTypeResourceIDReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostResetResourceTypeResourceIDReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewPostResetResourceTypeResourceIDAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewPostResetResourceTypeResourceIDNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPostResetResourceTypeResourceIDDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostResetResourceTypeResourceIDAccepted creates a PostResetResourceTypeResourceIDAccepted with default headers values
+func NewPostResetResourceTypeResourceIDAccepted() *PostResetResourceTypeResourceIDAccepted {
+	return &PostResetResourceTypeResourceIDAccepted{}
+}
+
+/*
+PostResetResourceTypeResourceIDAccepted describes a response with status code 202, with default header values.
+
+The resource has been reset.
+*/
+type PostResetResourceTypeResourceIDAccepted struct {
+	Payload *PostResetResourceTypeResourceIDAcceptedBody
+}
+
+// IsSuccess returns true when this post reset resource type resource Id accepted response has a 2xx status code
+func (o *PostResetResourceTypeResourceIDAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this post reset resource type resource Id accepted response has a 3xx status code
+func (o *PostResetResourceTypeResourceIDAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post reset resource type resource Id accepted response has a 4xx status code
+func (o *PostResetResourceTypeResourceIDAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post reset resource type resource Id accepted response has a 5xx status code
+func (o *PostResetResourceTypeResourceIDAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post reset resource type resource Id accepted response a status code equal to that given
+func (o *PostResetResourceTypeResourceIDAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the post reset resource type resource Id accepted response
+func (o *PostResetResourceTypeResourceIDAccepted) Code() int {
+	return 202
+}
+
+func (o *PostResetResourceTypeResourceIDAccepted) Error() string {
+	return fmt.Sprintf("[POST /reset/{resource_type}/{resource_id}][%d] postResetResourceTypeResourceIdAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostResetResourceTypeResourceIDAccepted) String() string {
+	return fmt.Sprintf("[POST /reset/{resource_type}/{resource_id}][%d] postResetResourceTypeResourceIdAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostResetResourceTypeResourceIDAccepted) GetPayload() *PostResetResourceTypeResourceIDAcceptedBody {
+	return o.Payload
+}
+
+func (o *PostResetResourceTypeResourceIDAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(PostResetResourceTypeResourceIDAcceptedBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostResetResourceTypeResourceIDNotFound creates a PostResetResourceTypeResourceIDNotFound with default headers values
+func NewPostResetResourceTypeResourceIDNotFound() *PostResetResourceTypeResourceIDNotFound {
+	return &PostResetResourceTypeResourceIDNotFound{}
+}
+
+/*
+PostResetResourceTypeResourceIDNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PostResetResourceTypeResourceIDNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post reset resource type resource Id not found response has a 2xx status code
+func (o *PostResetResourceTypeResourceIDNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post reset resource type resource Id not found response has a 3xx status code
+func (o *PostResetResourceTypeResourceIDNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post reset resource type resource Id not found response has a 4xx status code
+func (o *PostResetResourceTypeResourceIDNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post reset resource type resource Id not found response has a 5xx status code
+func (o *PostResetResourceTypeResourceIDNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post reset resource type resource Id not found response a status code equal to that given
+func (o *PostResetResourceTypeResourceIDNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the post reset resource type resource Id not found response
+func (o *PostResetResourceTypeResourceIDNotFound) Code() int {
+	return 404
+}
+
+func (o *PostResetResourceTypeResourceIDNotFound) Error() string {
+	return fmt.Sprintf("[POST /reset/{resource_type}/{resource_id}][%d] postResetResourceTypeResourceIdNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostResetResourceTypeResourceIDNotFound) String() string {
+	return fmt.Sprintf("[POST /reset/{resource_type}/{resource_id}][%d] postResetResourceTypeResourceIdNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostResetResourceTypeResourceIDNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostResetResourceTypeResourceIDNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostResetResourceTypeResourceIDDefault creates a PostResetResourceTypeResourceIDDefault with default headers values
+func NewPostResetResourceTypeResourceIDDefault(code int) *PostResetResourceTypeResourceIDDefault {
+	return &PostResetResourceTypeResourceIDDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+PostResetResourceTypeResourceIDDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type PostResetResourceTypeResourceIDDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post reset resource type resource ID default response has a 2xx status code
+func (o *PostResetResourceTypeResourceIDDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this post reset resource type resource ID default response has a 3xx status code
+func (o *PostResetResourceTypeResourceIDDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this post reset resource type resource ID default response has a 4xx status code
+func (o *PostResetResourceTypeResourceIDDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this post reset resource type resource ID default response has a 5xx status code
+func (o *PostResetResourceTypeResourceIDDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this post reset resource type resource ID default response a status code equal to that given
+func (o *PostResetResourceTypeResourceIDDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the post reset resource type resource ID default response
+func (o *PostResetResourceTypeResourceIDDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostResetResourceTypeResourceIDDefault) Error() string {
+	return fmt.Sprintf("[POST /reset/{resource_type}/{resource_id}][%d] PostResetResourceTypeResourceID default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostResetResourceTypeResourceIDDefault) String() string {
+	return fmt.Sprintf("[POST /reset/{resource_type}/{resource_id}][%d] PostResetResourceTypeResourceID default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostResetResourceTypeResourceIDDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostResetResourceTypeResourceIDDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+PostResetResourceTypeResourceIDAcceptedBody post reset resource type resource ID accepted body
+swagger:model PostResetResourceTypeResourceIDAcceptedBody
+*/
+type PostResetResourceTypeResourceIDAcceptedBody struct {
+
+	// resource
+	Resource *models.StuckResource `json:"resource,omitempty"`
+}
+
+// Validate validates this post reset resource type resource ID accepted body
+func (o *PostResetResourceTypeResourceIDAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateResource(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostResetResourceTypeResourceIDAcceptedBody) validateResource(formats strfmt.Registry) error {
+	if swag.IsZero(o.Resource) { // not required
+		return nil
+	}
+
+	if o.Resource != nil {
+		if err := o.Resource.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postResetResourceTypeResourceIdAccepted" + "." + "resource")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postResetResourceTypeResourceIdAccepted" + "." + "resource")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post reset resource type resource ID accepted body based on the context it is used
+func (o *PostResetResourceTypeResourceIDAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateResource(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostResetResourceTypeResourceIDAcceptedBody) contextValidateResource(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Resource != nil {
+		if err := o.Resource.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postResetResourceTypeResourceIdAccepted" + "." + "resource")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postResetResourceTypeResourceIdAccepted" + "." + "resource")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostResetResourceTypeResourceIDAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostResetResourceTypeResourceIDAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostResetResourceTypeResourceIDAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+PostResetResourceTypeResourceIDBody post reset resource type resource ID body
+swagger:model PostResetResourceTypeResourceIDBody
+*/
+type PostResetResourceTypeResourceIDBody struct {
+
+	// The reset action.
+	// Required: true
+	// Enum: [requeue active error]
+	Action *string `json:"action"`
+
+	// The provisioning error recorded for action error.
+	// Max Length: 255
+	Reason string `json:"reason,omitempty"`
+}
+
+// Validate validates this post reset resource type resource ID body
+func (o *PostResetResourceTypeResourceIDBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateReason(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var postResetResourceTypeResourceIdBodyTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["requeue","active","error"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		postResetResourceTypeResourceIdBodyTypeActionPropEnum = append(postResetResourceTypeResourceIdBodyTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// PostResetResourceTypeResourceIDBodyActionRequeue captures enum value "requeue"
+	PostResetResourceTypeResourceIDBodyActionRequeue string = "requeue"
+
+	// PostResetResourceTypeResourceIDBodyActionActive captures enum value "active"
+	PostResetResourceTypeResourceIDBodyActionActive string = "active"
+
+	// PostResetResourceTypeResourceIDBodyActionError captures enum value "error"
+	PostResetResourceTypeResourceIDBodyActionError string = "error"
+)
+
+// prop value enum
+func (o *PostResetResourceTypeResourceIDBody) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, postResetResourceTypeResourceIdBodyTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *PostResetResourceTypeResourceIDBody) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("reset"+"."+"action", "body", o.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := o.validateActionEnum("reset"+"."+"action", "body", *o.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *PostResetResourceTypeResourceIDBody) validateReason(formats strfmt.Registry) error {
+	if swag.IsZero(o.Reason) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("reset"+"."+"reason", "body", o.Reason, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post reset resource type resource ID body based on context it is used
+func (o *PostResetResourceTypeResourceIDBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostResetResourceTypeResourceIDBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostResetResourceTypeResourceIDBody) UnmarshalBinary(b []byte) error {
+	var res PostResetResourceTypeResourceIDBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
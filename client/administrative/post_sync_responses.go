@@ -58,7 +58,7 @@ func NewPostSyncAccepted() *PostSyncAccepted {
 /*
 PostSyncAccepted describes a response with status code 202, with default header values.
 
-Full sync has been enqueued.
+Sync has been enqueued or completed.
 */
 type PostSyncAccepted struct {
 }
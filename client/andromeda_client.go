@@ -15,6 +15,7 @@ import (
 	"github.com/go-openapi/strfmt"
 
 	"github.com/sapcc/andromeda/client/administrative"
+	"github.com/sapcc/andromeda/client/configuration"
 	"github.com/sapcc/andromeda/client/datacenters"
 	"github.com/sapcc/andromeda/client/domains"
 	"github.com/sapcc/andromeda/client/geographic_maps"
@@ -66,6 +67,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Andromeda
 	cli := new(Andromeda)
 	cli.Transport = transport
 	cli.Administrative = administrative.New(transport, formats)
+	cli.Configuration = configuration.New(transport, formats)
 	cli.Datacenters = datacenters.New(transport, formats)
 	cli.Domains = domains.New(transport, formats)
 	cli.GeographicMaps = geographic_maps.New(transport, formats)
@@ -118,6 +120,8 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 type Andromeda struct {
 	Administrative administrative.ClientService
 
+	Configuration configuration.ClientService
+
 	Datacenters datacenters.ClientService
 
 	Domains domains.ClientService
@@ -137,6 +141,7 @@ type Andromeda struct {
 func (c *Andromeda) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
 	c.Administrative.SetTransport(transport)
+	c.Configuration.SetTransport(transport)
 	c.Datacenters.SetTransport(transport)
 	c.Domains.SetTransport(transport)
 	c.GeographicMaps.SetTransport(transport)
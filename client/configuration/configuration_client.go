@@ -0,0 +1,123 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new configuration API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for configuration API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	GetExport(params *GetExportParams, opts ...ClientOption) (*GetExportOK, error)
+
+	PostImport(params *PostImportParams, opts ...ClientOption) (*PostImportOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+GetExport exports the configuration of a project
+
+Serialises all domains, pools, members, monitors, datacenters and geographic maps of a project into one versioned document. Resources reference each other by their ids within the document, public datacenters used by the project are included.
+*/
+func (a *Client) GetExport(params *GetExportParams, opts ...ClientOption) (*GetExportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetExportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetExport",
+		Method:             "GET",
+		PathPattern:        "/export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetExportReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetExportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetExportDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+PostImport imports the configuration of a project
+
+Creates or updates the resources of an exported configuration document in a project. Domains are matched by fqdn and provider, members by address and port within their pool, all other resources by name or, if unnamed, by their id. Public datacenters are referenced instead of copied if they exist.
+*/
+func (a *Client) PostImport(params *PostImportParams, opts ...ClientOption) (*PostImportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostImportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostImport",
+		Method:             "POST",
+		PathPattern:        "/import",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostImportReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostImportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostImportDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
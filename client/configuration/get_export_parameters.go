@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetExportParams creates a new GetExportParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetExportParams() *GetExportParams {
+	return &GetExportParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetExportParamsWithTimeout creates a new GetExportParams object
+// with the ability to set a timeout on a request.
+func NewGetExportParamsWithTimeout(timeout time.Duration) *GetExportParams {
+	return &GetExportParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetExportParamsWithContext creates a new GetExportParams object
+// with the ability to set a context for a request.
+func NewGetExportParamsWithContext(ctx context.Context) *GetExportParams {
+	return &GetExportParams{
+		Context: ctx,
+	}
+}
+
+// NewGetExportParamsWithHTTPClient creates a new GetExportParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetExportParamsWithHTTPClient(client *http.Client) *GetExportParams {
+	return &GetExportParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetExportParams contains all the parameters to send to the API endpoint
+
+	for the get export operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetExportParams struct {
+
+	/* ProjectID.
+
+	   Project to export, defaults to the project of the token.
+	*/
+	ProjectID *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get export params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetExportParams) WithDefaults() *GetExportParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get export params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetExportParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get export params
+func (o *GetExportParams) WithTimeout(timeout time.Duration) *GetExportParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get export params
+func (o *GetExportParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get export params
+func (o *GetExportParams) WithContext(ctx context.Context) *GetExportParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get export params
+func (o *GetExportParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get export params
+func (o *GetExportParams) WithHTTPClient(client *http.Client) *GetExportParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get export params
+func (o *GetExportParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithProjectID adds the projectID to the get export params
+func (o *GetExportParams) WithProjectID(projectID *string) *GetExportParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the get export params
+func (o *GetExportParams) SetProjectID(projectID *string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetExportParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.ProjectID != nil {
+
+		// query param project_id
+		var qrProjectID string
+
+		if o.ProjectID != nil {
+			qrProjectID = *o.ProjectID
+		}
+		qProjectID := qrProjectID
+		if qProjectID != "" {
+
+			if err := r.SetQueryParam("project_id", qProjectID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
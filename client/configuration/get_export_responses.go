@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetExportReader is a Reader for the GetExport structure.
+type GetExportReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetExportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetExportOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetExportDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetExportOK creates a GetExportOK with default headers values
+func NewGetExportOK() *GetExportOK {
+	return &GetExportOK{}
+}
+
+/*
+GetExportOK describes a response with status code 200, with default header values.
+
+The configuration of the project.
+*/
+type GetExportOK struct {
+	Payload *models.Configuration
+}
+
+// IsSuccess returns true when this get export o k response has a 2xx status code
+func (o *GetExportOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get export o k response has a 3xx status code
+func (o *GetExportOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get export o k response has a 4xx status code
+func (o *GetExportOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get export o k response has a 5xx status code
+func (o *GetExportOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get export o k response a status code equal to that given
+func (o *GetExportOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get export o k response
+func (o *GetExportOK) Code() int {
+	return 200
+}
+
+func (o *GetExportOK) Error() string {
+	return fmt.Sprintf("[GET /export][%d] getExportOK  %+v", 200, o.Payload)
+}
+
+func (o *GetExportOK) String() string {
+	return fmt.Sprintf("[GET /export][%d] getExportOK  %+v", 200, o.Payload)
+}
+
+func (o *GetExportOK) GetPayload() *models.Configuration {
+	return o.Payload
+}
+
+func (o *GetExportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Configuration)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetExportDefault creates a GetExportDefault with default headers values
+func NewGetExportDefault(code int) *GetExportDefault {
+	return &GetExportDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetExportDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type GetExportDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get export default response has a 2xx status code
+func (o *GetExportDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get export default response has a 3xx status code
+func (o *GetExportDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get export default response has a 4xx status code
+func (o *GetExportDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get export default response has a 5xx status code
+func (o *GetExportDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get export default response a status code equal to that given
+func (o *GetExportDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get export default response
+func (o *GetExportDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetExportDefault) Error() string {
+	return fmt.Sprintf("[GET /export][%d] GetExport default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetExportDefault) String() string {
+	return fmt.Sprintf("[GET /export][%d] GetExport default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetExportDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetExportDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
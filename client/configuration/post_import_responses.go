@@ -0,0 +1,440 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostImportReader is a Reader for the PostImport structure.
+type PostImportReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostImportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPostImportOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPostImportBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewPostImportForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPostImportDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostImportOK creates a PostImportOK with default headers values
+func NewPostImportOK() *PostImportOK {
+	return &PostImportOK{}
+}
+
+/*
+PostImportOK describes a response with status code 200, with default header values.
+
+The resources of the document and what the import did with them.
+*/
+type PostImportOK struct {
+	Payload *PostImportOKBody
+}
+
+// IsSuccess returns true when this post import o k response has a 2xx status code
+func (o *PostImportOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this post import o k response has a 3xx status code
+func (o *PostImportOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post import o k response has a 4xx status code
+func (o *PostImportOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post import o k response has a 5xx status code
+func (o *PostImportOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post import o k response a status code equal to that given
+func (o *PostImportOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the post import o k response
+func (o *PostImportOK) Code() int {
+	return 200
+}
+
+func (o *PostImportOK) Error() string {
+	return fmt.Sprintf("[POST /import][%d] postImportOK  %+v", 200, o.Payload)
+}
+
+func (o *PostImportOK) String() string {
+	return fmt.Sprintf("[POST /import][%d] postImportOK  %+v", 200, o.Payload)
+}
+
+func (o *PostImportOK) GetPayload() *PostImportOKBody {
+	return o.Payload
+}
+
+func (o *PostImportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(PostImportOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostImportBadRequest creates a PostImportBadRequest with default headers values
+func NewPostImportBadRequest() *PostImportBadRequest {
+	return &PostImportBadRequest{}
+}
+
+/*
+PostImportBadRequest describes a response with status code 400, with default header values.
+
+Bad request
+*/
+type PostImportBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post import bad request response has a 2xx status code
+func (o *PostImportBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post import bad request response has a 3xx status code
+func (o *PostImportBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post import bad request response has a 4xx status code
+func (o *PostImportBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post import bad request response has a 5xx status code
+func (o *PostImportBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post import bad request response a status code equal to that given
+func (o *PostImportBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the post import bad request response
+func (o *PostImportBadRequest) Code() int {
+	return 400
+}
+
+func (o *PostImportBadRequest) Error() string {
+	return fmt.Sprintf("[POST /import][%d] postImportBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PostImportBadRequest) String() string {
+	return fmt.Sprintf("[POST /import][%d] postImportBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PostImportBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostImportBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostImportForbidden creates a PostImportForbidden with default headers values
+func NewPostImportForbidden() *PostImportForbidden {
+	return &PostImportForbidden{}
+}
+
+/*
+PostImportForbidden describes a response with status code 403, with default header values.
+
+Quota exceeded
+*/
+type PostImportForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post import forbidden response has a 2xx status code
+func (o *PostImportForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post import forbidden response has a 3xx status code
+func (o *PostImportForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post import forbidden response has a 4xx status code
+func (o *PostImportForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post import forbidden response has a 5xx status code
+func (o *PostImportForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post import forbidden response a status code equal to that given
+func (o *PostImportForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the post import forbidden response
+func (o *PostImportForbidden) Code() int {
+	return 403
+}
+
+func (o *PostImportForbidden) Error() string {
+	return fmt.Sprintf("[POST /import][%d] postImportForbidden  %+v", 403, o.Payload)
+}
+
+func (o *PostImportForbidden) String() string {
+	return fmt.Sprintf("[POST /import][%d] postImportForbidden  %+v", 403, o.Payload)
+}
+
+func (o *PostImportForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostImportForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostImportDefault creates a PostImportDefault with default headers values
+func NewPostImportDefault(code int) *PostImportDefault {
+	return &PostImportDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+PostImportDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type PostImportDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post import default response has a 2xx status code
+func (o *PostImportDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this post import default response has a 3xx status code
+func (o *PostImportDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this post import default response has a 4xx status code
+func (o *PostImportDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this post import default response has a 5xx status code
+func (o *PostImportDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this post import default response a status code equal to that given
+func (o *PostImportDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the post import default response
+func (o *PostImportDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostImportDefault) Error() string {
+	return fmt.Sprintf("[POST /import][%d] PostImport default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostImportDefault) String() string {
+	return fmt.Sprintf("[POST /import][%d] PostImport default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostImportDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostImportDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+PostImportOKBody post import o k body
+swagger:model PostImportOKBody
+*/
+type PostImportOKBody struct {
+
+	// resources
+	Resources []*models.ImportedResource `json:"resources"`
+}
+
+// Validate validates this post import o k body
+func (o *PostImportOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateResources(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostImportOKBody) validateResources(formats strfmt.Registry) error {
+	if swag.IsZero(o.Resources) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Resources); i++ {
+		if swag.IsZero(o.Resources[i]) { // not required
+			continue
+		}
+
+		if o.Resources[i] != nil {
+			if err := o.Resources[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("postImportOK" + "." + "resources" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("postImportOK" + "." + "resources" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post import o k body based on the context it is used
+func (o *PostImportOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateResources(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostImportOKBody) contextValidateResources(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Resources); i++ {
+
+		if o.Resources[i] != nil {
+			if err := o.Resources[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("postImportOK" + "." + "resources" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("postImportOK" + "." + "resources" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostImportOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostImportOKBody) UnmarshalBinary(b []byte) error {
+	var res PostImportOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -40,6 +40,8 @@ type ClientService interface {
 
 	PostDatacenters(params *PostDatacentersParams, opts ...ClientOption) (*PostDatacentersCreated, error)
 
+	PostDatacentersDatacenterIDRetry(params *PostDatacentersDatacenterIDRetryParams, opts ...ClientOption) (*PostDatacentersDatacenterIDRetryAccepted, error)
+
 	PutDatacentersDatacenterID(params *PutDatacentersDatacenterIDParams, opts ...ClientOption) (*PutDatacentersDatacenterIDAccepted, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -193,6 +195,45 @@ func (a *Client) PostDatacenters(params *PostDatacentersParams, opts ...ClientOp
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+PostDatacentersDatacenterIDRetry retries provisioning of a datacenter
+
+Moves a datacenter with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.
+*/
+func (a *Client) PostDatacentersDatacenterIDRetry(params *PostDatacentersDatacenterIDRetryParams, opts ...ClientOption) (*PostDatacentersDatacenterIDRetryAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostDatacentersDatacenterIDRetryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostDatacentersDatacenterIDRetry",
+		Method:             "POST",
+		PathPattern:        "/datacenters/{datacenter_id}/retry",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostDatacentersDatacenterIDRetryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostDatacentersDatacenterIDRetryAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostDatacentersDatacenterIDRetryDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PutDatacentersDatacenterID updates a datacenter
 */
@@ -0,0 +1,157 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datacenters
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPostDatacentersDatacenterIDRetryParams creates a new PostDatacentersDatacenterIDRetryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPostDatacentersDatacenterIDRetryParams() *PostDatacentersDatacenterIDRetryParams {
+	return &PostDatacentersDatacenterIDRetryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPostDatacentersDatacenterIDRetryParamsWithTimeout creates a new PostDatacentersDatacenterIDRetryParams object
+// with the ability to set a timeout on a request.
+func NewPostDatacentersDatacenterIDRetryParamsWithTimeout(timeout time.Duration) *PostDatacentersDatacenterIDRetryParams {
+	return &PostDatacentersDatacenterIDRetryParams{
+		timeout: timeout,
+	}
+}
+
+// NewPostDatacentersDatacenterIDRetryParamsWithContext creates a new PostDatacentersDatacenterIDRetryParams object
+// with the ability to set a context for a request.
+func NewPostDatacentersDatacenterIDRetryParamsWithContext(ctx context.Context) *PostDatacentersDatacenterIDRetryParams {
+	return &PostDatacentersDatacenterIDRetryParams{
+		Context: ctx,
+	}
+}
+
+// NewPostDatacentersDatacenterIDRetryParamsWithHTTPClient creates a new PostDatacentersDatacenterIDRetryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPostDatacentersDatacenterIDRetryParamsWithHTTPClient(client *http.Client) *PostDatacentersDatacenterIDRetryParams {
+	return &PostDatacentersDatacenterIDRetryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PostDatacentersDatacenterIDRetryParams contains all the parameters to send to the API endpoint
+
+	for the post datacenters datacenter ID retry operation.
+
+	Typically these are written to a http.Request.
+*/
+type PostDatacentersDatacenterIDRetryParams struct {
+
+	/* DatacenterID.
+
+	   The UUID of the datacenter
+
+	   Format: uuid
+	*/
+	DatacenterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the post datacenters datacenter ID retry params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostDatacentersDatacenterIDRetryParams) WithDefaults() *PostDatacentersDatacenterIDRetryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the post datacenters datacenter ID retry params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostDatacentersDatacenterIDRetryParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the post datacenters datacenter ID retry params
+func (o *PostDatacentersDatacenterIDRetryParams) WithTimeout(timeout time.Duration) *PostDatacentersDatacenterIDRetryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the post datacenters datacenter ID retry params
+func (o *PostDatacentersDatacenterIDRetryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the post datacenters datacenter ID retry params
+func (o *PostDatacentersDatacenterIDRetryParams) WithContext(ctx context.Context) *PostDatacentersDatacenterIDRetryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the post datacenters datacenter ID retry params
+func (o *PostDatacentersDatacenterIDRetryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the post datacenters datacenter ID retry params
+func (o *PostDatacentersDatacenterIDRetryParams) WithHTTPClient(client *http.Client) *PostDatacentersDatacenterIDRetryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the post datacenters datacenter ID retry params
+func (o *PostDatacentersDatacenterIDRetryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDatacenterID adds the datacenterID to the post datacenters datacenter ID retry params
+func (o *PostDatacentersDatacenterIDRetryParams) WithDatacenterID(datacenterID strfmt.UUID) *PostDatacentersDatacenterIDRetryParams {
+	o.SetDatacenterID(datacenterID)
+	return o
+}
+
+// SetDatacenterID adds the datacenterId to the post datacenters datacenter ID retry params
+func (o *PostDatacentersDatacenterIDRetryParams) SetDatacenterID(datacenterID strfmt.UUID) {
+	o.DatacenterID = datacenterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PostDatacentersDatacenterIDRetryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param datacenter_id
+	if err := r.SetPathParam("datacenter_id", o.DatacenterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,428 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datacenters
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostDatacentersDatacenterIDRetryReader is a Reader for the PostDatacentersDatacenterIDRetry structure.
+type PostDatacentersDatacenterIDRetryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostDatacentersDatacenterIDRetryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewPostDatacentersDatacenterIDRetryAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewPostDatacentersDatacenterIDRetryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewPostDatacentersDatacenterIDRetryConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPostDatacentersDatacenterIDRetryDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostDatacentersDatacenterIDRetryAccepted creates a PostDatacentersDatacenterIDRetryAccepted with default headers values
+func NewPostDatacentersDatacenterIDRetryAccepted() *PostDatacentersDatacenterIDRetryAccepted {
+	return &PostDatacentersDatacenterIDRetryAccepted{}
+}
+
+/*
+PostDatacentersDatacenterIDRetryAccepted describes a response with status code 202, with default header values.
+
+The datacenter will be provisioned again.
+*/
+type PostDatacentersDatacenterIDRetryAccepted struct {
+	Payload *PostDatacentersDatacenterIDRetryAcceptedBody
+}
+
+// IsSuccess returns true when this post datacenters datacenter Id retry accepted response has a 2xx status code
+func (o *PostDatacentersDatacenterIDRetryAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this post datacenters datacenter Id retry accepted response has a 3xx status code
+func (o *PostDatacentersDatacenterIDRetryAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post datacenters datacenter Id retry accepted response has a 4xx status code
+func (o *PostDatacentersDatacenterIDRetryAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post datacenters datacenter Id retry accepted response has a 5xx status code
+func (o *PostDatacentersDatacenterIDRetryAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post datacenters datacenter Id retry accepted response a status code equal to that given
+func (o *PostDatacentersDatacenterIDRetryAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the post datacenters datacenter Id retry accepted response
+func (o *PostDatacentersDatacenterIDRetryAccepted) Code() int {
+	return 202
+}
+
+func (o *PostDatacentersDatacenterIDRetryAccepted) Error() string {
+	return fmt.Sprintf("[POST /datacenters/{datacenter_id}/retry][%d] postDatacentersDatacenterIdRetryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostDatacentersDatacenterIDRetryAccepted) String() string {
+	return fmt.Sprintf("[POST /datacenters/{datacenter_id}/retry][%d] postDatacentersDatacenterIdRetryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostDatacentersDatacenterIDRetryAccepted) GetPayload() *PostDatacentersDatacenterIDRetryAcceptedBody {
+	return o.Payload
+}
+
+func (o *PostDatacentersDatacenterIDRetryAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(PostDatacentersDatacenterIDRetryAcceptedBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostDatacentersDatacenterIDRetryNotFound creates a PostDatacentersDatacenterIDRetryNotFound with default headers values
+func NewPostDatacentersDatacenterIDRetryNotFound() *PostDatacentersDatacenterIDRetryNotFound {
+	return &PostDatacentersDatacenterIDRetryNotFound{}
+}
+
+/*
+PostDatacentersDatacenterIDRetryNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PostDatacentersDatacenterIDRetryNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post datacenters datacenter Id retry not found response has a 2xx status code
+func (o *PostDatacentersDatacenterIDRetryNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post datacenters datacenter Id retry not found response has a 3xx status code
+func (o *PostDatacentersDatacenterIDRetryNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post datacenters datacenter Id retry not found response has a 4xx status code
+func (o *PostDatacentersDatacenterIDRetryNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post datacenters datacenter Id retry not found response has a 5xx status code
+func (o *PostDatacentersDatacenterIDRetryNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post datacenters datacenter Id retry not found response a status code equal to that given
+func (o *PostDatacentersDatacenterIDRetryNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the post datacenters datacenter Id retry not found response
+func (o *PostDatacentersDatacenterIDRetryNotFound) Code() int {
+	return 404
+}
+
+func (o *PostDatacentersDatacenterIDRetryNotFound) Error() string {
+	return fmt.Sprintf("[POST /datacenters/{datacenter_id}/retry][%d] postDatacentersDatacenterIdRetryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostDatacentersDatacenterIDRetryNotFound) String() string {
+	return fmt.Sprintf("[POST /datacenters/{datacenter_id}/retry][%d] postDatacentersDatacenterIdRetryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostDatacentersDatacenterIDRetryNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostDatacentersDatacenterIDRetryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostDatacentersDatacenterIDRetryConflict creates a PostDatacentersDatacenterIDRetryConflict with default headers values
+func NewPostDatacentersDatacenterIDRetryConflict() *PostDatacentersDatacenterIDRetryConflict {
+	return &PostDatacentersDatacenterIDRetryConflict{}
+}
+
+/*
+PostDatacentersDatacenterIDRetryConflict describes a response with status code 409, with default header values.
+
+Conflict
+*/
+type PostDatacentersDatacenterIDRetryConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post datacenters datacenter Id retry conflict response has a 2xx status code
+func (o *PostDatacentersDatacenterIDRetryConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post datacenters datacenter Id retry conflict response has a 3xx status code
+func (o *PostDatacentersDatacenterIDRetryConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post datacenters datacenter Id retry conflict response has a 4xx status code
+func (o *PostDatacentersDatacenterIDRetryConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post datacenters datacenter Id retry conflict response has a 5xx status code
+func (o *PostDatacentersDatacenterIDRetryConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post datacenters datacenter Id retry conflict response a status code equal to that given
+func (o *PostDatacentersDatacenterIDRetryConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the post datacenters datacenter Id retry conflict response
+func (o *PostDatacentersDatacenterIDRetryConflict) Code() int {
+	return 409
+}
+
+func (o *PostDatacentersDatacenterIDRetryConflict) Error() string {
+	return fmt.Sprintf("[POST /datacenters/{datacenter_id}/retry][%d] postDatacentersDatacenterIdRetryConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostDatacentersDatacenterIDRetryConflict) String() string {
+	return fmt.Sprintf("[POST /datacenters/{datacenter_id}/retry][%d] postDatacentersDatacenterIdRetryConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostDatacentersDatacenterIDRetryConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostDatacentersDatacenterIDRetryConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostDatacentersDatacenterIDRetryDefault creates a PostDatacentersDatacenterIDRetryDefault with default headers values
+func NewPostDatacentersDatacenterIDRetryDefault(code int) *PostDatacentersDatacenterIDRetryDefault {
+	return &PostDatacentersDatacenterIDRetryDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+PostDatacentersDatacenterIDRetryDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type PostDatacentersDatacenterIDRetryDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post datacenters datacenter ID retry default response has a 2xx status code
+func (o *PostDatacentersDatacenterIDRetryDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this post datacenters datacenter ID retry default response has a 3xx status code
+func (o *PostDatacentersDatacenterIDRetryDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this post datacenters datacenter ID retry default response has a 4xx status code
+func (o *PostDatacentersDatacenterIDRetryDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this post datacenters datacenter ID retry default response has a 5xx status code
+func (o *PostDatacentersDatacenterIDRetryDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this post datacenters datacenter ID retry default response a status code equal to that given
+func (o *PostDatacentersDatacenterIDRetryDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the post datacenters datacenter ID retry default response
+func (o *PostDatacentersDatacenterIDRetryDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostDatacentersDatacenterIDRetryDefault) Error() string {
+	return fmt.Sprintf("[POST /datacenters/{datacenter_id}/retry][%d] PostDatacentersDatacenterIDRetry default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostDatacentersDatacenterIDRetryDefault) String() string {
+	return fmt.Sprintf("[POST /datacenters/{datacenter_id}/retry][%d] PostDatacentersDatacenterIDRetry default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostDatacentersDatacenterIDRetryDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostDatacentersDatacenterIDRetryDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+PostDatacentersDatacenterIDRetryAcceptedBody post datacenters datacenter ID retry accepted body
+swagger:model PostDatacentersDatacenterIDRetryAcceptedBody
+*/
+type PostDatacentersDatacenterIDRetryAcceptedBody struct {
+
+	// datacenter
+	Datacenter *models.Datacenter `json:"datacenter,omitempty"`
+}
+
+// Validate validates this post datacenters datacenter ID retry accepted body
+func (o *PostDatacentersDatacenterIDRetryAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateDatacenter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostDatacentersDatacenterIDRetryAcceptedBody) validateDatacenter(formats strfmt.Registry) error {
+	if swag.IsZero(o.Datacenter) { // not required
+		return nil
+	}
+
+	if o.Datacenter != nil {
+		if err := o.Datacenter.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postDatacentersDatacenterIdRetryAccepted" + "." + "datacenter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postDatacentersDatacenterIdRetryAccepted" + "." + "datacenter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post datacenters datacenter ID retry accepted body based on the context it is used
+func (o *PostDatacentersDatacenterIDRetryAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateDatacenter(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostDatacentersDatacenterIDRetryAcceptedBody) contextValidateDatacenter(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Datacenter != nil {
+		if err := o.Datacenter.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postDatacentersDatacenterIdRetryAccepted" + "." + "datacenter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postDatacentersDatacenterIdRetryAccepted" + "." + "datacenter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostDatacentersDatacenterIDRetryAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostDatacentersDatacenterIDRetryAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostDatacentersDatacenterIDRetryAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
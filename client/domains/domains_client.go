@@ -40,6 +40,10 @@ type ClientService interface {
 
 	PostDomains(params *PostDomainsParams, opts ...ClientOption) (*PostDomainsCreated, error)
 
+	PostDomainsDomainIDRestore(params *PostDomainsDomainIDRestoreParams, opts ...ClientOption) (*PostDomainsDomainIDRestoreAccepted, error)
+
+	PostDomainsDomainIDRetry(params *PostDomainsDomainIDRetryParams, opts ...ClientOption) (*PostDomainsDomainIDRetryAccepted, error)
+
 	PutDomainsDomainID(params *PutDomainsDomainIDParams, opts ...ClientOption) (*PutDomainsDomainIDAccepted, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -193,6 +197,84 @@ func (a *Client) PostDomains(params *PostDomainsParams, opts ...ClientOption) (*
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+PostDomainsDomainIDRestore restores a deleted domain
+
+Restores a deleted domain together with its pool relations, as long as it has not been purged after the restore window (api_settings.domain_restore_window) elapsed. Restored domains count against the domain quota again.
+*/
+func (a *Client) PostDomainsDomainIDRestore(params *PostDomainsDomainIDRestoreParams, opts ...ClientOption) (*PostDomainsDomainIDRestoreAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostDomainsDomainIDRestoreParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostDomainsDomainIDRestore",
+		Method:             "POST",
+		PathPattern:        "/domains/{domain_id}/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostDomainsDomainIDRestoreReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostDomainsDomainIDRestoreAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostDomainsDomainIDRestoreDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+PostDomainsDomainIDRetry retries provisioning of a domain
+
+Moves a domain with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.
+*/
+func (a *Client) PostDomainsDomainIDRetry(params *PostDomainsDomainIDRetryParams, opts ...ClientOption) (*PostDomainsDomainIDRetryAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostDomainsDomainIDRetryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostDomainsDomainIDRetry",
+		Method:             "POST",
+		PathPattern:        "/domains/{domain_id}/retry",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostDomainsDomainIDRetryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostDomainsDomainIDRetryAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostDomainsDomainIDRetryDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PutDomainsDomainID updates a domain
 */
@@ -0,0 +1,157 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPostDomainsDomainIDRestoreParams creates a new PostDomainsDomainIDRestoreParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPostDomainsDomainIDRestoreParams() *PostDomainsDomainIDRestoreParams {
+	return &PostDomainsDomainIDRestoreParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPostDomainsDomainIDRestoreParamsWithTimeout creates a new PostDomainsDomainIDRestoreParams object
+// with the ability to set a timeout on a request.
+func NewPostDomainsDomainIDRestoreParamsWithTimeout(timeout time.Duration) *PostDomainsDomainIDRestoreParams {
+	return &PostDomainsDomainIDRestoreParams{
+		timeout: timeout,
+	}
+}
+
+// NewPostDomainsDomainIDRestoreParamsWithContext creates a new PostDomainsDomainIDRestoreParams object
+// with the ability to set a context for a request.
+func NewPostDomainsDomainIDRestoreParamsWithContext(ctx context.Context) *PostDomainsDomainIDRestoreParams {
+	return &PostDomainsDomainIDRestoreParams{
+		Context: ctx,
+	}
+}
+
+// NewPostDomainsDomainIDRestoreParamsWithHTTPClient creates a new PostDomainsDomainIDRestoreParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPostDomainsDomainIDRestoreParamsWithHTTPClient(client *http.Client) *PostDomainsDomainIDRestoreParams {
+	return &PostDomainsDomainIDRestoreParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PostDomainsDomainIDRestoreParams contains all the parameters to send to the API endpoint
+
+	for the post domains domain ID restore operation.
+
+	Typically these are written to a http.Request.
+*/
+type PostDomainsDomainIDRestoreParams struct {
+
+	/* DomainID.
+
+	   The UUID of the domain
+
+	   Format: uuid
+	*/
+	DomainID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the post domains domain ID restore params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostDomainsDomainIDRestoreParams) WithDefaults() *PostDomainsDomainIDRestoreParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the post domains domain ID restore params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostDomainsDomainIDRestoreParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the post domains domain ID restore params
+func (o *PostDomainsDomainIDRestoreParams) WithTimeout(timeout time.Duration) *PostDomainsDomainIDRestoreParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the post domains domain ID restore params
+func (o *PostDomainsDomainIDRestoreParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the post domains domain ID restore params
+func (o *PostDomainsDomainIDRestoreParams) WithContext(ctx context.Context) *PostDomainsDomainIDRestoreParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the post domains domain ID restore params
+func (o *PostDomainsDomainIDRestoreParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the post domains domain ID restore params
+func (o *PostDomainsDomainIDRestoreParams) WithHTTPClient(client *http.Client) *PostDomainsDomainIDRestoreParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the post domains domain ID restore params
+func (o *PostDomainsDomainIDRestoreParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDomainID adds the domainID to the post domains domain ID restore params
+func (o *PostDomainsDomainIDRestoreParams) WithDomainID(domainID strfmt.UUID) *PostDomainsDomainIDRestoreParams {
+	o.SetDomainID(domainID)
+	return o
+}
+
+// SetDomainID adds the domainId to the post domains domain ID restore params
+func (o *PostDomainsDomainIDRestoreParams) SetDomainID(domainID strfmt.UUID) {
+	o.DomainID = domainID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PostDomainsDomainIDRestoreParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param domain_id
+	if err := r.SetPathParam("domain_id", o.DomainID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,428 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostDomainsDomainIDRestoreReader is a Reader for the PostDomainsDomainIDRestore structure.
+type PostDomainsDomainIDRestoreReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostDomainsDomainIDRestoreReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewPostDomainsDomainIDRestoreAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewPostDomainsDomainIDRestoreNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewPostDomainsDomainIDRestoreConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPostDomainsDomainIDRestoreDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostDomainsDomainIDRestoreAccepted creates a PostDomainsDomainIDRestoreAccepted with default headers values
+func NewPostDomainsDomainIDRestoreAccepted() *PostDomainsDomainIDRestoreAccepted {
+	return &PostDomainsDomainIDRestoreAccepted{}
+}
+
+/*
+PostDomainsDomainIDRestoreAccepted describes a response with status code 202, with default header values.
+
+The domain will be provisioned again.
+*/
+type PostDomainsDomainIDRestoreAccepted struct {
+	Payload *PostDomainsDomainIDRestoreAcceptedBody
+}
+
+// IsSuccess returns true when this post domains domain Id restore accepted response has a 2xx status code
+func (o *PostDomainsDomainIDRestoreAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this post domains domain Id restore accepted response has a 3xx status code
+func (o *PostDomainsDomainIDRestoreAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post domains domain Id restore accepted response has a 4xx status code
+func (o *PostDomainsDomainIDRestoreAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post domains domain Id restore accepted response has a 5xx status code
+func (o *PostDomainsDomainIDRestoreAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post domains domain Id restore accepted response a status code equal to that given
+func (o *PostDomainsDomainIDRestoreAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the post domains domain Id restore accepted response
+func (o *PostDomainsDomainIDRestoreAccepted) Code() int {
+	return 202
+}
+
+func (o *PostDomainsDomainIDRestoreAccepted) Error() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/restore][%d] postDomainsDomainIdRestoreAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRestoreAccepted) String() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/restore][%d] postDomainsDomainIdRestoreAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRestoreAccepted) GetPayload() *PostDomainsDomainIDRestoreAcceptedBody {
+	return o.Payload
+}
+
+func (o *PostDomainsDomainIDRestoreAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(PostDomainsDomainIDRestoreAcceptedBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostDomainsDomainIDRestoreNotFound creates a PostDomainsDomainIDRestoreNotFound with default headers values
+func NewPostDomainsDomainIDRestoreNotFound() *PostDomainsDomainIDRestoreNotFound {
+	return &PostDomainsDomainIDRestoreNotFound{}
+}
+
+/*
+PostDomainsDomainIDRestoreNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PostDomainsDomainIDRestoreNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post domains domain Id restore not found response has a 2xx status code
+func (o *PostDomainsDomainIDRestoreNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post domains domain Id restore not found response has a 3xx status code
+func (o *PostDomainsDomainIDRestoreNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post domains domain Id restore not found response has a 4xx status code
+func (o *PostDomainsDomainIDRestoreNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post domains domain Id restore not found response has a 5xx status code
+func (o *PostDomainsDomainIDRestoreNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post domains domain Id restore not found response a status code equal to that given
+func (o *PostDomainsDomainIDRestoreNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the post domains domain Id restore not found response
+func (o *PostDomainsDomainIDRestoreNotFound) Code() int {
+	return 404
+}
+
+func (o *PostDomainsDomainIDRestoreNotFound) Error() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/restore][%d] postDomainsDomainIdRestoreNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRestoreNotFound) String() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/restore][%d] postDomainsDomainIdRestoreNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRestoreNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostDomainsDomainIDRestoreNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostDomainsDomainIDRestoreConflict creates a PostDomainsDomainIDRestoreConflict with default headers values
+func NewPostDomainsDomainIDRestoreConflict() *PostDomainsDomainIDRestoreConflict {
+	return &PostDomainsDomainIDRestoreConflict{}
+}
+
+/*
+PostDomainsDomainIDRestoreConflict describes a response with status code 409, with default header values.
+
+Conflict
+*/
+type PostDomainsDomainIDRestoreConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post domains domain Id restore conflict response has a 2xx status code
+func (o *PostDomainsDomainIDRestoreConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post domains domain Id restore conflict response has a 3xx status code
+func (o *PostDomainsDomainIDRestoreConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post domains domain Id restore conflict response has a 4xx status code
+func (o *PostDomainsDomainIDRestoreConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post domains domain Id restore conflict response has a 5xx status code
+func (o *PostDomainsDomainIDRestoreConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post domains domain Id restore conflict response a status code equal to that given
+func (o *PostDomainsDomainIDRestoreConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the post domains domain Id restore conflict response
+func (o *PostDomainsDomainIDRestoreConflict) Code() int {
+	return 409
+}
+
+func (o *PostDomainsDomainIDRestoreConflict) Error() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/restore][%d] postDomainsDomainIdRestoreConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRestoreConflict) String() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/restore][%d] postDomainsDomainIdRestoreConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRestoreConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostDomainsDomainIDRestoreConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostDomainsDomainIDRestoreDefault creates a PostDomainsDomainIDRestoreDefault with default headers values
+func NewPostDomainsDomainIDRestoreDefault(code int) *PostDomainsDomainIDRestoreDefault {
+	return &PostDomainsDomainIDRestoreDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+PostDomainsDomainIDRestoreDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type PostDomainsDomainIDRestoreDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post domains domain ID restore default response has a 2xx status code
+func (o *PostDomainsDomainIDRestoreDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this post domains domain ID restore default response has a 3xx status code
+func (o *PostDomainsDomainIDRestoreDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this post domains domain ID restore default response has a 4xx status code
+func (o *PostDomainsDomainIDRestoreDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this post domains domain ID restore default response has a 5xx status code
+func (o *PostDomainsDomainIDRestoreDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this post domains domain ID restore default response a status code equal to that given
+func (o *PostDomainsDomainIDRestoreDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the post domains domain ID restore default response
+func (o *PostDomainsDomainIDRestoreDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostDomainsDomainIDRestoreDefault) Error() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/restore][%d] PostDomainsDomainIDRestore default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRestoreDefault) String() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/restore][%d] PostDomainsDomainIDRestore default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRestoreDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostDomainsDomainIDRestoreDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+PostDomainsDomainIDRestoreAcceptedBody post domains domain ID restore accepted body
+swagger:model PostDomainsDomainIDRestoreAcceptedBody
+*/
+type PostDomainsDomainIDRestoreAcceptedBody struct {
+
+	// domain
+	Domain *models.Domain `json:"domain,omitempty"`
+}
+
+// Validate validates this post domains domain ID restore accepted body
+func (o *PostDomainsDomainIDRestoreAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateDomain(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostDomainsDomainIDRestoreAcceptedBody) validateDomain(formats strfmt.Registry) error {
+	if swag.IsZero(o.Domain) { // not required
+		return nil
+	}
+
+	if o.Domain != nil {
+		if err := o.Domain.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postDomainsDomainIdRestoreAccepted" + "." + "domain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postDomainsDomainIdRestoreAccepted" + "." + "domain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post domains domain ID restore accepted body based on the context it is used
+func (o *PostDomainsDomainIDRestoreAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateDomain(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostDomainsDomainIDRestoreAcceptedBody) contextValidateDomain(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Domain != nil {
+		if err := o.Domain.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postDomainsDomainIdRestoreAccepted" + "." + "domain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postDomainsDomainIdRestoreAccepted" + "." + "domain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostDomainsDomainIDRestoreAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostDomainsDomainIDRestoreAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostDomainsDomainIDRestoreAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
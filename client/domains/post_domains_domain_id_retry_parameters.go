@@ -0,0 +1,157 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPostDomainsDomainIDRetryParams creates a new PostDomainsDomainIDRetryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPostDomainsDomainIDRetryParams() *PostDomainsDomainIDRetryParams {
+	return &PostDomainsDomainIDRetryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPostDomainsDomainIDRetryParamsWithTimeout creates a new PostDomainsDomainIDRetryParams object
+// with the ability to set a timeout on a request.
+func NewPostDomainsDomainIDRetryParamsWithTimeout(timeout time.Duration) *PostDomainsDomainIDRetryParams {
+	return &PostDomainsDomainIDRetryParams{
+		timeout: timeout,
+	}
+}
+
+// NewPostDomainsDomainIDRetryParamsWithContext creates a new PostDomainsDomainIDRetryParams object
+// with the ability to set a context for a request.
+func NewPostDomainsDomainIDRetryParamsWithContext(ctx context.Context) *PostDomainsDomainIDRetryParams {
+	return &PostDomainsDomainIDRetryParams{
+		Context: ctx,
+	}
+}
+
+// NewPostDomainsDomainIDRetryParamsWithHTTPClient creates a new PostDomainsDomainIDRetryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPostDomainsDomainIDRetryParamsWithHTTPClient(client *http.Client) *PostDomainsDomainIDRetryParams {
+	return &PostDomainsDomainIDRetryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PostDomainsDomainIDRetryParams contains all the parameters to send to the API endpoint
+
+	for the post domains domain ID retry operation.
+
+	Typically these are written to a http.Request.
+*/
+type PostDomainsDomainIDRetryParams struct {
+
+	/* DomainID.
+
+	   The UUID of the domain
+
+	   Format: uuid
+	*/
+	DomainID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the post domains domain ID retry params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostDomainsDomainIDRetryParams) WithDefaults() *PostDomainsDomainIDRetryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the post domains domain ID retry params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostDomainsDomainIDRetryParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the post domains domain ID retry params
+func (o *PostDomainsDomainIDRetryParams) WithTimeout(timeout time.Duration) *PostDomainsDomainIDRetryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the post domains domain ID retry params
+func (o *PostDomainsDomainIDRetryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the post domains domain ID retry params
+func (o *PostDomainsDomainIDRetryParams) WithContext(ctx context.Context) *PostDomainsDomainIDRetryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the post domains domain ID retry params
+func (o *PostDomainsDomainIDRetryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the post domains domain ID retry params
+func (o *PostDomainsDomainIDRetryParams) WithHTTPClient(client *http.Client) *PostDomainsDomainIDRetryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the post domains domain ID retry params
+func (o *PostDomainsDomainIDRetryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDomainID adds the domainID to the post domains domain ID retry params
+func (o *PostDomainsDomainIDRetryParams) WithDomainID(domainID strfmt.UUID) *PostDomainsDomainIDRetryParams {
+	o.SetDomainID(domainID)
+	return o
+}
+
+// SetDomainID adds the domainId to the post domains domain ID retry params
+func (o *PostDomainsDomainIDRetryParams) SetDomainID(domainID strfmt.UUID) {
+	o.DomainID = domainID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PostDomainsDomainIDRetryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param domain_id
+	if err := r.SetPathParam("domain_id", o.DomainID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
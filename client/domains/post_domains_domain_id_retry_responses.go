@@ -0,0 +1,428 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostDomainsDomainIDRetryReader is a Reader for the PostDomainsDomainIDRetry structure.
+type PostDomainsDomainIDRetryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostDomainsDomainIDRetryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewPostDomainsDomainIDRetryAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewPostDomainsDomainIDRetryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewPostDomainsDomainIDRetryConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPostDomainsDomainIDRetryDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostDomainsDomainIDRetryAccepted creates a PostDomainsDomainIDRetryAccepted with default headers values
+func NewPostDomainsDomainIDRetryAccepted() *PostDomainsDomainIDRetryAccepted {
+	return &PostDomainsDomainIDRetryAccepted{}
+}
+
+/*
+PostDomainsDomainIDRetryAccepted describes a response with status code 202, with default header values.
+
+The domain will be provisioned again.
+*/
+type PostDomainsDomainIDRetryAccepted struct {
+	Payload *PostDomainsDomainIDRetryAcceptedBody
+}
+
+// IsSuccess returns true when this post domains domain Id retry accepted response has a 2xx status code
+func (o *PostDomainsDomainIDRetryAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this post domains domain Id retry accepted response has a 3xx status code
+func (o *PostDomainsDomainIDRetryAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post domains domain Id retry accepted response has a 4xx status code
+func (o *PostDomainsDomainIDRetryAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post domains domain Id retry accepted response has a 5xx status code
+func (o *PostDomainsDomainIDRetryAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post domains domain Id retry accepted response a status code equal to that given
+func (o *PostDomainsDomainIDRetryAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the post domains domain Id retry accepted response
+func (o *PostDomainsDomainIDRetryAccepted) Code() int {
+	return 202
+}
+
+func (o *PostDomainsDomainIDRetryAccepted) Error() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/retry][%d] postDomainsDomainIdRetryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRetryAccepted) String() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/retry][%d] postDomainsDomainIdRetryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRetryAccepted) GetPayload() *PostDomainsDomainIDRetryAcceptedBody {
+	return o.Payload
+}
+
+func (o *PostDomainsDomainIDRetryAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(PostDomainsDomainIDRetryAcceptedBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostDomainsDomainIDRetryNotFound creates a PostDomainsDomainIDRetryNotFound with default headers values
+func NewPostDomainsDomainIDRetryNotFound() *PostDomainsDomainIDRetryNotFound {
+	return &PostDomainsDomainIDRetryNotFound{}
+}
+
+/*
+PostDomainsDomainIDRetryNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PostDomainsDomainIDRetryNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post domains domain Id retry not found response has a 2xx status code
+func (o *PostDomainsDomainIDRetryNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post domains domain Id retry not found response has a 3xx status code
+func (o *PostDomainsDomainIDRetryNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post domains domain Id retry not found response has a 4xx status code
+func (o *PostDomainsDomainIDRetryNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post domains domain Id retry not found response has a 5xx status code
+func (o *PostDomainsDomainIDRetryNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post domains domain Id retry not found response a status code equal to that given
+func (o *PostDomainsDomainIDRetryNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the post domains domain Id retry not found response
+func (o *PostDomainsDomainIDRetryNotFound) Code() int {
+	return 404
+}
+
+func (o *PostDomainsDomainIDRetryNotFound) Error() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/retry][%d] postDomainsDomainIdRetryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRetryNotFound) String() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/retry][%d] postDomainsDomainIdRetryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRetryNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostDomainsDomainIDRetryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostDomainsDomainIDRetryConflict creates a PostDomainsDomainIDRetryConflict with default headers values
+func NewPostDomainsDomainIDRetryConflict() *PostDomainsDomainIDRetryConflict {
+	return &PostDomainsDomainIDRetryConflict{}
+}
+
+/*
+PostDomainsDomainIDRetryConflict describes a response with status code 409, with default header values.
+
+Conflict
+*/
+type PostDomainsDomainIDRetryConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post domains domain Id retry conflict response has a 2xx status code
+func (o *PostDomainsDomainIDRetryConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post domains domain Id retry conflict response has a 3xx status code
+func (o *PostDomainsDomainIDRetryConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post domains domain Id retry conflict response has a 4xx status code
+func (o *PostDomainsDomainIDRetryConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post domains domain Id retry conflict response has a 5xx status code
+func (o *PostDomainsDomainIDRetryConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post domains domain Id retry conflict response a status code equal to that given
+func (o *PostDomainsDomainIDRetryConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the post domains domain Id retry conflict response
+func (o *PostDomainsDomainIDRetryConflict) Code() int {
+	return 409
+}
+
+func (o *PostDomainsDomainIDRetryConflict) Error() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/retry][%d] postDomainsDomainIdRetryConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRetryConflict) String() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/retry][%d] postDomainsDomainIdRetryConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRetryConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostDomainsDomainIDRetryConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostDomainsDomainIDRetryDefault creates a PostDomainsDomainIDRetryDefault with default headers values
+func NewPostDomainsDomainIDRetryDefault(code int) *PostDomainsDomainIDRetryDefault {
+	return &PostDomainsDomainIDRetryDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+PostDomainsDomainIDRetryDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type PostDomainsDomainIDRetryDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post domains domain ID retry default response has a 2xx status code
+func (o *PostDomainsDomainIDRetryDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this post domains domain ID retry default response has a 3xx status code
+func (o *PostDomainsDomainIDRetryDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this post domains domain ID retry default response has a 4xx status code
+func (o *PostDomainsDomainIDRetryDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this post domains domain ID retry default response has a 5xx status code
+func (o *PostDomainsDomainIDRetryDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this post domains domain ID retry default response a status code equal to that given
+func (o *PostDomainsDomainIDRetryDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the post domains domain ID retry default response
+func (o *PostDomainsDomainIDRetryDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostDomainsDomainIDRetryDefault) Error() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/retry][%d] PostDomainsDomainIDRetry default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRetryDefault) String() string {
+	return fmt.Sprintf("[POST /domains/{domain_id}/retry][%d] PostDomainsDomainIDRetry default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostDomainsDomainIDRetryDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostDomainsDomainIDRetryDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+PostDomainsDomainIDRetryAcceptedBody post domains domain ID retry accepted body
+swagger:model PostDomainsDomainIDRetryAcceptedBody
+*/
+type PostDomainsDomainIDRetryAcceptedBody struct {
+
+	// domain
+	Domain *models.Domain `json:"domain,omitempty"`
+}
+
+// Validate validates this post domains domain ID retry accepted body
+func (o *PostDomainsDomainIDRetryAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateDomain(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostDomainsDomainIDRetryAcceptedBody) validateDomain(formats strfmt.Registry) error {
+	if swag.IsZero(o.Domain) { // not required
+		return nil
+	}
+
+	if o.Domain != nil {
+		if err := o.Domain.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postDomainsDomainIdRetryAccepted" + "." + "domain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postDomainsDomainIdRetryAccepted" + "." + "domain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post domains domain ID retry accepted body based on the context it is used
+func (o *PostDomainsDomainIDRetryAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateDomain(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostDomainsDomainIDRetryAcceptedBody) contextValidateDomain(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Domain != nil {
+		if err := o.Domain.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postDomainsDomainIdRetryAccepted" + "." + "domain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postDomainsDomainIdRetryAccepted" + "." + "domain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostDomainsDomainIDRetryAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostDomainsDomainIDRetryAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostDomainsDomainIDRetryAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
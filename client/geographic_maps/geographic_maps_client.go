@@ -40,6 +40,8 @@ type ClientService interface {
 
 	PostGeomaps(params *PostGeomapsParams, opts ...ClientOption) (*PostGeomapsCreated, error)
 
+	PostGeomapsGeomapIDRetry(params *PostGeomapsGeomapIDRetryParams, opts ...ClientOption) (*PostGeomapsGeomapIDRetryAccepted, error)
+
 	PutGeomapsGeomapID(params *PutGeomapsGeomapIDParams, opts ...ClientOption) (*PutGeomapsGeomapIDAccepted, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -193,6 +195,45 @@ func (a *Client) PostGeomaps(params *PostGeomapsParams, opts ...ClientOption) (*
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+PostGeomapsGeomapIDRetry retries provisioning of a geographic map
+
+Moves a geographic map with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.
+*/
+func (a *Client) PostGeomapsGeomapIDRetry(params *PostGeomapsGeomapIDRetryParams, opts ...ClientOption) (*PostGeomapsGeomapIDRetryAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostGeomapsGeomapIDRetryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostGeomapsGeomapIDRetry",
+		Method:             "POST",
+		PathPattern:        "/geomaps/{geomap_id}/retry",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostGeomapsGeomapIDRetryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostGeomapsGeomapIDRetryAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostGeomapsGeomapIDRetryDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PutGeomapsGeomapID updates a geographic map
 */
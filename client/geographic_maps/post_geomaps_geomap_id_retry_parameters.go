@@ -0,0 +1,157 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package geographic_maps
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPostGeomapsGeomapIDRetryParams creates a new PostGeomapsGeomapIDRetryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPostGeomapsGeomapIDRetryParams() *PostGeomapsGeomapIDRetryParams {
+	return &PostGeomapsGeomapIDRetryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPostGeomapsGeomapIDRetryParamsWithTimeout creates a new PostGeomapsGeomapIDRetryParams object
+// with the ability to set a timeout on a request.
+func NewPostGeomapsGeomapIDRetryParamsWithTimeout(timeout time.Duration) *PostGeomapsGeomapIDRetryParams {
+	return &PostGeomapsGeomapIDRetryParams{
+		timeout: timeout,
+	}
+}
+
+// NewPostGeomapsGeomapIDRetryParamsWithContext creates a new PostGeomapsGeomapIDRetryParams object
+// with the ability to set a context for a request.
+func NewPostGeomapsGeomapIDRetryParamsWithContext(ctx context.Context) *PostGeomapsGeomapIDRetryParams {
+	return &PostGeomapsGeomapIDRetryParams{
+		Context: ctx,
+	}
+}
+
+// NewPostGeomapsGeomapIDRetryParamsWithHTTPClient creates a new PostGeomapsGeomapIDRetryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPostGeomapsGeomapIDRetryParamsWithHTTPClient(client *http.Client) *PostGeomapsGeomapIDRetryParams {
+	return &PostGeomapsGeomapIDRetryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PostGeomapsGeomapIDRetryParams contains all the parameters to send to the API endpoint
+
+	for the post geomaps geomap ID retry operation.
+
+	Typically these are written to a http.Request.
+*/
+type PostGeomapsGeomapIDRetryParams struct {
+
+	/* GeomapID.
+
+	   The UUID of the geomap
+
+	   Format: uuid
+	*/
+	GeomapID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the post geomaps geomap ID retry params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostGeomapsGeomapIDRetryParams) WithDefaults() *PostGeomapsGeomapIDRetryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the post geomaps geomap ID retry params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostGeomapsGeomapIDRetryParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the post geomaps geomap ID retry params
+func (o *PostGeomapsGeomapIDRetryParams) WithTimeout(timeout time.Duration) *PostGeomapsGeomapIDRetryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the post geomaps geomap ID retry params
+func (o *PostGeomapsGeomapIDRetryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the post geomaps geomap ID retry params
+func (o *PostGeomapsGeomapIDRetryParams) WithContext(ctx context.Context) *PostGeomapsGeomapIDRetryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the post geomaps geomap ID retry params
+func (o *PostGeomapsGeomapIDRetryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the post geomaps geomap ID retry params
+func (o *PostGeomapsGeomapIDRetryParams) WithHTTPClient(client *http.Client) *PostGeomapsGeomapIDRetryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the post geomaps geomap ID retry params
+func (o *PostGeomapsGeomapIDRetryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithGeomapID adds the geomapID to the post geomaps geomap ID retry params
+func (o *PostGeomapsGeomapIDRetryParams) WithGeomapID(geomapID strfmt.UUID) *PostGeomapsGeomapIDRetryParams {
+	o.SetGeomapID(geomapID)
+	return o
+}
+
+// SetGeomapID adds the geomapId to the post geomaps geomap ID retry params
+func (o *PostGeomapsGeomapIDRetryParams) SetGeomapID(geomapID strfmt.UUID) {
+	o.GeomapID = geomapID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PostGeomapsGeomapIDRetryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param geomap_id
+	if err := r.SetPathParam("geomap_id", o.GeomapID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
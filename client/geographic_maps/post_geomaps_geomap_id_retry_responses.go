@@ -0,0 +1,428 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package geographic_maps
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostGeomapsGeomapIDRetryReader is a Reader for the PostGeomapsGeomapIDRetry structure.
+type PostGeomapsGeomapIDRetryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostGeomapsGeomapIDRetryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewPostGeomapsGeomapIDRetryAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewPostGeomapsGeomapIDRetryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewPostGeomapsGeomapIDRetryConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPostGeomapsGeomapIDRetryDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostGeomapsGeomapIDRetryAccepted creates a PostGeomapsGeomapIDRetryAccepted with default headers values
+func NewPostGeomapsGeomapIDRetryAccepted() *PostGeomapsGeomapIDRetryAccepted {
+	return &PostGeomapsGeomapIDRetryAccepted{}
+}
+
+/*
+PostGeomapsGeomapIDRetryAccepted describes a response with status code 202, with default header values.
+
+The geographic map will be provisioned again.
+*/
+type PostGeomapsGeomapIDRetryAccepted struct {
+	Payload *PostGeomapsGeomapIDRetryAcceptedBody
+}
+
+// IsSuccess returns true when this post geomaps geomap Id retry accepted response has a 2xx status code
+func (o *PostGeomapsGeomapIDRetryAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this post geomaps geomap Id retry accepted response has a 3xx status code
+func (o *PostGeomapsGeomapIDRetryAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post geomaps geomap Id retry accepted response has a 4xx status code
+func (o *PostGeomapsGeomapIDRetryAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post geomaps geomap Id retry accepted response has a 5xx status code
+func (o *PostGeomapsGeomapIDRetryAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post geomaps geomap Id retry accepted response a status code equal to that given
+func (o *PostGeomapsGeomapIDRetryAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the post geomaps geomap Id retry accepted response
+func (o *PostGeomapsGeomapIDRetryAccepted) Code() int {
+	return 202
+}
+
+func (o *PostGeomapsGeomapIDRetryAccepted) Error() string {
+	return fmt.Sprintf("[POST /geomaps/{geomap_id}/retry][%d] postGeomapsGeomapIdRetryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostGeomapsGeomapIDRetryAccepted) String() string {
+	return fmt.Sprintf("[POST /geomaps/{geomap_id}/retry][%d] postGeomapsGeomapIdRetryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostGeomapsGeomapIDRetryAccepted) GetPayload() *PostGeomapsGeomapIDRetryAcceptedBody {
+	return o.Payload
+}
+
+func (o *PostGeomapsGeomapIDRetryAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(PostGeomapsGeomapIDRetryAcceptedBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostGeomapsGeomapIDRetryNotFound creates a PostGeomapsGeomapIDRetryNotFound with default headers values
+func NewPostGeomapsGeomapIDRetryNotFound() *PostGeomapsGeomapIDRetryNotFound {
+	return &PostGeomapsGeomapIDRetryNotFound{}
+}
+
+/*
+PostGeomapsGeomapIDRetryNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PostGeomapsGeomapIDRetryNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post geomaps geomap Id retry not found response has a 2xx status code
+func (o *PostGeomapsGeomapIDRetryNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post geomaps geomap Id retry not found response has a 3xx status code
+func (o *PostGeomapsGeomapIDRetryNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post geomaps geomap Id retry not found response has a 4xx status code
+func (o *PostGeomapsGeomapIDRetryNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post geomaps geomap Id retry not found response has a 5xx status code
+func (o *PostGeomapsGeomapIDRetryNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post geomaps geomap Id retry not found response a status code equal to that given
+func (o *PostGeomapsGeomapIDRetryNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the post geomaps geomap Id retry not found response
+func (o *PostGeomapsGeomapIDRetryNotFound) Code() int {
+	return 404
+}
+
+func (o *PostGeomapsGeomapIDRetryNotFound) Error() string {
+	return fmt.Sprintf("[POST /geomaps/{geomap_id}/retry][%d] postGeomapsGeomapIdRetryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostGeomapsGeomapIDRetryNotFound) String() string {
+	return fmt.Sprintf("[POST /geomaps/{geomap_id}/retry][%d] postGeomapsGeomapIdRetryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostGeomapsGeomapIDRetryNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostGeomapsGeomapIDRetryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostGeomapsGeomapIDRetryConflict creates a PostGeomapsGeomapIDRetryConflict with default headers values
+func NewPostGeomapsGeomapIDRetryConflict() *PostGeomapsGeomapIDRetryConflict {
+	return &PostGeomapsGeomapIDRetryConflict{}
+}
+
+/*
+PostGeomapsGeomapIDRetryConflict describes a response with status code 409, with default header values.
+
+Conflict
+*/
+type PostGeomapsGeomapIDRetryConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post geomaps geomap Id retry conflict response has a 2xx status code
+func (o *PostGeomapsGeomapIDRetryConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post geomaps geomap Id retry conflict response has a 3xx status code
+func (o *PostGeomapsGeomapIDRetryConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post geomaps geomap Id retry conflict response has a 4xx status code
+func (o *PostGeomapsGeomapIDRetryConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post geomaps geomap Id retry conflict response has a 5xx status code
+func (o *PostGeomapsGeomapIDRetryConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post geomaps geomap Id retry conflict response a status code equal to that given
+func (o *PostGeomapsGeomapIDRetryConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the post geomaps geomap Id retry conflict response
+func (o *PostGeomapsGeomapIDRetryConflict) Code() int {
+	return 409
+}
+
+func (o *PostGeomapsGeomapIDRetryConflict) Error() string {
+	return fmt.Sprintf("[POST /geomaps/{geomap_id}/retry][%d] postGeomapsGeomapIdRetryConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostGeomapsGeomapIDRetryConflict) String() string {
+	return fmt.Sprintf("[POST /geomaps/{geomap_id}/retry][%d] postGeomapsGeomapIdRetryConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostGeomapsGeomapIDRetryConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostGeomapsGeomapIDRetryConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostGeomapsGeomapIDRetryDefault creates a PostGeomapsGeomapIDRetryDefault with default headers values
+func NewPostGeomapsGeomapIDRetryDefault(code int) *PostGeomapsGeomapIDRetryDefault {
+	return &PostGeomapsGeomapIDRetryDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+PostGeomapsGeomapIDRetryDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type PostGeomapsGeomapIDRetryDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post geomaps geomap ID retry default response has a 2xx status code
+func (o *PostGeomapsGeomapIDRetryDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this post geomaps geomap ID retry default response has a 3xx status code
+func (o *PostGeomapsGeomapIDRetryDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this post geomaps geomap ID retry default response has a 4xx status code
+func (o *PostGeomapsGeomapIDRetryDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this post geomaps geomap ID retry default response has a 5xx status code
+func (o *PostGeomapsGeomapIDRetryDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this post geomaps geomap ID retry default response a status code equal to that given
+func (o *PostGeomapsGeomapIDRetryDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the post geomaps geomap ID retry default response
+func (o *PostGeomapsGeomapIDRetryDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostGeomapsGeomapIDRetryDefault) Error() string {
+	return fmt.Sprintf("[POST /geomaps/{geomap_id}/retry][%d] PostGeomapsGeomapIDRetry default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostGeomapsGeomapIDRetryDefault) String() string {
+	return fmt.Sprintf("[POST /geomaps/{geomap_id}/retry][%d] PostGeomapsGeomapIDRetry default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostGeomapsGeomapIDRetryDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostGeomapsGeomapIDRetryDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+PostGeomapsGeomapIDRetryAcceptedBody post geomaps geomap ID retry accepted body
+swagger:model PostGeomapsGeomapIDRetryAcceptedBody
+*/
+type PostGeomapsGeomapIDRetryAcceptedBody struct {
+
+	// geomap
+	Geomap *models.Geomap `json:"geomap,omitempty"`
+}
+
+// Validate validates this post geomaps geomap ID retry accepted body
+func (o *PostGeomapsGeomapIDRetryAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateGeomap(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostGeomapsGeomapIDRetryAcceptedBody) validateGeomap(formats strfmt.Registry) error {
+	if swag.IsZero(o.Geomap) { // not required
+		return nil
+	}
+
+	if o.Geomap != nil {
+		if err := o.Geomap.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postGeomapsGeomapIdRetryAccepted" + "." + "geomap")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postGeomapsGeomapIdRetryAccepted" + "." + "geomap")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post geomaps geomap ID retry accepted body based on the context it is used
+func (o *PostGeomapsGeomapIDRetryAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateGeomap(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostGeomapsGeomapIDRetryAcceptedBody) contextValidateGeomap(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Geomap != nil {
+		if err := o.Geomap.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postGeomapsGeomapIdRetryAccepted" + "." + "geomap")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postGeomapsGeomapIdRetryAccepted" + "." + "geomap")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostGeomapsGeomapIDRetryAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostGeomapsGeomapIDRetryAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostGeomapsGeomapIDRetryAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
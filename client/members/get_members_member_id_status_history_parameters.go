@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package members
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetMembersMemberIDStatusHistoryParams creates a new GetMembersMemberIDStatusHistoryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetMembersMemberIDStatusHistoryParams() *GetMembersMemberIDStatusHistoryParams {
+	return &GetMembersMemberIDStatusHistoryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetMembersMemberIDStatusHistoryParamsWithTimeout creates a new GetMembersMemberIDStatusHistoryParams object
+// with the ability to set a timeout on a request.
+func NewGetMembersMemberIDStatusHistoryParamsWithTimeout(timeout time.Duration) *GetMembersMemberIDStatusHistoryParams {
+	return &GetMembersMemberIDStatusHistoryParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetMembersMemberIDStatusHistoryParamsWithContext creates a new GetMembersMemberIDStatusHistoryParams object
+// with the ability to set a context for a request.
+func NewGetMembersMemberIDStatusHistoryParamsWithContext(ctx context.Context) *GetMembersMemberIDStatusHistoryParams {
+	return &GetMembersMemberIDStatusHistoryParams{
+		Context: ctx,
+	}
+}
+
+// NewGetMembersMemberIDStatusHistoryParamsWithHTTPClient creates a new GetMembersMemberIDStatusHistoryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetMembersMemberIDStatusHistoryParamsWithHTTPClient(client *http.Client) *GetMembersMemberIDStatusHistoryParams {
+	return &GetMembersMemberIDStatusHistoryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetMembersMemberIDStatusHistoryParams contains all the parameters to send to the API endpoint
+
+	for the get members member ID status history operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetMembersMemberIDStatusHistoryParams struct {
+
+	/* Limit.
+
+	   Sets the page size.
+	*/
+	Limit *int64
+
+	/* MemberID.
+
+	   The UUID of the member
+
+	   Format: uuid
+	*/
+	MemberID strfmt.UUID
+
+	/* Since.
+
+	   Only transitions at or after this UTC date and timestamp.
+
+	   Format: date-time
+	*/
+	Since *strfmt.DateTime
+
+	/* Until.
+
+	   Only transitions before this UTC date and timestamp.
+
+	   Format: date-time
+	*/
+	Until *strfmt.DateTime
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get members member ID status history params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetMembersMemberIDStatusHistoryParams) WithDefaults() *GetMembersMemberIDStatusHistoryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get members member ID status history params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetMembersMemberIDStatusHistoryParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) WithTimeout(timeout time.Duration) *GetMembersMemberIDStatusHistoryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) WithContext(ctx context.Context) *GetMembersMemberIDStatusHistoryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) WithHTTPClient(client *http.Client) *GetMembersMemberIDStatusHistoryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) WithLimit(limit *int64) *GetMembersMemberIDStatusHistoryParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithMemberID adds the memberID to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) WithMemberID(memberID strfmt.UUID) *GetMembersMemberIDStatusHistoryParams {
+	o.SetMemberID(memberID)
+	return o
+}
+
+// SetMemberID adds the memberId to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) SetMemberID(memberID strfmt.UUID) {
+	o.MemberID = memberID
+}
+
+// WithSince adds the since to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) WithSince(since *strfmt.DateTime) *GetMembersMemberIDStatusHistoryParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) SetSince(since *strfmt.DateTime) {
+	o.Since = since
+}
+
+// WithUntil adds the until to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) WithUntil(until *strfmt.DateTime) *GetMembersMemberIDStatusHistoryParams {
+	o.SetUntil(until)
+	return o
+}
+
+// SetUntil adds the until to the get members member ID status history params
+func (o *GetMembersMemberIDStatusHistoryParams) SetUntil(until *strfmt.DateTime) {
+	o.Until = until
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetMembersMemberIDStatusHistoryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param member_id
+	if err := r.SetPathParam("member_id", o.MemberID.String()); err != nil {
+		return err
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince strfmt.DateTime
+
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince.String()
+		if qSince != "" {
+
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Until != nil {
+
+		// query param until
+		var qrUntil strfmt.DateTime
+
+		if o.Until != nil {
+			qrUntil = *o.Until
+		}
+		qUntil := qrUntil.String()
+		if qUntil != "" {
+
+			if err := r.SetQueryParam("until", qUntil); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,366 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package members
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetMembersMemberIDStatusHistoryReader is a Reader for the GetMembersMemberIDStatusHistory structure.
+type GetMembersMemberIDStatusHistoryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetMembersMemberIDStatusHistoryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetMembersMemberIDStatusHistoryOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetMembersMemberIDStatusHistoryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetMembersMemberIDStatusHistoryDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetMembersMemberIDStatusHistoryOK creates a GetMembersMemberIDStatusHistoryOK with default headers values
+func NewGetMembersMemberIDStatusHistoryOK() *GetMembersMemberIDStatusHistoryOK {
+	return &GetMembersMemberIDStatusHistoryOK{}
+}
+
+/*
+GetMembersMemberIDStatusHistoryOK describes a response with status code 200, with default header values.
+
+A JSON array of status transitions.
+*/
+type GetMembersMemberIDStatusHistoryOK struct {
+	Payload *GetMembersMemberIDStatusHistoryOKBody
+}
+
+// IsSuccess returns true when this get members member Id status history o k response has a 2xx status code
+func (o *GetMembersMemberIDStatusHistoryOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get members member Id status history o k response has a 3xx status code
+func (o *GetMembersMemberIDStatusHistoryOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get members member Id status history o k response has a 4xx status code
+func (o *GetMembersMemberIDStatusHistoryOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get members member Id status history o k response has a 5xx status code
+func (o *GetMembersMemberIDStatusHistoryOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get members member Id status history o k response a status code equal to that given
+func (o *GetMembersMemberIDStatusHistoryOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get members member Id status history o k response
+func (o *GetMembersMemberIDStatusHistoryOK) Code() int {
+	return 200
+}
+
+func (o *GetMembersMemberIDStatusHistoryOK) Error() string {
+	return fmt.Sprintf("[GET /members/{member_id}/status-history][%d] getMembersMemberIdStatusHistoryOK  %+v", 200, o.Payload)
+}
+
+func (o *GetMembersMemberIDStatusHistoryOK) String() string {
+	return fmt.Sprintf("[GET /members/{member_id}/status-history][%d] getMembersMemberIdStatusHistoryOK  %+v", 200, o.Payload)
+}
+
+func (o *GetMembersMemberIDStatusHistoryOK) GetPayload() *GetMembersMemberIDStatusHistoryOKBody {
+	return o.Payload
+}
+
+func (o *GetMembersMemberIDStatusHistoryOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(GetMembersMemberIDStatusHistoryOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetMembersMemberIDStatusHistoryNotFound creates a GetMembersMemberIDStatusHistoryNotFound with default headers values
+func NewGetMembersMemberIDStatusHistoryNotFound() *GetMembersMemberIDStatusHistoryNotFound {
+	return &GetMembersMemberIDStatusHistoryNotFound{}
+}
+
+/*
+GetMembersMemberIDStatusHistoryNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type GetMembersMemberIDStatusHistoryNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get members member Id status history not found response has a 2xx status code
+func (o *GetMembersMemberIDStatusHistoryNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get members member Id status history not found response has a 3xx status code
+func (o *GetMembersMemberIDStatusHistoryNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get members member Id status history not found response has a 4xx status code
+func (o *GetMembersMemberIDStatusHistoryNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get members member Id status history not found response has a 5xx status code
+func (o *GetMembersMemberIDStatusHistoryNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get members member Id status history not found response a status code equal to that given
+func (o *GetMembersMemberIDStatusHistoryNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get members member Id status history not found response
+func (o *GetMembersMemberIDStatusHistoryNotFound) Code() int {
+	return 404
+}
+
+func (o *GetMembersMemberIDStatusHistoryNotFound) Error() string {
+	return fmt.Sprintf("[GET /members/{member_id}/status-history][%d] getMembersMemberIdStatusHistoryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetMembersMemberIDStatusHistoryNotFound) String() string {
+	return fmt.Sprintf("[GET /members/{member_id}/status-history][%d] getMembersMemberIdStatusHistoryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetMembersMemberIDStatusHistoryNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetMembersMemberIDStatusHistoryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetMembersMemberIDStatusHistoryDefault creates a GetMembersMemberIDStatusHistoryDefault with default headers values
+func NewGetMembersMemberIDStatusHistoryDefault(code int) *GetMembersMemberIDStatusHistoryDefault {
+	return &GetMembersMemberIDStatusHistoryDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetMembersMemberIDStatusHistoryDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type GetMembersMemberIDStatusHistoryDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get members member ID status history default response has a 2xx status code
+func (o *GetMembersMemberIDStatusHistoryDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get members member ID status history default response has a 3xx status code
+func (o *GetMembersMemberIDStatusHistoryDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get members member ID status history default response has a 4xx status code
+func (o *GetMembersMemberIDStatusHistoryDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get members member ID status history default response has a 5xx status code
+func (o *GetMembersMemberIDStatusHistoryDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get members member ID status history default response a status code equal to that given
+func (o *GetMembersMemberIDStatusHistoryDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get members member ID status history default response
+func (o *GetMembersMemberIDStatusHistoryDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetMembersMemberIDStatusHistoryDefault) Error() string {
+	return fmt.Sprintf("[GET /members/{member_id}/status-history][%d] GetMembersMemberIDStatusHistory default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetMembersMemberIDStatusHistoryDefault) String() string {
+	return fmt.Sprintf("[GET /members/{member_id}/status-history][%d] GetMembersMemberIDStatusHistory default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetMembersMemberIDStatusHistoryDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetMembersMemberIDStatusHistoryDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+GetMembersMemberIDStatusHistoryOKBody get members member ID status history o k body
+swagger:model GetMembersMemberIDStatusHistoryOKBody
+*/
+type GetMembersMemberIDStatusHistoryOKBody struct {
+
+	// status history
+	StatusHistory []*models.MemberStatusTransition `json:"status_history"`
+}
+
+// Validate validates this get members member ID status history o k body
+func (o *GetMembersMemberIDStatusHistoryOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatusHistory(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetMembersMemberIDStatusHistoryOKBody) validateStatusHistory(formats strfmt.Registry) error {
+	if swag.IsZero(o.StatusHistory) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.StatusHistory); i++ {
+		if swag.IsZero(o.StatusHistory[i]) { // not required
+			continue
+		}
+
+		if o.StatusHistory[i] != nil {
+			if err := o.StatusHistory[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getMembersMemberIdStatusHistoryOK" + "." + "status_history" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("getMembersMemberIdStatusHistoryOK" + "." + "status_history" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get members member ID status history o k body based on the context it is used
+func (o *GetMembersMemberIDStatusHistoryOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateStatusHistory(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetMembersMemberIDStatusHistoryOKBody) contextValidateStatusHistory(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.StatusHistory); i++ {
+
+		if o.StatusHistory[i] != nil {
+			if err := o.StatusHistory[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getMembersMemberIdStatusHistoryOK" + "." + "status_history" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("getMembersMemberIdStatusHistoryOK" + "." + "status_history" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetMembersMemberIDStatusHistoryOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetMembersMemberIDStatusHistoryOKBody) UnmarshalBinary(b []byte) error {
+	var res GetMembersMemberIDStatusHistoryOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
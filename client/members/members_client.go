@@ -38,8 +38,12 @@ type ClientService interface {
 
 	GetMembersMemberID(params *GetMembersMemberIDParams, opts ...ClientOption) (*GetMembersMemberIDOK, error)
 
+	GetMembersMemberIDStatusHistory(params *GetMembersMemberIDStatusHistoryParams, opts ...ClientOption) (*GetMembersMemberIDStatusHistoryOK, error)
+
 	PostMembers(params *PostMembersParams, opts ...ClientOption) (*PostMembersCreated, error)
 
+	PostMembersMemberIDRetry(params *PostMembersMemberIDRetryParams, opts ...ClientOption) (*PostMembersMemberIDRetryAccepted, error)
+
 	PutMembersMemberID(params *PutMembersMemberIDParams, opts ...ClientOption) (*PutMembersMemberIDAccepted, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -156,6 +160,45 @@ func (a *Client) GetMembersMemberID(params *GetMembersMemberIDParams, opts ...Cl
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+GetMembersMemberIDStatusHistory shows member status history
+
+Lists the status transitions of a member, newest first.
+*/
+func (a *Client) GetMembersMemberIDStatusHistory(params *GetMembersMemberIDStatusHistoryParams, opts ...ClientOption) (*GetMembersMemberIDStatusHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetMembersMemberIDStatusHistoryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetMembersMemberIDStatusHistory",
+		Method:             "GET",
+		PathPattern:        "/members/{member_id}/status-history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetMembersMemberIDStatusHistoryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetMembersMemberIDStatusHistoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetMembersMemberIDStatusHistoryDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PostMembers creates new member
 */
@@ -193,6 +236,45 @@ func (a *Client) PostMembers(params *PostMembersParams, opts ...ClientOption) (*
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+PostMembersMemberIDRetry retries provisioning of a member
+
+Moves a member with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.
+*/
+func (a *Client) PostMembersMemberIDRetry(params *PostMembersMemberIDRetryParams, opts ...ClientOption) (*PostMembersMemberIDRetryAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostMembersMemberIDRetryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostMembersMemberIDRetry",
+		Method:             "POST",
+		PathPattern:        "/members/{member_id}/retry",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostMembersMemberIDRetryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostMembersMemberIDRetryAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostMembersMemberIDRetryDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PutMembersMemberID updates a member
 */
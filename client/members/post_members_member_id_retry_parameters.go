@@ -0,0 +1,157 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package members
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPostMembersMemberIDRetryParams creates a new PostMembersMemberIDRetryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPostMembersMemberIDRetryParams() *PostMembersMemberIDRetryParams {
+	return &PostMembersMemberIDRetryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPostMembersMemberIDRetryParamsWithTimeout creates a new PostMembersMemberIDRetryParams object
+// with the ability to set a timeout on a request.
+func NewPostMembersMemberIDRetryParamsWithTimeout(timeout time.Duration) *PostMembersMemberIDRetryParams {
+	return &PostMembersMemberIDRetryParams{
+		timeout: timeout,
+	}
+}
+
+// NewPostMembersMemberIDRetryParamsWithContext creates a new PostMembersMemberIDRetryParams object
+// with the ability to set a context for a request.
+func NewPostMembersMemberIDRetryParamsWithContext(ctx context.Context) *PostMembersMemberIDRetryParams {
+	return &PostMembersMemberIDRetryParams{
+		Context: ctx,
+	}
+}
+
+// NewPostMembersMemberIDRetryParamsWithHTTPClient creates a new PostMembersMemberIDRetryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPostMembersMemberIDRetryParamsWithHTTPClient(client *http.Client) *PostMembersMemberIDRetryParams {
+	return &PostMembersMemberIDRetryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PostMembersMemberIDRetryParams contains all the parameters to send to the API endpoint
+
+	for the post members member ID retry operation.
+
+	Typically these are written to a http.Request.
+*/
+type PostMembersMemberIDRetryParams struct {
+
+	/* MemberID.
+
+	   The UUID of the member
+
+	   Format: uuid
+	*/
+	MemberID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the post members member ID retry params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostMembersMemberIDRetryParams) WithDefaults() *PostMembersMemberIDRetryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the post members member ID retry params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostMembersMemberIDRetryParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the post members member ID retry params
+func (o *PostMembersMemberIDRetryParams) WithTimeout(timeout time.Duration) *PostMembersMemberIDRetryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the post members member ID retry params
+func (o *PostMembersMemberIDRetryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the post members member ID retry params
+func (o *PostMembersMemberIDRetryParams) WithContext(ctx context.Context) *PostMembersMemberIDRetryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the post members member ID retry params
+func (o *PostMembersMemberIDRetryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the post members member ID retry params
+func (o *PostMembersMemberIDRetryParams) WithHTTPClient(client *http.Client) *PostMembersMemberIDRetryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the post members member ID retry params
+func (o *PostMembersMemberIDRetryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithMemberID adds the memberID to the post members member ID retry params
+func (o *PostMembersMemberIDRetryParams) WithMemberID(memberID strfmt.UUID) *PostMembersMemberIDRetryParams {
+	o.SetMemberID(memberID)
+	return o
+}
+
+// SetMemberID adds the memberId to the post members member ID retry params
+func (o *PostMembersMemberIDRetryParams) SetMemberID(memberID strfmt.UUID) {
+	o.MemberID = memberID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PostMembersMemberIDRetryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param member_id
+	if err := r.SetPathParam("member_id", o.MemberID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,428 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package members
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostMembersMemberIDRetryReader is a Reader for the PostMembersMemberIDRetry structure.
+type PostMembersMemberIDRetryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostMembersMemberIDRetryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewPostMembersMemberIDRetryAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewPostMembersMemberIDRetryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewPostMembersMemberIDRetryConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPostMembersMemberIDRetryDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostMembersMemberIDRetryAccepted creates a PostMembersMemberIDRetryAccepted with default headers values
+func NewPostMembersMemberIDRetryAccepted() *PostMembersMemberIDRetryAccepted {
+	return &PostMembersMemberIDRetryAccepted{}
+}
+
+/*
+PostMembersMemberIDRetryAccepted describes a response with status code 202, with default header values.
+
+The member will be provisioned again.
+*/
+type PostMembersMemberIDRetryAccepted struct {
+	Payload *PostMembersMemberIDRetryAcceptedBody
+}
+
+// IsSuccess returns true when this post members member Id retry accepted response has a 2xx status code
+func (o *PostMembersMemberIDRetryAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this post members member Id retry accepted response has a 3xx status code
+func (o *PostMembersMemberIDRetryAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post members member Id retry accepted response has a 4xx status code
+func (o *PostMembersMemberIDRetryAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post members member Id retry accepted response has a 5xx status code
+func (o *PostMembersMemberIDRetryAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post members member Id retry accepted response a status code equal to that given
+func (o *PostMembersMemberIDRetryAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the post members member Id retry accepted response
+func (o *PostMembersMemberIDRetryAccepted) Code() int {
+	return 202
+}
+
+func (o *PostMembersMemberIDRetryAccepted) Error() string {
+	return fmt.Sprintf("[POST /members/{member_id}/retry][%d] postMembersMemberIdRetryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostMembersMemberIDRetryAccepted) String() string {
+	return fmt.Sprintf("[POST /members/{member_id}/retry][%d] postMembersMemberIdRetryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostMembersMemberIDRetryAccepted) GetPayload() *PostMembersMemberIDRetryAcceptedBody {
+	return o.Payload
+}
+
+func (o *PostMembersMemberIDRetryAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(PostMembersMemberIDRetryAcceptedBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostMembersMemberIDRetryNotFound creates a PostMembersMemberIDRetryNotFound with default headers values
+func NewPostMembersMemberIDRetryNotFound() *PostMembersMemberIDRetryNotFound {
+	return &PostMembersMemberIDRetryNotFound{}
+}
+
+/*
+PostMembersMemberIDRetryNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PostMembersMemberIDRetryNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post members member Id retry not found response has a 2xx status code
+func (o *PostMembersMemberIDRetryNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post members member Id retry not found response has a 3xx status code
+func (o *PostMembersMemberIDRetryNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post members member Id retry not found response has a 4xx status code
+func (o *PostMembersMemberIDRetryNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post members member Id retry not found response has a 5xx status code
+func (o *PostMembersMemberIDRetryNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post members member Id retry not found response a status code equal to that given
+func (o *PostMembersMemberIDRetryNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the post members member Id retry not found response
+func (o *PostMembersMemberIDRetryNotFound) Code() int {
+	return 404
+}
+
+func (o *PostMembersMemberIDRetryNotFound) Error() string {
+	return fmt.Sprintf("[POST /members/{member_id}/retry][%d] postMembersMemberIdRetryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostMembersMemberIDRetryNotFound) String() string {
+	return fmt.Sprintf("[POST /members/{member_id}/retry][%d] postMembersMemberIdRetryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostMembersMemberIDRetryNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostMembersMemberIDRetryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostMembersMemberIDRetryConflict creates a PostMembersMemberIDRetryConflict with default headers values
+func NewPostMembersMemberIDRetryConflict() *PostMembersMemberIDRetryConflict {
+	return &PostMembersMemberIDRetryConflict{}
+}
+
+/*
+PostMembersMemberIDRetryConflict describes a response with status code 409, with default header values.
+
+Conflict
+*/
+type PostMembersMemberIDRetryConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post members member Id retry conflict response has a 2xx status code
+func (o *PostMembersMemberIDRetryConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post members member Id retry conflict response has a 3xx status code
+func (o *PostMembersMemberIDRetryConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post members member Id retry conflict response has a 4xx status code
+func (o *PostMembersMemberIDRetryConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post members member Id retry conflict response has a 5xx status code
+func (o *PostMembersMemberIDRetryConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post members member Id retry conflict response a status code equal to that given
+func (o *PostMembersMemberIDRetryConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the post members member Id retry conflict response
+func (o *PostMembersMemberIDRetryConflict) Code() int {
+	return 409
+}
+
+func (o *PostMembersMemberIDRetryConflict) Error() string {
+	return fmt.Sprintf("[POST /members/{member_id}/retry][%d] postMembersMemberIdRetryConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostMembersMemberIDRetryConflict) String() string {
+	return fmt.Sprintf("[POST /members/{member_id}/retry][%d] postMembersMemberIdRetryConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostMembersMemberIDRetryConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostMembersMemberIDRetryConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostMembersMemberIDRetryDefault creates a PostMembersMemberIDRetryDefault with default headers values
+func NewPostMembersMemberIDRetryDefault(code int) *PostMembersMemberIDRetryDefault {
+	return &PostMembersMemberIDRetryDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+PostMembersMemberIDRetryDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type PostMembersMemberIDRetryDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post members member ID retry default response has a 2xx status code
+func (o *PostMembersMemberIDRetryDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this post members member ID retry default response has a 3xx status code
+func (o *PostMembersMemberIDRetryDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this post members member ID retry default response has a 4xx status code
+func (o *PostMembersMemberIDRetryDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this post members member ID retry default response has a 5xx status code
+func (o *PostMembersMemberIDRetryDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this post members member ID retry default response a status code equal to that given
+func (o *PostMembersMemberIDRetryDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the post members member ID retry default response
+func (o *PostMembersMemberIDRetryDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostMembersMemberIDRetryDefault) Error() string {
+	return fmt.Sprintf("[POST /members/{member_id}/retry][%d] PostMembersMemberIDRetry default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostMembersMemberIDRetryDefault) String() string {
+	return fmt.Sprintf("[POST /members/{member_id}/retry][%d] PostMembersMemberIDRetry default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostMembersMemberIDRetryDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostMembersMemberIDRetryDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+PostMembersMemberIDRetryAcceptedBody post members member ID retry accepted body
+swagger:model PostMembersMemberIDRetryAcceptedBody
+*/
+type PostMembersMemberIDRetryAcceptedBody struct {
+
+	// member
+	Member *models.Member `json:"member,omitempty"`
+}
+
+// Validate validates this post members member ID retry accepted body
+func (o *PostMembersMemberIDRetryAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateMember(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostMembersMemberIDRetryAcceptedBody) validateMember(formats strfmt.Registry) error {
+	if swag.IsZero(o.Member) { // not required
+		return nil
+	}
+
+	if o.Member != nil {
+		if err := o.Member.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postMembersMemberIdRetryAccepted" + "." + "member")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postMembersMemberIdRetryAccepted" + "." + "member")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post members member ID retry accepted body based on the context it is used
+func (o *PostMembersMemberIDRetryAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateMember(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostMembersMemberIDRetryAcceptedBody) contextValidateMember(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Member != nil {
+		if err := o.Member.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postMembersMemberIdRetryAccepted" + "." + "member")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postMembersMemberIdRetryAccepted" + "." + "member")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostMembersMemberIDRetryAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostMembersMemberIDRetryAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostMembersMemberIDRetryAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -40,6 +40,8 @@ type ClientService interface {
 
 	PostMonitors(params *PostMonitorsParams, opts ...ClientOption) (*PostMonitorsCreated, error)
 
+	PostMonitorsMonitorIDRetry(params *PostMonitorsMonitorIDRetryParams, opts ...ClientOption) (*PostMonitorsMonitorIDRetryAccepted, error)
+
 	PutMonitorsMonitorID(params *PutMonitorsMonitorIDParams, opts ...ClientOption) (*PutMonitorsMonitorIDAccepted, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -225,6 +227,45 @@ func (a *Client) PostMonitors(params *PostMonitorsParams, opts ...ClientOption)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+PostMonitorsMonitorIDRetry retries provisioning of a monitor
+
+Moves a monitor with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.
+*/
+func (a *Client) PostMonitorsMonitorIDRetry(params *PostMonitorsMonitorIDRetryParams, opts ...ClientOption) (*PostMonitorsMonitorIDRetryAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostMonitorsMonitorIDRetryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostMonitorsMonitorIDRetry",
+		Method:             "POST",
+		PathPattern:        "/monitors/{monitor_id}/retry",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostMonitorsMonitorIDRetryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostMonitorsMonitorIDRetryAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostMonitorsMonitorIDRetryDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PutMonitorsMonitorID updates a monitor
 */
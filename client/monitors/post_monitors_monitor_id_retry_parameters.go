@@ -0,0 +1,157 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package monitors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPostMonitorsMonitorIDRetryParams creates a new PostMonitorsMonitorIDRetryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPostMonitorsMonitorIDRetryParams() *PostMonitorsMonitorIDRetryParams {
+	return &PostMonitorsMonitorIDRetryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPostMonitorsMonitorIDRetryParamsWithTimeout creates a new PostMonitorsMonitorIDRetryParams object
+// with the ability to set a timeout on a request.
+func NewPostMonitorsMonitorIDRetryParamsWithTimeout(timeout time.Duration) *PostMonitorsMonitorIDRetryParams {
+	return &PostMonitorsMonitorIDRetryParams{
+		timeout: timeout,
+	}
+}
+
+// NewPostMonitorsMonitorIDRetryParamsWithContext creates a new PostMonitorsMonitorIDRetryParams object
+// with the ability to set a context for a request.
+func NewPostMonitorsMonitorIDRetryParamsWithContext(ctx context.Context) *PostMonitorsMonitorIDRetryParams {
+	return &PostMonitorsMonitorIDRetryParams{
+		Context: ctx,
+	}
+}
+
+// NewPostMonitorsMonitorIDRetryParamsWithHTTPClient creates a new PostMonitorsMonitorIDRetryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPostMonitorsMonitorIDRetryParamsWithHTTPClient(client *http.Client) *PostMonitorsMonitorIDRetryParams {
+	return &PostMonitorsMonitorIDRetryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PostMonitorsMonitorIDRetryParams contains all the parameters to send to the API endpoint
+
+	for the post monitors monitor ID retry operation.
+
+	Typically these are written to a http.Request.
+*/
+type PostMonitorsMonitorIDRetryParams struct {
+
+	/* MonitorID.
+
+	   The UUID of the monitor
+
+	   Format: uuid
+	*/
+	MonitorID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the post monitors monitor ID retry params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostMonitorsMonitorIDRetryParams) WithDefaults() *PostMonitorsMonitorIDRetryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the post monitors monitor ID retry params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostMonitorsMonitorIDRetryParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the post monitors monitor ID retry params
+func (o *PostMonitorsMonitorIDRetryParams) WithTimeout(timeout time.Duration) *PostMonitorsMonitorIDRetryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the post monitors monitor ID retry params
+func (o *PostMonitorsMonitorIDRetryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the post monitors monitor ID retry params
+func (o *PostMonitorsMonitorIDRetryParams) WithContext(ctx context.Context) *PostMonitorsMonitorIDRetryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the post monitors monitor ID retry params
+func (o *PostMonitorsMonitorIDRetryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the post monitors monitor ID retry params
+func (o *PostMonitorsMonitorIDRetryParams) WithHTTPClient(client *http.Client) *PostMonitorsMonitorIDRetryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the post monitors monitor ID retry params
+func (o *PostMonitorsMonitorIDRetryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithMonitorID adds the monitorID to the post monitors monitor ID retry params
+func (o *PostMonitorsMonitorIDRetryParams) WithMonitorID(monitorID strfmt.UUID) *PostMonitorsMonitorIDRetryParams {
+	o.SetMonitorID(monitorID)
+	return o
+}
+
+// SetMonitorID adds the monitorId to the post monitors monitor ID retry params
+func (o *PostMonitorsMonitorIDRetryParams) SetMonitorID(monitorID strfmt.UUID) {
+	o.MonitorID = monitorID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PostMonitorsMonitorIDRetryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param monitor_id
+	if err := r.SetPathParam("monitor_id", o.MonitorID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,428 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package monitors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostMonitorsMonitorIDRetryReader is a Reader for the PostMonitorsMonitorIDRetry structure.
+type PostMonitorsMonitorIDRetryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostMonitorsMonitorIDRetryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewPostMonitorsMonitorIDRetryAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewPostMonitorsMonitorIDRetryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewPostMonitorsMonitorIDRetryConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPostMonitorsMonitorIDRetryDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostMonitorsMonitorIDRetryAccepted creates a PostMonitorsMonitorIDRetryAccepted with default headers values
+func NewPostMonitorsMonitorIDRetryAccepted() *PostMonitorsMonitorIDRetryAccepted {
+	return &PostMonitorsMonitorIDRetryAccepted{}
+}
+
+/*
+PostMonitorsMonitorIDRetryAccepted describes a response with status code 202, with default header values.
+
+The monitor will be provisioned again.
+*/
+type PostMonitorsMonitorIDRetryAccepted struct {
+	Payload *PostMonitorsMonitorIDRetryAcceptedBody
+}
+
+// IsSuccess returns true when this post monitors monitor Id retry accepted response has a 2xx status code
+func (o *PostMonitorsMonitorIDRetryAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this post monitors monitor Id retry accepted response has a 3xx status code
+func (o *PostMonitorsMonitorIDRetryAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post monitors monitor Id retry accepted response has a 4xx status code
+func (o *PostMonitorsMonitorIDRetryAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post monitors monitor Id retry accepted response has a 5xx status code
+func (o *PostMonitorsMonitorIDRetryAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post monitors monitor Id retry accepted response a status code equal to that given
+func (o *PostMonitorsMonitorIDRetryAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the post monitors monitor Id retry accepted response
+func (o *PostMonitorsMonitorIDRetryAccepted) Code() int {
+	return 202
+}
+
+func (o *PostMonitorsMonitorIDRetryAccepted) Error() string {
+	return fmt.Sprintf("[POST /monitors/{monitor_id}/retry][%d] postMonitorsMonitorIdRetryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostMonitorsMonitorIDRetryAccepted) String() string {
+	return fmt.Sprintf("[POST /monitors/{monitor_id}/retry][%d] postMonitorsMonitorIdRetryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostMonitorsMonitorIDRetryAccepted) GetPayload() *PostMonitorsMonitorIDRetryAcceptedBody {
+	return o.Payload
+}
+
+func (o *PostMonitorsMonitorIDRetryAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(PostMonitorsMonitorIDRetryAcceptedBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostMonitorsMonitorIDRetryNotFound creates a PostMonitorsMonitorIDRetryNotFound with default headers values
+func NewPostMonitorsMonitorIDRetryNotFound() *PostMonitorsMonitorIDRetryNotFound {
+	return &PostMonitorsMonitorIDRetryNotFound{}
+}
+
+/*
+PostMonitorsMonitorIDRetryNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PostMonitorsMonitorIDRetryNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post monitors monitor Id retry not found response has a 2xx status code
+func (o *PostMonitorsMonitorIDRetryNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post monitors monitor Id retry not found response has a 3xx status code
+func (o *PostMonitorsMonitorIDRetryNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post monitors monitor Id retry not found response has a 4xx status code
+func (o *PostMonitorsMonitorIDRetryNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post monitors monitor Id retry not found response has a 5xx status code
+func (o *PostMonitorsMonitorIDRetryNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post monitors monitor Id retry not found response a status code equal to that given
+func (o *PostMonitorsMonitorIDRetryNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the post monitors monitor Id retry not found response
+func (o *PostMonitorsMonitorIDRetryNotFound) Code() int {
+	return 404
+}
+
+func (o *PostMonitorsMonitorIDRetryNotFound) Error() string {
+	return fmt.Sprintf("[POST /monitors/{monitor_id}/retry][%d] postMonitorsMonitorIdRetryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostMonitorsMonitorIDRetryNotFound) String() string {
+	return fmt.Sprintf("[POST /monitors/{monitor_id}/retry][%d] postMonitorsMonitorIdRetryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostMonitorsMonitorIDRetryNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostMonitorsMonitorIDRetryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostMonitorsMonitorIDRetryConflict creates a PostMonitorsMonitorIDRetryConflict with default headers values
+func NewPostMonitorsMonitorIDRetryConflict() *PostMonitorsMonitorIDRetryConflict {
+	return &PostMonitorsMonitorIDRetryConflict{}
+}
+
+/*
+PostMonitorsMonitorIDRetryConflict describes a response with status code 409, with default header values.
+
+Conflict
+*/
+type PostMonitorsMonitorIDRetryConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post monitors monitor Id retry conflict response has a 2xx status code
+func (o *PostMonitorsMonitorIDRetryConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post monitors monitor Id retry conflict response has a 3xx status code
+func (o *PostMonitorsMonitorIDRetryConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post monitors monitor Id retry conflict response has a 4xx status code
+func (o *PostMonitorsMonitorIDRetryConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post monitors monitor Id retry conflict response has a 5xx status code
+func (o *PostMonitorsMonitorIDRetryConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post monitors monitor Id retry conflict response a status code equal to that given
+func (o *PostMonitorsMonitorIDRetryConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the post monitors monitor Id retry conflict response
+func (o *PostMonitorsMonitorIDRetryConflict) Code() int {
+	return 409
+}
+
+func (o *PostMonitorsMonitorIDRetryConflict) Error() string {
+	return fmt.Sprintf("[POST /monitors/{monitor_id}/retry][%d] postMonitorsMonitorIdRetryConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostMonitorsMonitorIDRetryConflict) String() string {
+	return fmt.Sprintf("[POST /monitors/{monitor_id}/retry][%d] postMonitorsMonitorIdRetryConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostMonitorsMonitorIDRetryConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostMonitorsMonitorIDRetryConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostMonitorsMonitorIDRetryDefault creates a PostMonitorsMonitorIDRetryDefault with default headers values
+func NewPostMonitorsMonitorIDRetryDefault(code int) *PostMonitorsMonitorIDRetryDefault {
+	return &PostMonitorsMonitorIDRetryDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+PostMonitorsMonitorIDRetryDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type PostMonitorsMonitorIDRetryDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post monitors monitor ID retry default response has a 2xx status code
+func (o *PostMonitorsMonitorIDRetryDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this post monitors monitor ID retry default response has a 3xx status code
+func (o *PostMonitorsMonitorIDRetryDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this post monitors monitor ID retry default response has a 4xx status code
+func (o *PostMonitorsMonitorIDRetryDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this post monitors monitor ID retry default response has a 5xx status code
+func (o *PostMonitorsMonitorIDRetryDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this post monitors monitor ID retry default response a status code equal to that given
+func (o *PostMonitorsMonitorIDRetryDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the post monitors monitor ID retry default response
+func (o *PostMonitorsMonitorIDRetryDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostMonitorsMonitorIDRetryDefault) Error() string {
+	return fmt.Sprintf("[POST /monitors/{monitor_id}/retry][%d] PostMonitorsMonitorIDRetry default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostMonitorsMonitorIDRetryDefault) String() string {
+	return fmt.Sprintf("[POST /monitors/{monitor_id}/retry][%d] PostMonitorsMonitorIDRetry default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostMonitorsMonitorIDRetryDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostMonitorsMonitorIDRetryDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+PostMonitorsMonitorIDRetryAcceptedBody post monitors monitor ID retry accepted body
+swagger:model PostMonitorsMonitorIDRetryAcceptedBody
+*/
+type PostMonitorsMonitorIDRetryAcceptedBody struct {
+
+	// monitor
+	Monitor *models.Monitor `json:"monitor,omitempty"`
+}
+
+// Validate validates this post monitors monitor ID retry accepted body
+func (o *PostMonitorsMonitorIDRetryAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateMonitor(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostMonitorsMonitorIDRetryAcceptedBody) validateMonitor(formats strfmt.Registry) error {
+	if swag.IsZero(o.Monitor) { // not required
+		return nil
+	}
+
+	if o.Monitor != nil {
+		if err := o.Monitor.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postMonitorsMonitorIdRetryAccepted" + "." + "monitor")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postMonitorsMonitorIdRetryAccepted" + "." + "monitor")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post monitors monitor ID retry accepted body based on the context it is used
+func (o *PostMonitorsMonitorIDRetryAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateMonitor(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostMonitorsMonitorIDRetryAcceptedBody) contextValidateMonitor(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Monitor != nil {
+		if err := o.Monitor.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postMonitorsMonitorIdRetryAccepted" + "." + "monitor")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postMonitorsMonitorIdRetryAccepted" + "." + "monitor")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostMonitorsMonitorIDRetryAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostMonitorsMonitorIDRetryAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostMonitorsMonitorIDRetryAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
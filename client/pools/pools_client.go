@@ -40,6 +40,8 @@ type ClientService interface {
 
 	PostPools(params *PostPoolsParams, opts ...ClientOption) (*PostPoolsCreated, error)
 
+	PostPoolsPoolIDRetry(params *PostPoolsPoolIDRetryParams, opts ...ClientOption) (*PostPoolsPoolIDRetryAccepted, error)
+
 	PutPoolsPoolID(params *PutPoolsPoolIDParams, opts ...ClientOption) (*PutPoolsPoolIDAccepted, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -193,6 +195,45 @@ func (a *Client) PostPools(params *PostPoolsParams, opts ...ClientOption) (*Post
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+PostPoolsPoolIDRetry retries provisioning of a pool
+
+Moves a pool with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.
+*/
+func (a *Client) PostPoolsPoolIDRetry(params *PostPoolsPoolIDRetryParams, opts ...ClientOption) (*PostPoolsPoolIDRetryAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostPoolsPoolIDRetryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostPoolsPoolIDRetry",
+		Method:             "POST",
+		PathPattern:        "/pools/{pool_id}/retry",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostPoolsPoolIDRetryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostPoolsPoolIDRetryAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostPoolsPoolIDRetryDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PutPoolsPoolID updates a pool
 */
@@ -0,0 +1,157 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pools
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPostPoolsPoolIDRetryParams creates a new PostPoolsPoolIDRetryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPostPoolsPoolIDRetryParams() *PostPoolsPoolIDRetryParams {
+	return &PostPoolsPoolIDRetryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPostPoolsPoolIDRetryParamsWithTimeout creates a new PostPoolsPoolIDRetryParams object
+// with the ability to set a timeout on a request.
+func NewPostPoolsPoolIDRetryParamsWithTimeout(timeout time.Duration) *PostPoolsPoolIDRetryParams {
+	return &PostPoolsPoolIDRetryParams{
+		timeout: timeout,
+	}
+}
+
+// NewPostPoolsPoolIDRetryParamsWithContext creates a new PostPoolsPoolIDRetryParams object
+// with the ability to set a context for a request.
+func NewPostPoolsPoolIDRetryParamsWithContext(ctx context.Context) *PostPoolsPoolIDRetryParams {
+	return &PostPoolsPoolIDRetryParams{
+		Context: ctx,
+	}
+}
+
+// NewPostPoolsPoolIDRetryParamsWithHTTPClient creates a new PostPoolsPoolIDRetryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPostPoolsPoolIDRetryParamsWithHTTPClient(client *http.Client) *PostPoolsPoolIDRetryParams {
+	return &PostPoolsPoolIDRetryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PostPoolsPoolIDRetryParams contains all the parameters to send to the API endpoint
+
+	for the post pools pool ID retry operation.
+
+	Typically these are written to a http.Request.
+*/
+type PostPoolsPoolIDRetryParams struct {
+
+	/* PoolID.
+
+	   The UUID of the pool
+
+	   Format: uuid
+	*/
+	PoolID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the post pools pool ID retry params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostPoolsPoolIDRetryParams) WithDefaults() *PostPoolsPoolIDRetryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the post pools pool ID retry params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostPoolsPoolIDRetryParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the post pools pool ID retry params
+func (o *PostPoolsPoolIDRetryParams) WithTimeout(timeout time.Duration) *PostPoolsPoolIDRetryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the post pools pool ID retry params
+func (o *PostPoolsPoolIDRetryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the post pools pool ID retry params
+func (o *PostPoolsPoolIDRetryParams) WithContext(ctx context.Context) *PostPoolsPoolIDRetryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the post pools pool ID retry params
+func (o *PostPoolsPoolIDRetryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the post pools pool ID retry params
+func (o *PostPoolsPoolIDRetryParams) WithHTTPClient(client *http.Client) *PostPoolsPoolIDRetryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the post pools pool ID retry params
+func (o *PostPoolsPoolIDRetryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithPoolID adds the poolID to the post pools pool ID retry params
+func (o *PostPoolsPoolIDRetryParams) WithPoolID(poolID strfmt.UUID) *PostPoolsPoolIDRetryParams {
+	o.SetPoolID(poolID)
+	return o
+}
+
+// SetPoolID adds the poolId to the post pools pool ID retry params
+func (o *PostPoolsPoolIDRetryParams) SetPoolID(poolID strfmt.UUID) {
+	o.PoolID = poolID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PostPoolsPoolIDRetryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param pool_id
+	if err := r.SetPathParam("pool_id", o.PoolID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
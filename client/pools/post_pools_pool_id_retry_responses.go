@@ -0,0 +1,428 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pools
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostPoolsPoolIDRetryReader is a Reader for the PostPoolsPoolIDRetry structure.
+type PostPoolsPoolIDRetryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostPoolsPoolIDRetryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewPostPoolsPoolIDRetryAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewPostPoolsPoolIDRetryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewPostPoolsPoolIDRetryConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPostPoolsPoolIDRetryDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostPoolsPoolIDRetryAccepted creates a PostPoolsPoolIDRetryAccepted with default headers values
+func NewPostPoolsPoolIDRetryAccepted() *PostPoolsPoolIDRetryAccepted {
+	return &PostPoolsPoolIDRetryAccepted{}
+}
+
+/*
+PostPoolsPoolIDRetryAccepted describes a response with status code 202, with default header values.
+
+The pool will be provisioned again.
+*/
+type PostPoolsPoolIDRetryAccepted struct {
+	Payload *PostPoolsPoolIDRetryAcceptedBody
+}
+
+// IsSuccess returns true when this post pools pool Id retry accepted response has a 2xx status code
+func (o *PostPoolsPoolIDRetryAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this post pools pool Id retry accepted response has a 3xx status code
+func (o *PostPoolsPoolIDRetryAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post pools pool Id retry accepted response has a 4xx status code
+func (o *PostPoolsPoolIDRetryAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post pools pool Id retry accepted response has a 5xx status code
+func (o *PostPoolsPoolIDRetryAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post pools pool Id retry accepted response a status code equal to that given
+func (o *PostPoolsPoolIDRetryAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the post pools pool Id retry accepted response
+func (o *PostPoolsPoolIDRetryAccepted) Code() int {
+	return 202
+}
+
+func (o *PostPoolsPoolIDRetryAccepted) Error() string {
+	return fmt.Sprintf("[POST /pools/{pool_id}/retry][%d] postPoolsPoolIdRetryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostPoolsPoolIDRetryAccepted) String() string {
+	return fmt.Sprintf("[POST /pools/{pool_id}/retry][%d] postPoolsPoolIdRetryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *PostPoolsPoolIDRetryAccepted) GetPayload() *PostPoolsPoolIDRetryAcceptedBody {
+	return o.Payload
+}
+
+func (o *PostPoolsPoolIDRetryAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(PostPoolsPoolIDRetryAcceptedBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostPoolsPoolIDRetryNotFound creates a PostPoolsPoolIDRetryNotFound with default headers values
+func NewPostPoolsPoolIDRetryNotFound() *PostPoolsPoolIDRetryNotFound {
+	return &PostPoolsPoolIDRetryNotFound{}
+}
+
+/*
+PostPoolsPoolIDRetryNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PostPoolsPoolIDRetryNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post pools pool Id retry not found response has a 2xx status code
+func (o *PostPoolsPoolIDRetryNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post pools pool Id retry not found response has a 3xx status code
+func (o *PostPoolsPoolIDRetryNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post pools pool Id retry not found response has a 4xx status code
+func (o *PostPoolsPoolIDRetryNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post pools pool Id retry not found response has a 5xx status code
+func (o *PostPoolsPoolIDRetryNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post pools pool Id retry not found response a status code equal to that given
+func (o *PostPoolsPoolIDRetryNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the post pools pool Id retry not found response
+func (o *PostPoolsPoolIDRetryNotFound) Code() int {
+	return 404
+}
+
+func (o *PostPoolsPoolIDRetryNotFound) Error() string {
+	return fmt.Sprintf("[POST /pools/{pool_id}/retry][%d] postPoolsPoolIdRetryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostPoolsPoolIDRetryNotFound) String() string {
+	return fmt.Sprintf("[POST /pools/{pool_id}/retry][%d] postPoolsPoolIdRetryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PostPoolsPoolIDRetryNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostPoolsPoolIDRetryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostPoolsPoolIDRetryConflict creates a PostPoolsPoolIDRetryConflict with default headers values
+func NewPostPoolsPoolIDRetryConflict() *PostPoolsPoolIDRetryConflict {
+	return &PostPoolsPoolIDRetryConflict{}
+}
+
+/*
+PostPoolsPoolIDRetryConflict describes a response with status code 409, with default header values.
+
+Conflict
+*/
+type PostPoolsPoolIDRetryConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post pools pool Id retry conflict response has a 2xx status code
+func (o *PostPoolsPoolIDRetryConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post pools pool Id retry conflict response has a 3xx status code
+func (o *PostPoolsPoolIDRetryConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post pools pool Id retry conflict response has a 4xx status code
+func (o *PostPoolsPoolIDRetryConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post pools pool Id retry conflict response has a 5xx status code
+func (o *PostPoolsPoolIDRetryConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post pools pool Id retry conflict response a status code equal to that given
+func (o *PostPoolsPoolIDRetryConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the post pools pool Id retry conflict response
+func (o *PostPoolsPoolIDRetryConflict) Code() int {
+	return 409
+}
+
+func (o *PostPoolsPoolIDRetryConflict) Error() string {
+	return fmt.Sprintf("[POST /pools/{pool_id}/retry][%d] postPoolsPoolIdRetryConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostPoolsPoolIDRetryConflict) String() string {
+	return fmt.Sprintf("[POST /pools/{pool_id}/retry][%d] postPoolsPoolIdRetryConflict  %+v", 409, o.Payload)
+}
+
+func (o *PostPoolsPoolIDRetryConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostPoolsPoolIDRetryConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostPoolsPoolIDRetryDefault creates a PostPoolsPoolIDRetryDefault with default headers values
+func NewPostPoolsPoolIDRetryDefault(code int) *PostPoolsPoolIDRetryDefault {
+	return &PostPoolsPoolIDRetryDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+PostPoolsPoolIDRetryDefault describes a response with status code -1, with default header values.
+
+Unexpected Error
+*/
+type PostPoolsPoolIDRetryDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this post pools pool ID retry default response has a 2xx status code
+func (o *PostPoolsPoolIDRetryDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this post pools pool ID retry default response has a 3xx status code
+func (o *PostPoolsPoolIDRetryDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this post pools pool ID retry default response has a 4xx status code
+func (o *PostPoolsPoolIDRetryDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this post pools pool ID retry default response has a 5xx status code
+func (o *PostPoolsPoolIDRetryDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this post pools pool ID retry default response a status code equal to that given
+func (o *PostPoolsPoolIDRetryDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the post pools pool ID retry default response
+func (o *PostPoolsPoolIDRetryDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostPoolsPoolIDRetryDefault) Error() string {
+	return fmt.Sprintf("[POST /pools/{pool_id}/retry][%d] PostPoolsPoolIDRetry default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostPoolsPoolIDRetryDefault) String() string {
+	return fmt.Sprintf("[POST /pools/{pool_id}/retry][%d] PostPoolsPoolIDRetry default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PostPoolsPoolIDRetryDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostPoolsPoolIDRetryDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+PostPoolsPoolIDRetryAcceptedBody post pools pool ID retry accepted body
+swagger:model PostPoolsPoolIDRetryAcceptedBody
+*/
+type PostPoolsPoolIDRetryAcceptedBody struct {
+
+	// pool
+	Pool *models.Pool `json:"pool,omitempty"`
+}
+
+// Validate validates this post pools pool ID retry accepted body
+func (o *PostPoolsPoolIDRetryAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validatePool(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostPoolsPoolIDRetryAcceptedBody) validatePool(formats strfmt.Registry) error {
+	if swag.IsZero(o.Pool) { // not required
+		return nil
+	}
+
+	if o.Pool != nil {
+		if err := o.Pool.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postPoolsPoolIdRetryAccepted" + "." + "pool")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postPoolsPoolIdRetryAccepted" + "." + "pool")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post pools pool ID retry accepted body based on the context it is used
+func (o *PostPoolsPoolIDRetryAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidatePool(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostPoolsPoolIDRetryAcceptedBody) contextValidatePool(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Pool != nil {
+		if err := o.Pool.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postPoolsPoolIdRetryAccepted" + "." + "pool")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postPoolsPoolIdRetryAccepted" + "." + "pool")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostPoolsPoolIDRetryAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostPoolsPoolIDRetryAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostPoolsPoolIDRetryAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
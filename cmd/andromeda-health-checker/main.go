@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"github.com/urfave/cli/v2"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/healthcheck"
+)
+
+func main() {
+	config.ParseArgsAndRun("andromeda-health-checker", "andromeda health checker",
+		func(c *cli.Context) error {
+			return healthcheck.HealthCheck()
+		})
+}
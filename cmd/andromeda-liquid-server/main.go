@@ -59,7 +59,7 @@ func (rt *ReauthTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	return resp, nil
 }
 
-var currentLiquidVersion = int64(2)
+var currentLiquidVersion = int64(3)
 
 var defaultServiceUsageReport = liquid.ServiceUsageReport{
 	InfoVersion: currentLiquidVersion,
@@ -82,6 +82,12 @@ var defaultServiceUsageReport = liquid.ServiceUsageReport{
 				liquid.AvailabilityZoneAny: {Usage: 0},
 			},
 		},
+		"domains_dns": {
+			Quota: option.Some(int64(0)),
+			PerAZ: map[liquid.AvailabilityZone]*liquid.AZResourceUsageReport{
+				liquid.AvailabilityZoneAny: {Usage: 0},
+			},
+		},
 		"members": {
 			Quota: option.Some(int64(0)),
 			PerAZ: map[liquid.AvailabilityZone]*liquid.AZResourceUsageReport{
@@ -160,6 +166,12 @@ func (l *liquidLogic) BuildServiceInfo(_ context.Context) (liquid.ServiceInfo, e
 				HasQuota:    true,
 				Topology:    liquid.FlatTopology,
 			},
+			"domains_dns": {
+				DisplayName: "Domains (DNS)",
+				HasCapacity: false,
+				HasQuota:    true,
+				Topology:    liquid.FlatTopology,
+			},
 			"members": {
 				DisplayName: "Members",
 				HasCapacity: false,
@@ -220,6 +232,12 @@ func (l *liquidLogic) ScanUsage(ctx context.Context, projectUUID string, req liq
 					liquid.AvailabilityZoneAny: {Usage: uint64(resp.Payload.Quota.QuotaUsage.InUseDomainF5)},
 				},
 			},
+			"domains_dns": {
+				Quota: options.FromPointer(resp.Payload.Quota.DomainDNS),
+				PerAZ: map[liquid.AvailabilityZone]*liquid.AZResourceUsageReport{
+					liquid.AvailabilityZoneAny: {Usage: uint64(resp.Payload.Quota.QuotaUsage.InUseDomainDNS)},
+				},
+			},
 			"members": {
 				Quota: options.FromPointer(resp.Payload.Quota.Member),
 				PerAZ: map[liquid.AvailabilityZone]*liquid.AZResourceUsageReport{
@@ -249,6 +267,7 @@ func (l *liquidLogic) SetQuota(ctx context.Context, projectUUID string, req liqu
 		Datacenter:   func(num uint64) *int64 { i := int64(num); return &i }(req.Resources["datacenters"].Quota),
 		DomainAkamai: func(num uint64) *int64 { i := int64(num); return &i }(req.Resources["domains_akamai"].Quota),
 		DomainF5:     func(num uint64) *int64 { i := int64(num); return &i }(req.Resources["domains_f5"].Quota),
+		DomainDNS:    func(num uint64) *int64 { i := int64(num); return &i }(req.Resources["domains_dns"].Quota),
 		Member:       func(num uint64) *int64 { i := int64(num); return &i }(req.Resources["members"].Quota),
 		Monitor:      func(num uint64) *int64 { i := int64(num); return &i }(req.Resources["monitors"].Quota),
 		Pool:         func(num uint64) *int64 { i := int64(num); return &i }(req.Resources["pools"].Quota),
@@ -0,0 +1,5 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE `quota` ADD COLUMN `domain_dns` bigint(20) NOT NULL DEFAULT 0 AFTER `domain_f5`;
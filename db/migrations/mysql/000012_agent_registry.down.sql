@@ -0,0 +1,16 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- keep a single registration per host
+DELETE a FROM `agent` a JOIN `agent` b ON a.`host` = b.`host` AND a.`type` > b.`type`;
+
+ALTER TABLE `agent`
+    DROP PRIMARY KEY,
+    DROP COLUMN `type`,
+    MODIFY `admin_state_up` BOOLEAN,
+    DROP COLUMN `version`,
+    DROP COLUMN `capabilities`,
+    MODIFY `heartbeat` TIMESTAMP NOT NULL,
+    DROP COLUMN `last_sync`,
+    ADD PRIMARY KEY (`host`);
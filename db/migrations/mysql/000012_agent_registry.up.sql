@@ -0,0 +1,19 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+UPDATE `agent` SET `admin_state_up` = true WHERE `admin_state_up` IS NULL;
+
+ALTER TABLE `agent`
+    DROP PRIMARY KEY,
+    MODIFY `host` VARCHAR(255) NOT NULL,
+    ADD COLUMN `type` VARCHAR(64) NOT NULL DEFAULT '' AFTER `host`,
+    MODIFY `admin_state_up` BOOLEAN NOT NULL DEFAULT true,
+    ADD COLUMN `version` VARCHAR(64) AFTER `admin_state_up`,
+    ADD COLUMN `capabilities` JSON AFTER `providers`,
+    MODIFY `heartbeat` DATETIME NOT NULL,
+    ADD COLUMN `last_sync` DATETIME,
+    ADD PRIMARY KEY (`host`, `type`);
+
+-- existing registrations keep an empty type, agents register with theirs
+ALTER TABLE `agent` ALTER COLUMN `type` DROP DEFAULT;
@@ -0,0 +1,16 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+CREATE TABLE `member_status_history`
+(
+    `id`              BIGINT      NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    `member_id`       VARCHAR(36) NOT NULL,
+    `status`          VARCHAR(16) NOT NULL,
+    `previous_status` VARCHAR(16) NOT NULL,
+    `created_at`      DATETIME    NOT NULL DEFAULT now(),
+    CONSTRAINT FOREIGN KEY (`member_id`) REFERENCES `member` (`id`) ON DELETE CASCADE,
+    INDEX (`member_id`, `created_at`)
+) ENGINE = InnoDB;
+
+ALTER TABLE `member` ADD COLUMN `flapping` BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,10 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE `domain` DROP COLUMN `provisioning_error`, DROP COLUMN `last_error_at`;
+ALTER TABLE `pool` DROP COLUMN `provisioning_error`, DROP COLUMN `last_error_at`;
+ALTER TABLE `member` DROP COLUMN `provisioning_error`, DROP COLUMN `last_error_at`;
+ALTER TABLE `monitor` DROP COLUMN `provisioning_error`, DROP COLUMN `last_error_at`;
+ALTER TABLE `datacenter` DROP COLUMN `provisioning_error`, DROP COLUMN `last_error_at`;
+ALTER TABLE `geographic_map` DROP COLUMN `provisioning_error`, DROP COLUMN `last_error_at`;
@@ -0,0 +1,10 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE `domain` ADD COLUMN `provisioning_error` TEXT NULL, ADD COLUMN `last_error_at` DATETIME NULL;
+ALTER TABLE `pool` ADD COLUMN `provisioning_error` TEXT NULL, ADD COLUMN `last_error_at` DATETIME NULL;
+ALTER TABLE `member` ADD COLUMN `provisioning_error` TEXT NULL, ADD COLUMN `last_error_at` DATETIME NULL;
+ALTER TABLE `monitor` ADD COLUMN `provisioning_error` TEXT NULL, ADD COLUMN `last_error_at` DATETIME NULL;
+ALTER TABLE `datacenter` ADD COLUMN `provisioning_error` TEXT NULL, ADD COLUMN `last_error_at` DATETIME NULL;
+ALTER TABLE `geographic_map` ADD COLUMN `provisioning_error` TEXT NULL, ADD COLUMN `last_error_at` DATETIME NULL;
@@ -0,0 +1,11 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+CREATE TABLE `domain_alias`
+(
+    `domain_id` VARCHAR(36)  NOT NULL,
+    `alias`     VARCHAR(512) NOT NULL,
+    CONSTRAINT FOREIGN KEY (`domain_id`) REFERENCES `domain` (`id`) ON DELETE CASCADE,
+    CONSTRAINT PRIMARY KEY (`domain_id`, `alias`)
+) ENGINE = InnoDB;
@@ -0,0 +1,9 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE `domain`
+    ADD COLUMN `ttl` INT NOT NULL DEFAULT 30,
+    ADD COLUMN `failover_delay` INT NOT NULL DEFAULT 0,
+    ADD COLUMN `failback_delay` INT NOT NULL DEFAULT 0,
+    ADD COLUMN `handout_limit` INT NOT NULL DEFAULT 0;
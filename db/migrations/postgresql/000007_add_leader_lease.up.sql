@@ -0,0 +1,11 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+CREATE TABLE leader_lease
+(
+    name        VARCHAR(64)  PRIMARY KEY,
+    holder      VARCHAR(255) NOT NULL,
+    acquired_at TIMESTAMP    NOT NULL DEFAULT now(),
+    expires_at  TIMESTAMP    NOT NULL
+);
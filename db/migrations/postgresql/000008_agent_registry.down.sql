@@ -0,0 +1,16 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- keep a single registration per host
+DELETE FROM agent a USING agent b WHERE a.host = b.host AND a.type > b.type;
+
+ALTER TABLE agent
+    DROP CONSTRAINT agent_pkey,
+    DROP COLUMN type,
+    ALTER COLUMN admin_state_up DROP NOT NULL,
+    ALTER COLUMN admin_state_up DROP DEFAULT,
+    DROP COLUMN version,
+    DROP COLUMN capabilities,
+    DROP COLUMN last_sync,
+    ADD PRIMARY KEY (host);
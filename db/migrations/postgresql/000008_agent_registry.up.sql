@@ -0,0 +1,19 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+UPDATE agent SET admin_state_up = true WHERE admin_state_up IS NULL;
+
+ALTER TABLE agent
+    DROP CONSTRAINT agent_pkey,
+    ALTER COLUMN host TYPE VARCHAR(255),
+    ADD COLUMN type VARCHAR(64) NOT NULL DEFAULT '',
+    ALTER COLUMN admin_state_up SET NOT NULL,
+    ALTER COLUMN admin_state_up SET DEFAULT true,
+    ADD COLUMN version VARCHAR(64),
+    ADD COLUMN capabilities JSON,
+    ADD COLUMN last_sync TIMESTAMP,
+    ADD PRIMARY KEY (host, type);
+
+-- existing registrations keep an empty type, agents register with theirs
+ALTER TABLE agent ALTER COLUMN type DROP DEFAULT;
@@ -0,0 +1,6 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE member DROP COLUMN flapping;
+DROP TABLE member_status_history;
@@ -0,0 +1,15 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+CREATE TABLE member_status_history
+(
+    id              BIGSERIAL PRIMARY KEY,
+    member_id       UUID        NOT NULL REFERENCES member ON DELETE CASCADE,
+    status          VARCHAR(16) NOT NULL,
+    previous_status VARCHAR(16) NOT NULL,
+    created_at      TIMESTAMP   NOT NULL DEFAULT now()
+);
+CREATE INDEX member_status_history_member_id_created_at_idx ON member_status_history (member_id, created_at);
+
+ALTER TABLE member ADD COLUMN flapping BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,10 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+CREATE TABLE domain_alias
+(
+    domain_id UUID         NOT NULL REFERENCES domain ON DELETE CASCADE,
+    alias     VARCHAR(512) NOT NULL,
+    PRIMARY KEY (domain_id, alias)
+);
@@ -0,0 +1,9 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE domain
+    DROP COLUMN ttl,
+    DROP COLUMN failover_delay,
+    DROP COLUMN failback_delay,
+    DROP COLUMN handout_limit;
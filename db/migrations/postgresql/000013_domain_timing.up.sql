@@ -0,0 +1,9 @@
+-- SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE domain
+    ADD COLUMN ttl INTEGER NOT NULL DEFAULT 30,
+    ADD COLUMN failover_delay INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN failback_delay INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN handout_limit INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"strings"
+
+	"github.com/jedib0t/go-pretty/table"
+)
+
+var AgentOptions struct {
+	AgentList `command:"list" description:"List Agents"`
+}
+
+type AgentList struct{}
+
+func (*AgentList) Execute(_ []string) error {
+	resp, err := AndromedaClient.Administrative.GetAgents(nil)
+	if err != nil {
+		return err
+	}
+
+	Table.AppendHeader(table.Row{"Host", "Type", "Version", "Providers", "Capabilities", "Status", "Heartbeat", "Last Sync"})
+	for _, agent := range resp.Payload.Agents {
+		lastSync := "-"
+		if agent.LastSync != nil {
+			lastSync = agent.LastSync.String()
+		}
+		Table.AppendRow(table.Row{agent.Host, agent.Type, agent.Version, strings.Join(agent.Providers, ", "),
+			strings.Join(agent.Capabilities, ", "), agent.Status, agent.Heartbeat, lastSync})
+	}
+	Table.Render()
+	return nil
+}
+
+func init() {
+	_, _ = Parser.AddCommand("agent", "Agents", "Agent Commands.", &AgentOptions)
+}
@@ -0,0 +1,700 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag/conv"
+	"github.com/jessevdk/go-flags"
+	"gopkg.in/yaml.v3"
+
+	"github.com/sapcc/andromeda/client/configuration"
+	"github.com/sapcc/andromeda/client/datacenters"
+	"github.com/sapcc/andromeda/client/domains"
+	"github.com/sapcc/andromeda/client/geographic_maps"
+	"github.com/sapcc/andromeda/client/members"
+	"github.com/sapcc/andromeda/client/monitors"
+	"github.com/sapcc/andromeda/client/pools"
+	"github.com/sapcc/andromeda/models"
+)
+
+// desiredState is the declarative description of the resources of a project. Resources reference each
+// other by name: domains reference pools by name, members and geomaps reference datacenters by name or ID.
+type desiredState struct {
+	Geomaps []*desiredGeomap `yaml:"geomaps"`
+	Pools   []*desiredPool   `yaml:"pools"`
+	Domains []*desiredDomain `yaml:"domains"`
+}
+
+type desiredGeomap struct {
+	Name              string `yaml:"name"`
+	Provider          string `yaml:"provider"`
+	DefaultDatacenter string `yaml:"default_datacenter"`
+	Assignments       []struct {
+		Country    string `yaml:"country"`
+		Datacenter string `yaml:"datacenter"`
+	} `yaml:"assignments"`
+}
+
+type desiredPool struct {
+	Name         string            `yaml:"name"`
+	AdminStateUp *bool             `yaml:"admin_state_up"`
+	Members      []*desiredMember  `yaml:"members"`
+	Monitors     []*desiredMonitor `yaml:"monitors"`
+}
+
+type desiredMember struct {
+	Name         *string `yaml:"name"`
+	Address      string  `yaml:"address"`
+	Port         int64   `yaml:"port"`
+	Weight       *int64  `yaml:"weight"`
+	Datacenter   string  `yaml:"datacenter"`
+	AdminStateUp *bool   `yaml:"admin_state_up"`
+}
+
+type desiredMonitor struct {
+	Name         string  `yaml:"name"`
+	Type         *string `yaml:"type"`
+	Interval     *int64  `yaml:"interval"`
+	Timeout      *int64  `yaml:"timeout"`
+	Send         *string `yaml:"send"`
+	Receive      *string `yaml:"receive"`
+	HTTPMethod   *string `yaml:"http_method"`
+	DomainName   *string `yaml:"domain_name"`
+	AdminStateUp *bool   `yaml:"admin_state_up"`
+}
+
+type desiredDomain struct {
+	Fqdn          string   `yaml:"fqdn"`
+	Provider      string   `yaml:"provider"`
+	Name          *string  `yaml:"name"`
+	Mode          *string  `yaml:"mode"`
+	RecordType    *string  `yaml:"record_type"`
+	TTL           *int64   `yaml:"ttl"`
+	FailoverDelay *int64   `yaml:"failover_delay"`
+	FailbackDelay *int64   `yaml:"failback_delay"`
+	HandoutLimit  *int64   `yaml:"handout_limit"`
+	AdminStateUp  *bool    `yaml:"admin_state_up"`
+	Pools         []string `yaml:"pools"`
+}
+
+type StateOptions struct {
+	File  flags.Filename `short:"f" long:"file" description:"Desired state file in YAML format" required:"true"`
+	Prune bool           `long:"prune" description:"Delete resources of the project missing in the desired state"`
+}
+
+type ApplyState struct {
+	StateOptions
+}
+
+type PlanState struct {
+	StateOptions
+	DetailedExitCode bool `long:"detailed-exitcode" description:"Exit with status 2 if there are changes to apply"`
+}
+
+const (
+	actionCreate  = "+"
+	actionUpdate  = "~"
+	actionDelete  = "-"
+	actionReplace = "-/+"
+)
+
+// change is a single step of a plan, run performs the change against the API
+type change struct {
+	action   string
+	resource string
+	diffs    []string
+	run      func() error
+}
+
+// planner computes the changes between the desired state and the live state of the project
+type planner struct {
+	prune       bool
+	live        *models.Configuration
+	datacenters []*models.Datacenter
+	// poolIDs maps pool names to live pools, pools created by the plan are added when applied
+	poolIDs map[string]strfmt.UUID
+	changes []*change
+}
+
+func readDesiredState(file string) (*desiredState, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var state desiredState
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+	if err = decoder.Decode(&state); err != nil {
+		return nil, fmt.Errorf("failed parsing %s: %w", file, err)
+	}
+	return &state, nil
+}
+
+// newPlan fetches the live state of the project and computes the changes to reach the desired state
+func newPlan(so *StateOptions) (*planner, error) {
+	desired, err := readDesiredState(string(so.File))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := AndromedaClient.Configuration.GetExport(configuration.NewGetExportParams())
+	if err != nil {
+		return nil, err
+	}
+	dcResp, err := AndromedaClient.Datacenters.GetDatacenters(datacenters.NewGetDatacentersParams())
+	if err != nil {
+		return nil, err
+	}
+	return computePlan(desired, resp.GetPayload(), dcResp.GetPayload().Datacenters, so.Prune)
+}
+
+// computePlan computes the changes in dependency order: geomaps, pools, monitors, members and domains
+// are created or updated first, then deletions follow in reverse order.
+func computePlan(desired *desiredState, live *models.Configuration, dcs []*models.Datacenter, prune bool) (*planner, error) {
+	p := &planner{
+		prune:       prune,
+		live:        live,
+		datacenters: dcs,
+		poolIDs:     map[string]strfmt.UUID{},
+	}
+	for _, pool := range p.live.Pools {
+		// unnamed pools can't be referenced by the desired state
+		name := conv.Value(pool.Name)
+		if name == "" {
+			continue
+		}
+		if _, ok := p.poolIDs[name]; ok {
+			return nil, fmt.Errorf("pool name %q is not unique in the project", name)
+		}
+		p.poolIDs[name] = pool.ID
+	}
+	for _, pool := range desired.Pools {
+		if pool.Name == "" {
+			return nil, errors.New("pools of the desired state must have a name")
+		}
+	}
+
+	for _, geomap := range desired.Geomaps {
+		if err := p.planGeomap(geomap); err != nil {
+			return nil, err
+		}
+	}
+	for _, pool := range desired.Pools {
+		if err := p.planPool(pool); err != nil {
+			return nil, err
+		}
+	}
+	for _, pool := range desired.Pools {
+		p.planMonitors(pool)
+	}
+	for _, pool := range desired.Pools {
+		if err := p.planMembers(pool); err != nil {
+			return nil, err
+		}
+	}
+	for _, domain := range desired.Domains {
+		if err := p.planDomain(domain); err != nil {
+			return nil, err
+		}
+	}
+	if p.prune {
+		p.planPrune(desired)
+	}
+	return p, nil
+}
+
+// resolveDatacenter returns the ID of the datacenter referenced by name or ID
+func (p *planner) resolveDatacenter(ref string) (strfmt.UUID, error) {
+	var found []strfmt.UUID
+	for _, datacenter := range p.datacenters {
+		if datacenter.ID.String() == ref {
+			return datacenter.ID, nil
+		}
+		if conv.Value(datacenter.Name) == ref {
+			found = append(found, datacenter.ID)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return "", fmt.Errorf("datacenter %q not found", ref)
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("datacenter name %q is ambiguous, use its ID", ref)
+	}
+}
+
+func (p *planner) poolName(id strfmt.UUID) string {
+	for name, poolID := range p.poolIDs {
+		if poolID == id {
+			return name
+		}
+	}
+	return id.String()
+}
+
+func (p *planner) add(action, resource string, diffs []string, run func() error) {
+	p.changes = append(p.changes, &change{action: action, resource: resource, diffs: diffs, run: run})
+}
+
+// diff appends the change of a field, fields not set in the desired state are left untouched
+func diff[T comparable](diffs []string, field string, live, desired *T) []string {
+	if desired == nil || (live != nil && *live == *desired) {
+		return diffs
+	}
+	if live == nil {
+		return append(diffs, fmt.Sprintf("%s: null => %v", field, *desired))
+	}
+	return append(diffs, fmt.Sprintf("%s: %v => %v", field, *live, *desired))
+}
+
+func (p *planner) planGeomap(desired *desiredGeomap) error {
+	defaultDatacenter, err := p.resolveDatacenter(desired.DefaultDatacenter)
+	if err != nil {
+		return err
+	}
+	geomap := &models.Geomap{
+		Name:              conv.Pointer(desired.Name),
+		Provider:          desired.Provider,
+		DefaultDatacenter: &defaultDatacenter,
+		Assignments:       []*models.GeomapAssignmentsItems0{},
+	}
+	for _, assignment := range desired.Assignments {
+		datacenter, err := p.resolveDatacenter(assignment.Datacenter)
+		if err != nil {
+			return err
+		}
+		geomap.Assignments = append(geomap.Assignments,
+			&models.GeomapAssignmentsItems0{Country: assignment.Country, Datacenter: datacenter})
+	}
+	create := func() error {
+		resp, err := AndromedaClient.GeographicMaps.PostGeomaps(geographic_maps.NewPostGeomapsParams().
+			WithGeomap(geographic_maps.PostGeomapsBody{Geomap: geomap}))
+		if err != nil {
+			return err
+		}
+		return waitForActiveGeomap(resp.GetPayload().Geomap.ID, false)
+	}
+
+	resource := fmt.Sprintf("geomap %q", desired.Name)
+	idx := slices.IndexFunc(p.live.Geomaps, func(g *models.Geomap) bool { return conv.Value(g.Name) == desired.Name })
+	if idx < 0 {
+		p.add(actionCreate, resource, nil, create)
+		return nil
+	}
+
+	// geomaps cannot be updated, changed geomaps are replaced
+	live := p.live.Geomaps[idx]
+	var diffs []string
+	diffs = diff(diffs, "provider", &live.Provider, &desired.Provider)
+	diffs = diff(diffs, "default_datacenter", live.DefaultDatacenter, &defaultDatacenter)
+	liveAssignments, desiredAssignments := assignmentKeys(live.Assignments), assignmentKeys(geomap.Assignments)
+	if !slices.Equal(liveAssignments, desiredAssignments) {
+		diffs = append(diffs, fmt.Sprintf("assignments: [%s] => [%s]",
+			strings.Join(liveAssignments, ", "), strings.Join(desiredAssignments, ", ")))
+	}
+	if len(diffs) == 0 {
+		return nil
+	}
+	// deleted first, the replacement must not exceed the geomap quota
+	p.add(actionReplace, resource, diffs, func() error {
+		if err := deleteGeomap(live.ID); err != nil {
+			return err
+		}
+		return create()
+	})
+	return nil
+}
+
+func assignmentKeys(assignments []*models.GeomapAssignmentsItems0) []string {
+	keys := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		keys = append(keys, assignment.Country+"="+assignment.Datacenter.String())
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (p *planner) planPool(desired *desiredPool) error {
+	resource := fmt.Sprintf("pool %q", desired.Name)
+	id, ok := p.poolIDs[desired.Name]
+	if !ok {
+		p.add(actionCreate, resource, nil, func() error {
+			pool := &models.Pool{Name: conv.Pointer(desired.Name), AdminStateUp: desired.AdminStateUp}
+			resp, err := AndromedaClient.Pools.PostPools(pools.NewPostPoolsParams().
+				WithPool(pools.PostPoolsBody{Pool: pool}))
+			if err != nil {
+				return err
+			}
+			p.poolIDs[desired.Name] = resp.GetPayload().Pool.ID
+			return waitForActivePool(resp.GetPayload().Pool.ID, false)
+		})
+		return nil
+	}
+
+	live := p.live.Pools[slices.IndexFunc(p.live.Pools, func(pool *models.Pool) bool { return pool.ID == id })]
+	if diffs := diff(nil, "admin_state_up", live.AdminStateUp, desired.AdminStateUp); len(diffs) > 0 {
+		p.add(actionUpdate, resource, diffs, func() error {
+			pool := &models.Pool{AdminStateUp: desired.AdminStateUp}
+			if _, err := AndromedaClient.Pools.PutPoolsPoolID(pools.NewPutPoolsPoolIDParams().
+				WithPoolID(id).WithPool(pools.PutPoolsPoolIDBody{Pool: pool})); err != nil {
+				return err
+			}
+			return waitForActivePool(id, false)
+		})
+	}
+	return nil
+}
+
+func (p *planner) planMonitors(desiredPool *desiredPool) {
+	poolID := p.poolIDs[desiredPool.Name]
+	for _, desired := range desiredPool.Monitors {
+		monitor := &models.Monitor{
+			Name:         conv.Pointer(desired.Name),
+			Type:         desired.Type,
+			Interval:     desired.Interval,
+			Timeout:      desired.Timeout,
+			Send:         desired.Send,
+			Receive:      desired.Receive,
+			HTTPMethod:   desired.HTTPMethod,
+			DomainName:   (*strfmt.Hostname)(desired.DomainName),
+			AdminStateUp: desired.AdminStateUp,
+		}
+		resource := fmt.Sprintf("monitor %q (pool %q)", desired.Name, desiredPool.Name)
+		idx := slices.IndexFunc(p.live.Monitors, func(m *models.Monitor) bool {
+			return poolID != "" && conv.Value(m.PoolID) == poolID && conv.Value(m.Name) == desired.Name
+		})
+		if idx < 0 {
+			p.add(actionCreate, resource, nil, func() error {
+				monitor.PoolID = conv.Pointer(p.poolIDs[desiredPool.Name])
+				resp, err := AndromedaClient.Monitors.PostMonitors(monitors.NewPostMonitorsParams().
+					WithMonitor(monitors.PostMonitorsBody{Monitor: monitor}))
+				if err != nil {
+					return err
+				}
+				return waitForActiveMonitor(resp.GetPayload().Monitor.ID, false)
+			})
+			continue
+		}
+
+		live := p.live.Monitors[idx]
+		var diffs []string
+		diffs = diff(diffs, "type", live.Type, desired.Type)
+		diffs = diff(diffs, "interval", live.Interval, desired.Interval)
+		diffs = diff(diffs, "timeout", live.Timeout, desired.Timeout)
+		diffs = diff(diffs, "send", live.Send, desired.Send)
+		diffs = diff(diffs, "receive", live.Receive, desired.Receive)
+		diffs = diff(diffs, "http_method", live.HTTPMethod, desired.HTTPMethod)
+		diffs = diff(diffs, "domain_name", live.DomainName, monitor.DomainName)
+		diffs = diff(diffs, "admin_state_up", live.AdminStateUp, desired.AdminStateUp)
+		if len(diffs) > 0 {
+			p.add(actionUpdate, resource, diffs, func() error {
+				if _, err := AndromedaClient.Monitors.PutMonitorsMonitorID(monitors.NewPutMonitorsMonitorIDParams().
+					WithMonitorID(live.ID).WithMonitor(monitors.PutMonitorsMonitorIDBody{Monitor: monitor})); err != nil {
+					return err
+				}
+				return waitForActiveMonitor(live.ID, false)
+			})
+		}
+	}
+}
+
+func (p *planner) planMembers(desiredPool *desiredPool) error {
+	poolID := p.poolIDs[desiredPool.Name]
+	for _, desired := range desiredPool.Members {
+		member := &models.Member{
+			Name:         desired.Name,
+			Address:      conv.Pointer(desired.Address),
+			Port:         conv.Pointer(desired.Port),
+			Weight:       desired.Weight,
+			AdminStateUp: desired.AdminStateUp,
+		}
+		if desired.Datacenter != "" {
+			datacenter, err := p.resolveDatacenter(desired.Datacenter)
+			if err != nil {
+				return err
+			}
+			member.DatacenterID = &datacenter
+		}
+		resource := fmt.Sprintf("member %s:%d (pool %q)", desired.Address, desired.Port, desiredPool.Name)
+		idx := slices.IndexFunc(p.live.Members, func(m *models.Member) bool {
+			return poolID != "" && conv.Value(m.PoolID) == poolID &&
+				conv.Value(m.Address) == desired.Address && conv.Value(m.Port) == desired.Port
+		})
+		if idx < 0 {
+			p.add(actionCreate, resource, nil, func() error {
+				member.PoolID = conv.Pointer(p.poolIDs[desiredPool.Name])
+				resp, err := AndromedaClient.Members.PostMembers(members.NewPostMembersParams().
+					WithMember(members.PostMembersBody{Member: member}))
+				if err != nil {
+					return err
+				}
+				return waitForActiveMember(resp.GetPayload().Member.ID, false)
+			})
+			continue
+		}
+
+		live := p.live.Members[idx]
+		var diffs []string
+		diffs = diff(diffs, "name", live.Name, desired.Name)
+		diffs = diff(diffs, "weight", live.Weight, desired.Weight)
+		diffs = diff(diffs, "datacenter_id", live.DatacenterID, member.DatacenterID)
+		diffs = diff(diffs, "admin_state_up", live.AdminStateUp, desired.AdminStateUp)
+		if len(diffs) > 0 {
+			p.add(actionUpdate, resource, diffs, func() error {
+				// address and port identify the member, they are left untouched
+				member.Address, member.Port = nil, nil
+				if _, err := AndromedaClient.Members.PutMembersMemberID(members.NewPutMembersMemberIDParams().
+					WithMemberID(live.ID).WithMember(members.PutMembersMemberIDBody{Member: member})); err != nil {
+					return err
+				}
+				return waitForActiveMember(live.ID, false)
+			})
+		}
+	}
+	return nil
+}
+
+func (p *planner) planDomain(desired *desiredDomain) error {
+	fqdn := strfmt.Hostname(desired.Fqdn)
+	for _, pool := range desired.Pools {
+		if _, ok := p.poolIDs[pool]; !ok && !p.creates(fmt.Sprintf("pool %q", pool)) {
+			return fmt.Errorf("domain %s references unknown pool %q", desired.Fqdn, pool)
+		}
+	}
+	domain := &models.Domain{
+		Fqdn:          &fqdn,
+		Provider:      conv.Pointer(desired.Provider),
+		Name:          desired.Name,
+		Mode:          desired.Mode,
+		RecordType:    desired.RecordType,
+		TTL:           desired.TTL,
+		FailoverDelay: desired.FailoverDelay,
+		FailbackDelay: desired.FailbackDelay,
+		HandoutLimit:  desired.HandoutLimit,
+		AdminStateUp:  desired.AdminStateUp,
+	}
+	// pools are resolved when applied, they might be created by the plan
+	resolvePools := func() {
+		if desired.Pools == nil {
+			return
+		}
+		domain.Pools = []strfmt.UUID{}
+		for _, pool := range desired.Pools {
+			domain.Pools = append(domain.Pools, p.poolIDs[pool])
+		}
+	}
+
+	resource := fmt.Sprintf("domain %q (%s)", desired.Fqdn, desired.Provider)
+	idx := slices.IndexFunc(p.live.Domains, func(d *models.Domain) bool {
+		return conv.Value(d.Fqdn) == fqdn && conv.Value(d.Provider) == desired.Provider
+	})
+	if idx < 0 {
+		p.add(actionCreate, resource, nil, func() error {
+			resolvePools()
+			resp, err := AndromedaClient.Domains.PostDomains(domains.NewPostDomainsParams().
+				WithDomain(domains.PostDomainsBody{Domain: domain}))
+			if err != nil {
+				return err
+			}
+			return waitForActiveDomain(resp.GetPayload().Domain.ID, false)
+		})
+		return nil
+	}
+
+	live := p.live.Domains[idx]
+	var diffs []string
+	diffs = diff(diffs, "name", live.Name, desired.Name)
+	diffs = diff(diffs, "mode", live.Mode, desired.Mode)
+	diffs = diff(diffs, "record_type", live.RecordType, desired.RecordType)
+	diffs = diff(diffs, "ttl", live.TTL, desired.TTL)
+	diffs = diff(diffs, "failover_delay", live.FailoverDelay, desired.FailoverDelay)
+	diffs = diff(diffs, "failback_delay", live.FailbackDelay, desired.FailbackDelay)
+	diffs = diff(diffs, "handout_limit", live.HandoutLimit, desired.HandoutLimit)
+	diffs = diff(diffs, "admin_state_up", live.AdminStateUp, desired.AdminStateUp)
+	if desired.Pools != nil {
+		livePools := make([]string, 0, len(live.Pools))
+		for _, pool := range live.Pools {
+			livePools = append(livePools, p.poolName(pool))
+		}
+		// the order of the pools is their priority
+		if !slices.Equal(livePools, desired.Pools) {
+			diffs = append(diffs, fmt.Sprintf("pools: [%s] => [%s]",
+				strings.Join(livePools, ", "), strings.Join(desired.Pools, ", ")))
+		}
+	}
+	if len(diffs) > 0 {
+		p.add(actionUpdate, resource, diffs, func() error {
+			resolvePools()
+			// FQDN and provider identify the domain, they are left untouched
+			domain.Fqdn, domain.Provider = nil, nil
+			if _, err := AndromedaClient.Domains.PutDomainsDomainID(domains.NewPutDomainsDomainIDParams().
+				WithDomainID(live.ID).WithDomain(domains.PutDomainsDomainIDBody{Domain: domain})); err != nil {
+				return err
+			}
+			return waitForActiveDomain(live.ID, false)
+		})
+	}
+	return nil
+}
+
+// creates returns whether the plan creates the resource
+func (p *planner) creates(resource string) bool {
+	return slices.ContainsFunc(p.changes, func(c *change) bool {
+		return c.action == actionCreate && c.resource == resource
+	})
+}
+
+// planPrune deletes live resources missing in the desired state, dependents first
+func (p *planner) planPrune(desired *desiredState) {
+	for _, live := range p.live.Domains {
+		if slices.ContainsFunc(desired.Domains, func(d *desiredDomain) bool {
+			return d.Fqdn == conv.Value(live.Fqdn).String() && d.Provider == conv.Value(live.Provider)
+		}) {
+			continue
+		}
+		p.add(actionDelete, fmt.Sprintf("domain %q (%s)", conv.Value(live.Fqdn), conv.Value(live.Provider)), nil,
+			func() error {
+				if _, err := AndromedaClient.Domains.DeleteDomainsDomainID(domains.NewDeleteDomainsDomainIDParams().
+					WithDomainID(live.ID)); err != nil {
+					return err
+				}
+				return waitForActiveDomain(live.ID, true)
+			})
+	}
+
+	desiredPools := map[strfmt.UUID]*desiredPool{}
+	for _, pool := range desired.Pools {
+		if id, ok := p.poolIDs[pool.Name]; ok {
+			desiredPools[id] = pool
+		}
+	}
+	// members and monitors of deleted pools are deleted along with their pool
+	for _, live := range p.live.Monitors {
+		pool, ok := desiredPools[conv.Value(live.PoolID)]
+		if !ok || slices.ContainsFunc(pool.Monitors, func(m *desiredMonitor) bool {
+			return m.Name == conv.Value(live.Name)
+		}) {
+			continue
+		}
+		p.add(actionDelete, fmt.Sprintf("monitor %q (pool %q)", conv.Value(live.Name), pool.Name), nil,
+			func() error {
+				if _, err := AndromedaClient.Monitors.DeleteMonitorsMonitorID(monitors.NewDeleteMonitorsMonitorIDParams().
+					WithMonitorID(live.ID)); err != nil {
+					return err
+				}
+				return waitForActiveMonitor(live.ID, true)
+			})
+	}
+	for _, live := range p.live.Members {
+		pool, ok := desiredPools[conv.Value(live.PoolID)]
+		if !ok || slices.ContainsFunc(pool.Members, func(m *desiredMember) bool {
+			return m.Address == conv.Value(live.Address) && m.Port == conv.Value(live.Port)
+		}) {
+			continue
+		}
+		p.add(actionDelete, fmt.Sprintf("member %s:%d (pool %q)", conv.Value(live.Address), conv.Value(live.Port),
+			pool.Name), nil, func() error {
+			if _, err := AndromedaClient.Members.DeleteMembersMemberID(members.NewDeleteMembersMemberIDParams().
+				WithMemberID(live.ID)); err != nil {
+				return err
+			}
+			return waitForActiveMember(live.ID, true)
+		})
+	}
+	for _, live := range p.live.Pools {
+		if _, ok := desiredPools[live.ID]; ok {
+			continue
+		}
+		p.add(actionDelete, fmt.Sprintf("pool %q", p.poolName(live.ID)), nil, func() error {
+			if _, err := AndromedaClient.Pools.DeletePoolsPoolID(pools.NewDeletePoolsPoolIDParams().
+				WithPoolID(live.ID)); err != nil {
+				return err
+			}
+			return waitForActivePool(live.ID, true)
+		})
+	}
+	for _, live := range p.live.Geomaps {
+		if slices.ContainsFunc(desired.Geomaps, func(g *desiredGeomap) bool { return g.Name == conv.Value(live.Name) }) {
+			continue
+		}
+		p.add(actionDelete, fmt.Sprintf("geomap %q", conv.Value(live.Name)), nil, func() error {
+			return deleteGeomap(live.ID)
+		})
+	}
+}
+
+func deleteGeomap(id strfmt.UUID) error {
+	if _, err := AndromedaClient.GeographicMaps.DeleteGeomapsGeomapID(geographic_maps.
+		NewDeleteGeomapsGeomapIDParams().WithGeomapID(id)); err != nil {
+		return err
+	}
+	return waitForActiveGeomap(id, true)
+}
+
+// print writes the plan in the style of terraform
+func (p *planner) print() {
+	if len(p.changes) == 0 {
+		fmt.Println("No changes. The live state matches the desired state.")
+		return
+	}
+
+	counts := map[string]int{}
+	for _, c := range p.changes {
+		fmt.Printf("  %3s %s\n", c.action, c.resource)
+		for _, d := range c.diffs {
+			fmt.Printf("        %s\n", d)
+		}
+		counts[c.action]++
+	}
+	fmt.Printf("\nPlan: %d to add, %d to change, %d to replace, %d to destroy.\n",
+		counts[actionCreate], counts[actionUpdate], counts[actionReplace], counts[actionDelete])
+}
+
+func (ps *PlanState) Execute(_ []string) error {
+	p, err := newPlan(&ps.StateOptions)
+	if err != nil {
+		return err
+	}
+	p.print()
+	if ps.DetailedExitCode && len(p.changes) > 0 {
+		os.Exit(2)
+	}
+	return nil
+}
+
+func (as *ApplyState) Execute(_ []string) error {
+	p, err := newPlan(&as.StateOptions)
+	if err != nil {
+		return err
+	}
+	p.print()
+
+	for _, c := range p.changes {
+		if err = c.run(); err != nil {
+			return errors.Join(fmt.Errorf("failed applying %s %s", c.action, c.resource), err)
+		}
+		fmt.Printf("%s %s: done\n", c.action, c.resource)
+	}
+	return nil
+}
+
+func init() {
+	_, _ = Parser.AddCommand("plan", "Plan desired state",
+		"Show the changes needed to reach the desired state of a file.", &PlanState{})
+	_, _ = Parser.AddCommand("apply", "Apply desired state",
+		"Create, update and, with --prune, delete resources to reach the desired state of a file. "+
+			"Changes are applied in dependency order, use --wait to wait for each change to become active.",
+		&ApplyState{})
+}
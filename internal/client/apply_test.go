@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag/conv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+func liveConfiguration() *models.Configuration {
+	fqdn := strfmt.Hostname("www.example.com")
+	dc1 := strfmt.UUID("dc1-uuid")
+	return &models.Configuration{
+		Geomaps: []*models.Geomap{{
+			ID:                "geomap1-uuid",
+			Name:              conv.Pointer("geo"),
+			Provider:          "akamai",
+			DefaultDatacenter: &dc1,
+			Assignments:       []*models.GeomapAssignmentsItems0{{Country: "DE", Datacenter: dc1}},
+		}},
+		Pools: []*models.Pool{
+			{ID: "pool1-uuid", Name: conv.Pointer("web"), AdminStateUp: conv.Pointer(true)},
+			{ID: "pool2-uuid"},
+			{ID: "pool3-uuid", Name: conv.Pointer("")},
+		},
+		Members: []*models.Member{{
+			ID:           "member1-uuid",
+			PoolID:       conv.Pointer(strfmt.UUID("pool1-uuid")),
+			Address:      conv.Pointer("192.0.2.1"),
+			Port:         conv.Pointer(int64(80)),
+			Weight:       conv.Pointer(int64(1)),
+			DatacenterID: &dc1,
+		}},
+		Monitors: []*models.Monitor{{
+			ID:     "monitor1-uuid",
+			PoolID: conv.Pointer(strfmt.UUID("pool1-uuid")),
+			Name:   conv.Pointer("http"),
+			Type:   conv.Pointer("HTTP"),
+		}},
+		Domains: []*models.Domain{{
+			ID:       "domain1-uuid",
+			Fqdn:     &fqdn,
+			Provider: conv.Pointer("akamai"),
+			Mode:     conv.Pointer("ROUND_ROBIN"),
+			Pools:    []strfmt.UUID{"pool1-uuid"},
+		}},
+	}
+}
+
+func desiredConfiguration() *desiredState {
+	return &desiredState{
+		Geomaps: []*desiredGeomap{{Name: "geo", Provider: "akamai", DefaultDatacenter: "dc1", Assignments: []struct {
+			Country    string `yaml:"country"`
+			Datacenter string `yaml:"datacenter"`
+		}{{Country: "DE", Datacenter: "dc1"}}}},
+		Pools: []*desiredPool{{
+			Name:     "web",
+			Members:  []*desiredMember{{Address: "192.0.2.1", Port: 80, Datacenter: "dc1"}},
+			Monitors: []*desiredMonitor{{Name: "http", Type: conv.Pointer("HTTP")}},
+		}},
+		Domains: []*desiredDomain{{Fqdn: "www.example.com", Provider: "akamai", Pools: []string{"web"}}},
+	}
+}
+
+func TestComputePlan(t *testing.T) {
+	datacenters := []*models.Datacenter{{ID: "dc1-uuid", Name: conv.Pointer("dc1")}}
+
+	type plannedChange struct {
+		action, resource string
+		diffs            []string
+	}
+	tests := []struct {
+		name    string
+		desired func(*desiredState)
+		prune   bool
+		changes []plannedChange
+	}{
+		{
+			name:    "No changes if the live state matches",
+			desired: func(*desiredState) {},
+		},
+		{
+			name: "Creates missing resources",
+			desired: func(d *desiredState) {
+				d.Pools = append(d.Pools, &desiredPool{
+					Name:    "api",
+					Members: []*desiredMember{{Address: "192.0.2.2", Port: 443}},
+				})
+				d.Domains = append(d.Domains, &desiredDomain{Fqdn: "api.example.com", Provider: "akamai", Pools: []string{"api"}})
+			},
+			changes: []plannedChange{
+				{action: actionCreate, resource: `pool "api"`},
+				{action: actionCreate, resource: `member 192.0.2.2:443 (pool "api")`},
+				{action: actionCreate, resource: `domain "api.example.com" (akamai)`},
+			},
+		},
+		{
+			name: "Updates changed fields",
+			desired: func(d *desiredState) {
+				d.Pools[0].Members[0].Weight = conv.Pointer(int64(5))
+				d.Domains[0].Mode = conv.Pointer("WEIGHTED")
+			},
+			changes: []plannedChange{
+				{action: actionUpdate, resource: `member 192.0.2.1:80 (pool "web")`, diffs: []string{"weight: 1 => 5"}},
+				{action: actionUpdate, resource: `domain "www.example.com" (akamai)`, diffs: []string{"mode: ROUND_ROBIN => WEIGHTED"}},
+			},
+		},
+		{
+			name: "Replaces changed geomaps in a single change",
+			desired: func(d *desiredState) {
+				d.Geomaps[0].Provider = "f5"
+			},
+			changes: []plannedChange{
+				{action: actionReplace, resource: `geomap "geo"`, diffs: []string{"provider: akamai => f5"}},
+			},
+		},
+		{
+			name: "Deletes missing resources with prune only",
+			desired: func(d *desiredState) {
+				d.Pools[0].Members = nil
+				d.Domains = nil
+			},
+		},
+		{
+			name: "Deletes missing resources including unnamed pools",
+			desired: func(d *desiredState) {
+				d.Pools[0].Monitors = nil
+				d.Domains = nil
+				d.Geomaps = nil
+			},
+			prune: true,
+			changes: []plannedChange{
+				{action: actionDelete, resource: `domain "www.example.com" (akamai)`},
+				{action: actionDelete, resource: `monitor "http" (pool "web")`},
+				{action: actionDelete, resource: `pool "pool2-uuid"`},
+				{action: actionDelete, resource: `pool "pool3-uuid"`},
+				{action: actionDelete, resource: `geomap "geo"`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := desiredConfiguration()
+			tt.desired(desired)
+			p, err := computePlan(desired, liveConfiguration(), datacenters, tt.prune)
+			require.NoError(t, err)
+
+			var changes []plannedChange
+			for _, c := range p.changes {
+				changes = append(changes, plannedChange{action: c.action, resource: c.resource, diffs: c.diffs})
+			}
+			assert.Equal(t, tt.changes, changes)
+		})
+	}
+
+	t.Run("Rejects duplicate pool names", func(t *testing.T) {
+		live := liveConfiguration()
+		live.Pools = append(live.Pools, &models.Pool{ID: "pool4-uuid", Name: conv.Pointer("web")})
+		_, err := computePlan(desiredConfiguration(), live, datacenters, false)
+		assert.ErrorContains(t, err, `pool name "web" is not unique`)
+	})
+
+	t.Run("Rejects unnamed pools in the desired state", func(t *testing.T) {
+		desired := desiredConfiguration()
+		desired.Pools = append(desired.Pools, &desiredPool{})
+		_, err := computePlan(desired, liveConfiguration(), datacenters, false)
+		assert.ErrorContains(t, err, "must have a name")
+	})
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/jessevdk/go-flags"
+	"gopkg.in/yaml.v3"
+
+	"github.com/sapcc/andromeda/client/configuration"
+	"github.com/sapcc/andromeda/models"
+)
+
+type ExportConfiguration struct {
+	ProjectID string         `short:"p" long:"project" description:"Export the configuration of this project"`
+	Output    flags.Filename `short:"o" long:"output" description:"Write the configuration to this file instead of stdout"`
+}
+
+type ImportConfiguration struct {
+	ProjectID  string `short:"p" long:"project" description:"Import the configuration into this project"`
+	Positional struct {
+		File flags.Filename `description:"Configuration file in YAML or JSON format"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+func (ec *ExportConfiguration) Execute(_ []string) error {
+	params := configuration.NewGetExportParams()
+	if ec.ProjectID != "" {
+		params.SetProjectID(&ec.ProjectID)
+	}
+	resp, err := AndromedaClient.Configuration.GetExport(params)
+	if err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(resp.GetPayload(), "", "  ")
+	if err != nil {
+		return err
+	}
+	// YAML unless JSON output is requested, converted via JSON to keep the API field names
+	if opts.Formatters.Format != "json" {
+		var doc any
+		if err = yaml.Unmarshal(out, &doc); err != nil {
+			return err
+		}
+		if out, err = yaml.Marshal(doc); err != nil {
+			return err
+		}
+	}
+
+	if ec.Output != "" {
+		return os.WriteFile(string(ec.Output), out, 0o600)
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
+
+// readConfiguration parses a configuration file, JSON is a subset of YAML
+func readConfiguration(file string) (*models.Configuration, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var doc any
+	if err = yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if data, err = json.Marshal(doc); err != nil {
+		return nil, err
+	}
+	var cfg models.Configuration
+	if err = json.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func (ic *ImportConfiguration) Execute(_ []string) error {
+	cfg, err := readConfiguration(string(ic.Positional.File))
+	if err != nil {
+		return err
+	}
+
+	params := configuration.NewPostImportParams().WithConfiguration(cfg)
+	if ic.ProjectID != "" {
+		params.SetProjectID(&ic.ProjectID)
+	}
+	resp, err := AndromedaClient.Configuration.PostImport(params)
+	if err != nil {
+		return err
+	}
+	return WriteTable(resp.GetPayload().Resources)
+}
+
+func init() {
+	_, _ = Parser.AddCommand("export", "Export configuration",
+		"Export all resources of a project as YAML, or JSON with --format json.", &ExportConfiguration{})
+	_, _ = Parser.AddCommand("import", "Import configuration",
+		"Create or update the resources of a configuration file exported with the export command.", &ImportConfiguration{})
+}
@@ -19,6 +19,7 @@ var DatacenterOptions struct {
 	DatacenterShow   `command:"show" description:"Show Datacenter"`
 	DatacenterCreate `command:"create" description:"Create Datacenter"`
 	DatacenterDelete `command:"delete" description:"Delete Datacenter"`
+	DatacenterRetry  `command:"retry" description:"Retry provisioning of Datacenter"`
 }
 
 type DatacenterList struct {
@@ -31,6 +32,12 @@ type DatacenterShow struct {
 	} `positional-args:"yes" required:"yes"`
 }
 
+type DatacenterRetry struct {
+	Positional struct {
+		UUID strfmt.UUID `description:"UUID of the datacenter"`
+	} `positional-args:"yes" required:"yes"`
+}
+
 type DatacenterCreate struct {
 	Name            string   `short:"n" long:"name" description:"Name of the Datacenter"`
 	Provider        string   `long:"provider" description:"Provider name" required:"true"`
@@ -92,6 +99,17 @@ func (*DatacenterShow) Execute(_ []string) error {
 	return WriteTable(resp.GetPayload().Datacenter)
 }
 
+func (*DatacenterRetry) Execute(_ []string) error {
+	params := datacenters.
+		NewPostDatacentersDatacenterIDRetryParams().
+		WithDatacenterID(DatacenterOptions.DatacenterRetry.Positional.UUID)
+	resp, err := AndromedaClient.Datacenters.PostDatacentersDatacenterIDRetry(params)
+	if err != nil {
+		return err
+	}
+	return WriteTable(resp.GetPayload().Datacenter)
+}
+
 func (*DatacenterDelete) Execute(_ []string) error {
 	params := datacenters.
 		NewDeleteDatacentersDatacenterIDParams().
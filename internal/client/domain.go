@@ -16,11 +16,13 @@ import (
 )
 
 var DomainOptions struct {
-	DomainList   `command:"list" description:"List Domains"`
-	DomainShow   `command:"show" description:"Show Domain"`
-	DomainCreate `command:"create" description:"Create Domain"`
-	DomainDelete `command:"delete" description:"Delete Domain"`
-	DomainSet    `command:"set" description:"Update Domain"`
+	DomainList    `command:"list" description:"List Domains"`
+	DomainShow    `command:"show" description:"Show Domain"`
+	DomainCreate  `command:"create" description:"Create Domain"`
+	DomainDelete  `command:"delete" description:"Delete Domain"`
+	DomainRetry   `command:"retry" description:"Retry provisioning of Domain"`
+	DomainRestore `command:"restore" description:"Restore deleted Domain"`
+	DomainSet     `command:"set" description:"Update Domain"`
 }
 
 type DomainList struct {
@@ -34,14 +36,30 @@ type DomainShow struct {
 	} `positional-args:"yes" required:"yes"`
 }
 
+type DomainRetry struct {
+	Positional struct {
+		UUID strfmt.UUID `description:"UUID of the domain"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+type DomainRestore struct {
+	Positional struct {
+		UUID strfmt.UUID `description:"UUID of the domain"`
+	} `positional-args:"yes" required:"yes"`
+}
+
 type DomainCreate struct {
-	Name       string        `short:"n" long:"name" description:"Name of the Domain"`
-	Provider   string        `short:"v" long:"provider" description:"Provider name" required:"true"`
-	FQDN       string        `short:"q" long:"fqdn" description:"Fully qualified domain name" required:"true"`
-	Mode       string        `short:"m" long:"mode" description:"Load balancing method to use for the references pools." default:"ROUND_ROBIN" choice:"ROUND_ROBIN" choice:"WEIGHTED" choice:"GEOGRAPHIC" choice:"AVAILABILITY"`
-	RecordType string        `short:"r" long:"recordtype" description:"Record type" default:"A"`
-	Pools      []strfmt.UUID `short:"p" long:"pool" description:"Pool ID to associate, can be specified multiple times"`
-	Disable    bool          `short:"d" long:"disable" description:"Disable Domain" optional:"true" optional-value:"false"`
+	Name          string        `short:"n" long:"name" description:"Name of the Domain"`
+	Provider      string        `short:"v" long:"provider" description:"Provider name" required:"true"`
+	FQDN          string        `short:"q" long:"fqdn" description:"Fully qualified domain name" required:"true"`
+	Mode          string        `short:"m" long:"mode" description:"Load balancing method to use for the references pools." default:"ROUND_ROBIN" choice:"ROUND_ROBIN" choice:"WEIGHTED" choice:"GEOGRAPHIC" choice:"AVAILABILITY"`
+	RecordType    string        `short:"r" long:"recordtype" description:"Record type" default:"A"`
+	TTL           *int64        `long:"ttl" description:"Time to live, in seconds, of the handed out records"`
+	FailoverDelay *int64        `long:"failover-delay" description:"Seconds a datacenter has to be down before failing over"`
+	FailbackDelay *int64        `long:"failback-delay" description:"Seconds a datacenter has to be up before failing back"`
+	HandoutLimit  *int64        `long:"handout-limit" description:"Maximum number of addresses handed out, 0 for all live addresses"`
+	Pools         []strfmt.UUID `short:"p" long:"pool" description:"Pool ID to associate, can be specified multiple times"`
+	Disable       bool          `short:"d" long:"disable" description:"Disable Domain" optional:"true" optional-value:"false"`
 }
 
 type DomainDelete struct {
@@ -54,14 +72,18 @@ type DomainSet struct {
 	Positional struct {
 		UUID strfmt.UUID `description:"UUID of the domain"`
 	} `positional-args:"yes" required:"yes"`
-	Name       string        `short:"n" long:"name" description:"Name of the Domain"`
-	FQDN       string        `short:"q" long:"fqdn" description:"Fully qualified domain name"`
-	Mode       string        `short:"m" long:"mode" description:"Load balancing method to use for the references pools." optional:"true" choice:"ROUND_ROBIN" choice:"WEIGHTED" choice:"GEOGRAPHIC" choice:"AVAILABILITY"`
-	RecordType string        `short:"r" long:"recordtype" description:"Record type"`
-	Pools      []strfmt.UUID `short:"p" long:"pool" description:"Pool ID to associate, can be specified multiple times"`
-	NoPools    bool          `long:"no-pools" description:"Remove all pools from domain" optional:"true" optional-value:"true"`
-	Disable    bool          `short:"d" long:"disable" description:"Enable Domain" optional:"true" optional-value:"true"`
-	Enable     bool          `short:"e" long:"enable" description:"Enable Domain" optional:"true" optional-value:"true"`
+	Name          string        `short:"n" long:"name" description:"Name of the Domain"`
+	FQDN          string        `short:"q" long:"fqdn" description:"Fully qualified domain name"`
+	Mode          string        `short:"m" long:"mode" description:"Load balancing method to use for the references pools." optional:"true" choice:"ROUND_ROBIN" choice:"WEIGHTED" choice:"GEOGRAPHIC" choice:"AVAILABILITY"`
+	RecordType    string        `short:"r" long:"recordtype" description:"Record type"`
+	TTL           *int64        `long:"ttl" description:"Time to live, in seconds, of the handed out records"`
+	FailoverDelay *int64        `long:"failover-delay" description:"Seconds a datacenter has to be down before failing over"`
+	FailbackDelay *int64        `long:"failback-delay" description:"Seconds a datacenter has to be up before failing back"`
+	HandoutLimit  *int64        `long:"handout-limit" description:"Maximum number of addresses handed out, 0 for all live addresses"`
+	Pools         []strfmt.UUID `short:"p" long:"pool" description:"Pool ID to associate, can be specified multiple times"`
+	NoPools       bool          `long:"no-pools" description:"Remove all pools from domain" optional:"true" optional-value:"true"`
+	Disable       bool          `short:"d" long:"disable" description:"Enable Domain" optional:"true" optional-value:"true"`
+	Enable        bool          `short:"e" long:"enable" description:"Enable Domain" optional:"true" optional-value:"true"`
 }
 
 func (*DomainList) Execute(_ []string) error {
@@ -80,12 +102,16 @@ func (*DomainList) Execute(_ []string) error {
 func (*DomainCreate) Execute(_ []string) error {
 	fqdn := strfmt.Hostname(DomainOptions.DomainCreate.FQDN)
 	domain := domains.PostDomainsBody{Domain: &models.Domain{
-		Name:       &DomainOptions.DomainCreate.Name,
-		Fqdn:       &fqdn,
-		Mode:       &DomainOptions.DomainCreate.Mode,
-		Provider:   &DomainOptions.Provider,
-		RecordType: &DomainOptions.DomainCreate.RecordType,
-		Pools:      DomainOptions.DomainCreate.Pools,
+		Name:          &DomainOptions.DomainCreate.Name,
+		Fqdn:          &fqdn,
+		Mode:          &DomainOptions.DomainCreate.Mode,
+		Provider:      &DomainOptions.Provider,
+		RecordType:    &DomainOptions.DomainCreate.RecordType,
+		Pools:         DomainOptions.DomainCreate.Pools,
+		TTL:           DomainOptions.DomainCreate.TTL,
+		FailoverDelay: DomainOptions.DomainCreate.FailoverDelay,
+		FailbackDelay: DomainOptions.DomainCreate.FailbackDelay,
+		HandoutLimit:  DomainOptions.DomainCreate.HandoutLimit,
 	}}
 	resp, err := AndromedaClient.Domains.PostDomains(domains.NewPostDomainsParams().WithDomain(domain))
 	if err != nil {
@@ -108,6 +134,28 @@ func (*DomainShow) Execute(_ []string) error {
 	return WriteTable(resp.GetPayload().Domain)
 }
 
+func (*DomainRetry) Execute(_ []string) error {
+	params := domains.
+		NewPostDomainsDomainIDRetryParams().
+		WithDomainID(DomainOptions.DomainRetry.Positional.UUID)
+	resp, err := AndromedaClient.Domains.PostDomainsDomainIDRetry(params)
+	if err != nil {
+		return err
+	}
+	return WriteTable(resp.GetPayload().Domain)
+}
+
+func (*DomainRestore) Execute(_ []string) error {
+	params := domains.
+		NewPostDomainsDomainIDRestoreParams().
+		WithDomainID(DomainOptions.DomainRestore.Positional.UUID)
+	resp, err := AndromedaClient.Domains.PostDomainsDomainIDRestore(params)
+	if err != nil {
+		return err
+	}
+	return WriteTable(resp.GetPayload().Domain)
+}
+
 func (*DomainDelete) Execute(_ []string) error {
 	params := domains.
 		NewDeleteDomainsDomainIDParams().
@@ -131,7 +179,12 @@ func (*DomainSet) Execute(_ []string) error {
 		return fmt.Errorf("cannot remove all pools and add new pools at the same time")
 	}
 
-	domain := domains.PutDomainsDomainIDBody{Domain: &models.Domain{}}
+	domain := domains.PutDomainsDomainIDBody{Domain: &models.Domain{
+		TTL:           DomainOptions.DomainSet.TTL,
+		FailoverDelay: DomainOptions.DomainSet.FailoverDelay,
+		FailbackDelay: DomainOptions.DomainSet.FailbackDelay,
+		HandoutLimit:  DomainOptions.DomainSet.HandoutLimit,
+	}}
 	if DomainOptions.DomainSet.Disable {
 		domain.Domain.AdminStateUp = conv.Pointer(false)
 	} else if DomainOptions.DomainSet.Enable {
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"encoding/json"
+
+	"github.com/jedib0t/go-pretty/table"
+)
+
+var F5Options struct {
+	F5Diff `command:"diff" description:"Show the difference between the deployed and the desired AS3 declaration"`
+}
+
+type F5Diff struct{}
+
+func (*F5Diff) Execute(_ []string) error {
+	resp, err := AndromedaClient.Administrative.GetF5Diff(nil)
+	if err != nil {
+		return err
+	}
+
+	Table.AppendHeader(table.Row{"Tenant", "Action", "Application", "Action", "Path", "Deployed", "Desired"})
+	for _, tenant := range resp.Payload.Tenants {
+		for _, app := range tenant.Applications {
+			if len(app.Changes) == 0 {
+				Table.AppendRow(table.Row{tenant.Tenant, tenant.Action, app.Application, app.Action})
+			}
+			for _, change := range app.Changes {
+				Table.AppendRow(table.Row{tenant.Tenant, tenant.Action, app.Application, app.Action,
+					change.Path, jsonValue(change.Deployed), jsonValue(change.Desired)})
+			}
+		}
+	}
+	Table.Render()
+	return nil
+}
+
+func jsonValue(v any) string {
+	if v == nil {
+		return ""
+	}
+	out, _ := json.Marshal(v)
+	return string(out)
+}
+
+func init() {
+	_, _ = Parser.AddCommand("f5", "F5", "F5 Commands.", &F5Options)
+}
@@ -19,6 +19,7 @@ var GeomapOptions struct {
 	GeomapShow   `command:"show" description:"Show Geomap"`
 	GeomapCreate `command:"create" description:"Create Geomap"`
 	GeomapDelete `command:"delete" description:"Delete Geomap"`
+	GeomapRetry  `command:"retry" description:"Retry provisioning of Geomap"`
 }
 
 type GeomapList struct {
@@ -32,6 +33,12 @@ type GeomapShow struct {
 	} `positional-args:"yes" required:"yes"`
 }
 
+type GeomapRetry struct {
+	Positional struct {
+		UUID strfmt.UUID `description:"UUID of the geographic map"`
+	} `positional-args:"yes" required:"yes"`
+}
+
 type GeomapCreate struct {
 	// Array of datacenter assignments
 	Assignment map[string]strfmt.UUID `short:"a" long:"assignment" description:"Datacenter assignment of 2-letter country code and datacenter id, e.g. --assignment=DE:UUID --assignment=US:UUID"`
@@ -95,6 +102,17 @@ func (*GeomapShow) Execute(_ []string) error {
 	return WriteTable(resp.GetPayload().Geomap)
 }
 
+func (*GeomapRetry) Execute(_ []string) error {
+	params := geographic_maps.
+		NewPostGeomapsGeomapIDRetryParams().
+		WithGeomapID(GeomapOptions.GeomapRetry.Positional.UUID)
+	resp, err := AndromedaClient.GeographicMaps.PostGeomapsGeomapIDRetry(params)
+	if err != nil {
+		return err
+	}
+	return WriteTable(resp.GetPayload().Geomap)
+}
+
 func (*GeomapDelete) Execute(_ []string) error {
 	params := geographic_maps.
 		NewDeleteGeomapsGeomapIDParams().
@@ -15,11 +15,13 @@ import (
 )
 
 var MemberOptions struct {
-	MemberList   `command:"list" description:"List Members"`
-	MemberShow   `command:"show" description:"Show Member"`
-	MemberCreate `command:"create" description:"Create Member"`
-	MemberDelete `command:"delete" description:"Delete Member"`
-	MemberSet    `command:"set" description:"Update Member"`
+	MemberList          `command:"list" description:"List Members"`
+	MemberShow          `command:"show" description:"Show Member"`
+	MemberCreate        `command:"create" description:"Create Member"`
+	MemberDelete        `command:"delete" description:"Delete Member"`
+	MemberRetry         `command:"retry" description:"Retry provisioning of Member"`
+	MemberSet           `command:"set" description:"Update Member"`
+	MemberStatusHistory `command:"status-history" description:"Show Member Status History"`
 }
 
 type MemberList struct {
@@ -34,6 +36,12 @@ type MemberShow struct {
 	} `positional-args:"yes" required:"yes"`
 }
 
+type MemberRetry struct {
+	PositionalMemberRetry struct {
+		MemberID strfmt.UUID `description:"UUID of the member"`
+	} `positional-args:"yes" required:"yes"`
+}
+
 type MemberCreate struct {
 	PositionalMemberCreate struct {
 		PoolID strfmt.UUID `description:"UUID of the pool"`
@@ -41,6 +49,7 @@ type MemberCreate struct {
 	Name         string      `short:"n" long:"name" description:"Name of the Member"`
 	Address      string      `short:"a" long:"address" description:"Address of the Member" required:"true"`
 	Port         int64       `short:"p" long:"port" description:"Port of the Member" required:"true"`
+	Weight       *int64      `short:"w" long:"weight" description:"Weight of the Member (WEIGHTED mode)"`
 	Disable      bool        `short:"d" long:"disable" description:"Disable Member" optional:"true" optional-value:"false"`
 	DatacenterID strfmt.UUID `short:"i" long:"datacenter" description:"Datacenter ID"`
 }
@@ -58,10 +67,20 @@ type MemberSet struct {
 	Name    *string `short:"n" long:"name" description:"Name of the Member"`
 	Address *string `short:"a" long:"address" description:"Address of the Member"`
 	Port    *int64  `short:"p" long:"port" description:"Port of the Member"`
+	Weight  *int64  `short:"w" long:"weight" description:"Weight of the Member (WEIGHTED mode)"`
 	Disable bool    `short:"d" long:"disable" description:"Disable Member"`
 	Enable  bool    `short:"e" long:"enable" description:"Enable Member"`
 }
 
+type MemberStatusHistory struct {
+	PositionalMemberStatusHistory struct {
+		MemberID strfmt.UUID `description:"UUID of the member"`
+	} `positional-args:"yes" required:"yes"`
+	Since string `long:"since" description:"Only transitions at or after this UTC timestamp (RFC3339)"`
+	Until string `long:"until" description:"Only transitions before this UTC timestamp (RFC3339)"`
+	Limit *int64 `short:"l" long:"limit" description:"Maximum number of transitions"`
+}
+
 func (*MemberList) Execute(_ []string) error {
 	resp, err := AndromedaClient.Members.GetMembers(members.
 		NewGetMembersParams().
@@ -79,6 +98,7 @@ func (*MemberCreate) Execute(_ []string) error {
 		Name:         &MemberOptions.MemberCreate.Name,
 		Address:      &MemberOptions.MemberCreate.Address,
 		Port:         &MemberOptions.MemberCreate.Port,
+		Weight:       MemberOptions.MemberCreate.Weight,
 		DatacenterID: &MemberOptions.DatacenterID,
 		PoolID:       &MemberOptions.PositionalMemberCreate.PoolID,
 	}}
@@ -104,6 +124,17 @@ func (*MemberShow) Execute(_ []string) error {
 	return WriteTable(resp.GetPayload().Member)
 }
 
+func (*MemberRetry) Execute(_ []string) error {
+	params := members.
+		NewPostMembersMemberIDRetryParams().
+		WithMemberID(MemberOptions.MemberRetry.PositionalMemberRetry.MemberID)
+	resp, err := AndromedaClient.Members.PostMembersMemberIDRetry(params)
+	if err != nil {
+		return err
+	}
+	return WriteTable(resp.GetPayload().Member)
+}
+
 func (*MemberDelete) Execute(_ []string) error {
 	params := members.
 		NewDeleteMembersMemberIDParams().
@@ -128,6 +159,7 @@ func (*MemberSet) Execute(_ []string) error {
 		Name:    MemberOptions.MemberSet.Name,
 		Address: MemberOptions.MemberSet.Address,
 		Port:    MemberOptions.MemberSet.Port,
+		Weight:  MemberOptions.MemberSet.Weight,
 	}}
 	if MemberOptions.MemberSet.Disable {
 		adminStateUp := false
@@ -153,6 +185,33 @@ func (*MemberSet) Execute(_ []string) error {
 	return WriteTable(resp.GetPayload().Member)
 }
 
+func (*MemberStatusHistory) Execute(_ []string) error {
+	params := members.
+		NewGetMembersMemberIDStatusHistoryParams().
+		WithMemberID(MemberOptions.MemberStatusHistory.PositionalMemberStatusHistory.MemberID).
+		WithLimit(MemberOptions.MemberStatusHistory.Limit)
+	if since := MemberOptions.MemberStatusHistory.Since; since != "" {
+		t, err := strfmt.ParseDateTime(since)
+		if err != nil {
+			return fmt.Errorf("invalid value for --since: %w", err)
+		}
+		params.SetSince(&t)
+	}
+	if until := MemberOptions.MemberStatusHistory.Until; until != "" {
+		t, err := strfmt.ParseDateTime(until)
+		if err != nil {
+			return fmt.Errorf("invalid value for --until: %w", err)
+		}
+		params.SetUntil(&t)
+	}
+
+	resp, err := AndromedaClient.Members.GetMembersMemberIDStatusHistory(params)
+	if err != nil {
+		return err
+	}
+	return WriteTable(resp.GetPayload().StatusHistory)
+}
+
 // waitForActiveMember waits for the member to be active, or optionally be deleted
 func waitForActiveMember(id strfmt.UUID, deleted bool) error {
 	// if not waiting, return immediately
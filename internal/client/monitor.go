@@ -20,6 +20,7 @@ var MonitorOptions struct {
 	MonitorShow   `command:"show" description:"Show Monitor"`
 	MonitorCreate `command:"create" description:"Create Monitor"`
 	MonitorDelete `command:"delete" description:"Delete Monitor"`
+	MonitorRetry  `command:"retry" description:"Retry provisioning of Monitor"`
 	MonitorSet    `command:"set" description:"Update Monitor"`
 }
 
@@ -35,6 +36,12 @@ type MonitorShow struct {
 	} `positional-args:"yes" required:"yes"`
 }
 
+type MonitorRetry struct {
+	Positional struct {
+		UUID strfmt.UUID `description:"UUID of the monitor"`
+	} `positional-args:"yes" required:"yes"`
+}
+
 type MonitorCreate struct {
 	Name       string  `short:"n" long:"name" description:"Name of the Monitor"`
 	Pool       string  `short:"p" long:"pool" description:"ID of the pool to check members" required:"true"`
@@ -110,6 +117,17 @@ func (*MonitorShow) Execute(_ []string) error {
 	return WriteTable(resp.GetPayload().Monitor)
 }
 
+func (*MonitorRetry) Execute(_ []string) error {
+	params := monitors.
+		NewPostMonitorsMonitorIDRetryParams().
+		WithMonitorID(MonitorOptions.MonitorRetry.Positional.UUID)
+	resp, err := AndromedaClient.Monitors.PostMonitorsMonitorIDRetry(params)
+	if err != nil {
+		return err
+	}
+	return WriteTable(resp.GetPayload().Monitor)
+}
+
 func (*MonitorDelete) Execute(_ []string) error {
 	params := monitors.
 		NewDeleteMonitorsMonitorIDParams().
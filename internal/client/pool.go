@@ -19,6 +19,7 @@ var PoolOptions struct {
 	PoolShow   `command:"show" description:"Show Pool"`
 	PoolCreate `command:"create" description:"Create Pool"`
 	PoolDelete `command:"delete" description:"Delete Pool"`
+	PoolRetry  `command:"retry" description:"Retry provisioning of Pool"`
 }
 
 type PoolList struct {
@@ -32,6 +33,12 @@ type PoolShow struct {
 	} `positional-args:"yes" required:"yes"`
 }
 
+type PoolRetry struct {
+	Positional struct {
+		UUID strfmt.UUID `description:"UUID of the pool"`
+	} `positional-args:"yes" required:"yes"`
+}
+
 type PoolCreate struct {
 	Name    string   `short:"n" long:"name" description:"Name of the Pool"`
 	Domain  []string `short:"a" long:"domain" description:"ID(s) of the associated Domain (multiple domains allowed)"`
@@ -86,6 +93,17 @@ func (*PoolShow) Execute(_ []string) error {
 	return WriteTable(resp.GetPayload().Pool)
 }
 
+func (*PoolRetry) Execute(_ []string) error {
+	params := pools.
+		NewPostPoolsPoolIDRetryParams().
+		WithPoolID(PoolOptions.PoolRetry.Positional.UUID)
+	resp, err := AndromedaClient.Pools.PostPoolsPoolIDRetry(params)
+	if err != nil {
+		return err
+	}
+	return WriteTable(resp.GetPayload().Pool)
+}
+
 func (*PoolDelete) Execute(_ []string) error {
 	params := pools.
 		NewDeletePoolsPoolIDParams().
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"slices"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag/conv"
+
+	"github.com/sapcc/andromeda/client/administrative"
+	"github.com/sapcc/andromeda/models"
+)
+
+var AdminOptions struct {
+	AdminReset `command:"reset" description:"List or reset resources stuck in PENDING_* or ERROR"`
+}
+
+type AdminReset struct {
+	OlderThan  int64  `long:"older-than" description:"Only resources not updated for this many seconds" default:"3600"`
+	Type       string `long:"type" description:"Only resources of this type" choice:"domain" choice:"pool" choice:"member" choice:"monitor" choice:"datacenter" choice:"geomap"`
+	Action     string `long:"action" description:"Reset the stuck resources, only list them if omitted" choice:"requeue" choice:"active" choice:"error"`
+	Reason     string `long:"reason" description:"Provisioning error recorded with action error"`
+	Positional struct {
+		UUIDs []strfmt.UUID `description:"Only reset these resources"`
+	} `positional-args:"yes"`
+}
+
+func (*AdminReset) Execute(_ []string) error {
+	opts := AdminOptions.AdminReset
+	params := administrative.NewGetResetParams().WithOlderThan(&opts.OlderThan)
+	if opts.Type != "" {
+		params.SetResourceType(&opts.Type)
+	}
+	resp, err := AndromedaClient.Administrative.GetReset(params)
+	if err != nil {
+		return err
+	}
+
+	resources := resp.GetPayload().Resources
+	if len(opts.Positional.UUIDs) > 0 {
+		resources = slices.DeleteFunc(resources, func(resource *models.StuckResource) bool {
+			return !slices.Contains(opts.Positional.UUIDs, resource.ID)
+		})
+	}
+	if opts.Action == "" {
+		return WriteTable(resources)
+	}
+
+	// Reset one by one, so that every reset is audited separately
+	//goland:noinspection GoPreferNilSlice
+	reset := []*models.StuckResource{}
+	for _, resource := range resources {
+		resetParams := administrative.NewPostResetResourceTypeResourceIDParams().
+			WithResourceType(resource.ResourceType).
+			WithResourceID(resource.ID).
+			WithReset(administrative.PostResetResourceTypeResourceIDBody{
+				Action: conv.Pointer(opts.Action),
+				Reason: opts.Reason,
+			})
+		r, err := AndromedaClient.Administrative.PostResetResourceTypeResourceID(resetParams)
+		if err != nil {
+			return err
+		}
+		reset = append(reset, r.GetPayload().Resource)
+	}
+	return WriteTable(reset)
+}
+
+func init() {
+	_, _ = Parser.AddCommand("admin", "Admin", "Administrative Commands.", &AdminOptions)
+}
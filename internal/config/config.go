@@ -126,6 +126,11 @@ func parseConfigFlags(flags []string) error {
 		Global.Audit.TransportURL = os.Getenv("AUDIT_TRANSPORT_URL")
 	}
 
+	// Keystone notifications are usually consumed from the transport of the audit notifications
+	if Global.KeystoneNotifications.TransportURL == "" {
+		Global.KeystoneNotifications.TransportURL = Global.Audit.TransportURL
+	}
+
 	// Set Database Connection URL from env if not set in config file
 	if Global.Database.Connection == "" {
 		Global.Database.Connection = os.Getenv("DATABASE_CONNECTION")
@@ -142,16 +147,21 @@ func parseConfigFlags(flags []string) error {
 }
 
 type Andromeda struct {
-	Default       Default               `yaml:"DEFAULT"`
-	Database      Database              `yaml:"database"`
-	ApiSettings   ApiSettings           `yaml:"api_settings"`
-	ServiceAuth   clientconfig.AuthInfo `yaml:"service_auth"`
-	Quota         Quota                 `yaml:"quota"`
-	F5Config      F5Config              `yaml:"f5"`
-	F5Datacenters []F5Datacenter        `yaml:"f5_datacenters"`
-	AkamaiConfig  AkamaiConfig          `yaml:"akamai"`
-	Audit         Audit                 `yaml:"audit_middleware_notifications"`
-	HouseKeeping  HouseKeeping          `yaml:"house_keeping"`
+	Default               Default               `yaml:"DEFAULT"`
+	Database              Database              `yaml:"database"`
+	ApiSettings           ApiSettings           `yaml:"api_settings"`
+	ServiceAuth           clientconfig.AuthInfo `yaml:"service_auth"`
+	Quota                 Quota                 `yaml:"quota"`
+	F5Config              F5Config              `yaml:"f5"`
+	F5Datacenters         []F5Datacenter        `yaml:"f5_datacenters"`
+	AkamaiConfig          AkamaiConfig          `yaml:"akamai"`
+	NoopConfig            NoopConfig            `yaml:"noop"`
+	DNSConfig             DNSConfig             `yaml:"dns"`
+	HealthCheck           HealthCheck           `yaml:"health_check"`
+	Audit                 Audit                 `yaml:"audit_middleware_notifications"`
+	HouseKeeping          HouseKeeping          `yaml:"house_keeping"`
+	StatusHistory         StatusHistory         `yaml:"status_history"`
+	KeystoneNotifications KeystoneNotifications `yaml:"keystone_notifications"`
 }
 
 type ApiSettings struct {
@@ -165,12 +175,16 @@ type ApiSettings struct {
 	DisableCors               bool    `yaml:"disable_cors" description:"Stops sending Access-Control-Allow-Origin Header to allow cross-origin requests."`
 	EnableProxyHeadersParsing bool    `yaml:"enable_proxy_headers_parsing" default:"true" description:"Try parsing proxy headers for http scheme and base url."`
 	EnablePolicyTracing       bool    `yaml:"enable_policy_tracing" description:"Enable policy tracing."`
+	AgentTTL                  int64   `yaml:"agent_ttl" default:"90" description:"Seconds after the last heartbeat an agent is considered stale."`
+	AgentCheck                string  `yaml:"agent_check" default:"warn" description:"Action on creating or updating domains for a provider without live agent, either warn (Warning response header), refuse or none."`
+	DomainRestoreWindow       int64   `yaml:"domain_restore_window" description:"Seconds a deleted domain can be restored before house keeping purges it, 0 disables restoring domains."`
 }
 
 type Quota struct {
 	Enabled                  bool  `yaml:"enabled" description:"Enable quotas."`
 	DefaultQuotaDomainAkamai int64 `yaml:"domains_akamai" default:"0" description:"Default quota of domain (provider Akamai) per project."`
 	DefaultQuotaDomainF5     int64 `yaml:"domains_f5" default:"0" description:"Default quota of domain (provider F5) per project."`
+	DefaultQuotaDomainDNS    int64 `yaml:"domains_dns" default:"0" description:"Default quota of domain (provider DNS) per project."`
 	DefaultQuotaPool         int64 `yaml:"pools" default:"0" description:"Default quota of pool per project."`
 	DefaultQuotaMember       int64 `yaml:"members" default:"0" description:"Default quota of member per project."`
 	DefaultQuotaMonitor      int64 `yaml:"monitors" default:"0" description:"Default quota of monitor per project."`
@@ -187,6 +201,9 @@ type Default struct {
 	PrometheusListen     string `yaml:"prometheus_listen" default:"127.0.0.1:9090" description:"Prometheus listen TCP network address."`
 	PrometheusRPCMetrics bool   `yaml:"prometheus_rpc_metrics" description:"Enable Prometheus metrics for RPC calls." default:"true"`
 	SentryDSN            string `yaml:"sentry_dsn" description:"Sentry Data Source Name."`
+	ChangeEventDebounce  int64  `yaml:"change_event_debounce" default:"2" description:"Seconds agents wait for further change events before syncing pending changes, 0 disables change events."`
+	LeaderLeaseDuration  int64  `yaml:"leader_lease_duration" default:"30" description:"Seconds a leader election lease of agent replicas is valid, 0 disables leader election."`
+	HeartbeatInterval    int64  `yaml:"heartbeat_interval" default:"30" description:"Seconds between heartbeats of agents to the agent registry, 0 disables heartbeats."`
 }
 
 type Database struct {
@@ -202,6 +219,8 @@ type F5Config struct {
 	MaxRetries       uint64   `long:"max-retries" ini-name:"max_retries" description:"Maximum number of retries for F5 operations." default:"5"`
 	ValidateCert     bool     `yaml:"validate_certificates" description:"Validate HTTPS certificate"`
 	DomainSuffix     string   `yaml:"domain_suffix" description:"Automatically appended to FQDN of Andromeda domains before posting the AS3 declaration"`
+	SyncInterval     int64    `yaml:"sync_interval" default:"300" description:"Interval of full declaration syncs, pending changes are synced on change events in between."`
+	StatusInterval   int64    `yaml:"status_interval" default:"300" description:"Interval for syncing member status"`
 }
 
 type F5Datacenter struct {
@@ -222,6 +241,30 @@ type AkamaiConfig struct {
 	ContractId           string `yaml:"contract_id" description:"Indicated the contract id to use, autodetects if only one contract is associated."`
 	SyncInterval         int64  `yaml:"sync_interval" default:"30" description:"Sync interval for checking for pending updates"`
 	MemberStatusInterval int64  `yaml:"member_status_interval" default:"60" description:"Sync interval for checking for member status"`
+	DriftCheckInterval   int64  `yaml:"drift_check_interval" default:"3600" description:"Interval for checking Akamai objects for out-of-band changes, 0 disables periodic drift checks."`
+	DriftAutoRevert      bool   `yaml:"drift_auto_revert" description:"Revert drifted Akamai objects to the state of the Andromeda database."`
+	AvailabilityFailover bool   `yaml:"availability_failover" description:"Provision AVAILABILITY domains as failover properties handing out their pools in order of priority, instead of weighted-round-robin properties across all pools."`
+}
+
+type NoopConfig struct {
+	SyncInterval          int64             `yaml:"sync_interval" default:"10" description:"Sync interval for checking for pending updates"`
+	MemberStatusInterval  int64             `yaml:"member_status_interval" default:"30" description:"Sync interval for reporting simulated member status"`
+	MemberStatus          string            `yaml:"member_status" default:"ONLINE" description:"Simulated member status reported for all members, either ONLINE, OFFLINE, NO_MONITOR or UNKNOWN."`
+	MemberStatusOverrides map[string]string `yaml:"member_status_overrides" description:"Simulated member status per member address or member ID, overriding member_status."`
+}
+
+type DNSConfig struct {
+	Listen       string              `yaml:"listen" default:":53" description:"UDP/TCP network address the authoritative DNS server listens on."`
+	SyncInterval int64               `yaml:"sync_interval" default:"10" description:"Sync interval for refreshing the served records and checking for pending updates"`
+	TTL          uint32              `yaml:"ttl" default:"30" description:"TTL in seconds of SOA records and negative answers, answered records use the TTL of their domain."`
+	Nameserver   string              `yaml:"nameserver" description:"Name of the DNS server in SOA records of the served domains, defaults to the host name."`
+	GeoNetworks  map[string][]string `yaml:"geo_networks" description:"Client networks (CIDR) per ISO 3166 country code, used to resolve GEOGRAPHIC domains."`
+}
+
+type HealthCheck struct {
+	Providers       []string `yaml:"providers" default:"[dns]" description:"Providers whose members are health checked, usually the ones without native monitoring."`
+	RefreshInterval int64    `yaml:"refresh_interval" default:"30" description:"Interval for refreshing the monitors and members to check"`
+	Workers         int      `yaml:"workers" default:"16" description:"Maximum number of concurrently running health checks."`
 }
 
 type Audit struct {
@@ -230,9 +273,27 @@ type Audit struct {
 	QueueName    string `yaml:"queue_name" description:"RabbitMQ queue name"`
 }
 
+type KeystoneNotifications struct {
+	Enabled      bool   `yaml:"enabled" description:"Delete the resources of projects deleted in Keystone, consumed by house keeping."`
+	TransportURL string `yaml:"transport_url" description:"The network address and optional user credentials for connecting to the OpenStack notification bus, defaults to the audit transport_url."`
+	Exchange     string `yaml:"exchange" default:"keystone" description:"Exchange Keystone publishes notifications to."`
+	Topic        string `yaml:"topic" default:"notifications.info" description:"Routing key of the Keystone notifications."`
+	QueueName    string `yaml:"queue_name" default:"andromeda-keystone-notifications" description:"RabbitMQ queue consumed by Andromeda."`
+}
+
 type HouseKeeping struct {
-	Enabled     bool  `yaml:"enabled" description:"Enables house keeping."`
-	DeleteAfter int64 `yaml:"delete_after" default:"600" description:"Minimum seconds elapsed after cleanup of a deleted domain."`
+	Enabled       bool  `yaml:"enabled" description:"Enables house keeping."`
+	DeleteAfter   int64 `yaml:"delete_after" default:"600" description:"Minimum seconds elapsed after cleanup of a deleted domain, datacenter or geographic map, or purge of the members and monitors of a deleted pool."`
+	StuckTimeout  int64 `yaml:"stuck_timeout" default:"3600" description:"Seconds a resource may stay in PENDING_* before it is reported as stuck."`
+	Interval      int64 `yaml:"interval" default:"60" description:"Interval of the stuck resource check and the cleanup of deleted datacenters, geographic maps and pools."`
+	QuotaCleanup  bool  `yaml:"quota_cleanup" description:"Remove quotas of projects that no longer exist in Keystone, requires service_auth."`
+	QuotaInterval int64 `yaml:"quota_interval" default:"3600" description:"Interval of the cleanup of quotas of deleted projects."`
+}
+
+type StatusHistory struct {
+	FlapThreshold int64 `yaml:"flap_threshold" default:"5" description:"Number of member status transitions within flap_window marking a member as flapping, 0 disables flap detection."`
+	FlapWindow    int64 `yaml:"flap_window" default:"600" description:"Seconds of member status history considered by flap detection."`
+	Retention     int64 `yaml:"retention" default:"2592000" description:"Seconds member status history is kept by house keeping, 0 keeps it forever."`
 }
 
 func GetApiBaseUrl(r *http.Request) string {
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	dbsql "database/sql"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/sapcc/andromeda/internal/auth"
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
+	"github.com/sapcc/andromeda/internal/utils"
+	"github.com/sapcc/andromeda/models"
+	"github.com/sapcc/andromeda/restapi/operations/administrative"
+)
+
+type AgentController struct {
+	CommonController
+}
+
+// GetAgents GET /agents
+func (c AgentController) GetAgents(params administrative.GetAgentsParams) middleware.Responder {
+	if _, err := auth.Authenticate(params.HTTPRequest, nil); err != nil {
+		return administrative.NewGetAgentsDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	agents, err := getAgents(c.db)
+	if err != nil {
+		panic(err)
+	}
+	return administrative.NewGetAgentsOK().WithPayload(&administrative.GetAgentsOKBody{Agents: agents})
+}
+
+// getAgents returns the registered agents, agents are STALE if disabled or without heartbeat within the agent TTL
+func getAgents(db *sqlx.DB) ([]*models.Agent, error) {
+	var rows []struct {
+		Host         string           `db:"host"`
+		Type         string           `db:"type"`
+		AdminStateUp bool             `db:"admin_state_up"`
+		Version      dbsql.NullString `db:"version"`
+		Providers    []byte           `db:"providers"`
+		Capabilities []byte           `db:"capabilities"`
+		Heartbeat    strfmt.DateTime  `db:"heartbeat"`
+		LastSync     *strfmt.DateTime `db:"last_sync"`
+	}
+	sql := `SELECT host, type, admin_state_up, version, providers, capabilities, heartbeat, last_sync FROM agent
+		ORDER BY type, host`
+	if err := db.Select(&rows, sql); err != nil {
+		return nil, err
+	}
+
+	ttl := time.Duration(config.Global.ApiSettings.AgentTTL) * time.Second
+	//goland:noinspection GoPreferNilSlice
+	agents := []*models.Agent{}
+	for _, row := range rows {
+		agent := &models.Agent{
+			Host:         row.Host,
+			Type:         row.Type,
+			AdminStateUp: row.AdminStateUp,
+			Version:      row.Version.String,
+			Providers:    []string{},
+			Capabilities: []string{},
+			Heartbeat:    row.Heartbeat,
+			LastSync:     row.LastSync,
+			Status:       models.AgentStatusSTALE,
+		}
+		if err := unmarshalJSONColumn(row.Providers, &agent.Providers); err != nil {
+			return nil, err
+		}
+		if err := unmarshalJSONColumn(row.Capabilities, &agent.Capabilities); err != nil {
+			return nil, err
+		}
+		if row.AdminStateUp && time.Since(time.Time(row.Heartbeat)) < ttl {
+			agent.Status = models.AgentStatusALIVE
+		}
+		agents = append(agents, agent)
+	}
+	return agents, nil
+}
+
+func unmarshalJSONColumn(data []byte, v any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+// providerHasLiveAgent returns true if an ALIVE agent is able to sync domains of the provider
+func providerHasLiveAgent(db *sqlx.DB, provider string) (bool, error) {
+	agents, err := getAgents(db)
+	if err != nil {
+		return false, err
+	}
+	for _, agent := range agents {
+		if agent.Status == models.AgentStatusALIVE && slices.Contains(agent.Providers, provider) &&
+			slices.Contains(agent.Capabilities, driver.CapabilitySync) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkProviderAgent applies the configured agent check, returns a warning if the provider has no live agent
+// and the request is not refused
+func checkProviderAgent(db *sqlx.DB, provider string) (warning string, refuse bool) {
+	check := config.Global.ApiSettings.AgentCheck
+	if check != "warn" && check != "refuse" {
+		return "", false
+	}
+	live, err := providerHasLiveAgent(db, provider)
+	if err != nil {
+		panic(err)
+	}
+	if live {
+		return "", false
+	}
+	return utils.GetErrorNoLiveAgent(provider).Message, check == "refuse"
+}
+
+// withWarning adds a Warning header to the response
+func withWarning(responder middleware.Responder, warning string) middleware.Responder {
+	if warning == "" {
+		return responder
+	}
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		rw.Header().Add("Warning", `299 andromeda "`+warning+`"`)
+		responder.WriteResponse(rw, producer)
+	})
+}
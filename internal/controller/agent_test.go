@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag/conv"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/models"
+	"github.com/sapcc/andromeda/restapi/operations/administrative"
+	"github.com/sapcc/andromeda/restapi/operations/domains"
+)
+
+func (t *SuiteTest) TestAgents() {
+	config.Global.ApiSettings.AgentTTL = 90
+	config.Global.ApiSettings.AgentCheck = "refuse"
+	defer func() { config.Global.ApiSettings.AgentCheck = "" }()
+	defer func() { _, _ = t.db.Exec("DELETE FROM agent") }()
+	defer t.cleanupDomains()
+
+	fqdn := strfmt.Hostname("agent.test.com")
+	domain := domains.PostDomainsBody{
+		Domain: &models.Domain{Fqdn: &fqdn, Provider: conv.Pointer("akamai")},
+	}
+
+	// Refused without live agent
+	res := t.c.Domains.PostDomains(domains.PostDomainsParams{Domain: domain})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusBadRequest, rr.Code, rr.Body)
+
+	// Register agent by heartbeat
+	rpc := server.RPCHandler{DB: t.db}
+	_, err := rpc.Heartbeat(context.Background(), &server.HeartbeatRequest{
+		Host:         "host1",
+		Type:         driver.AgentAkamai,
+		Version:      "1.2.3",
+		Providers:    []string{"akamai"},
+		Capabilities: []string{driver.CapabilitySync},
+	})
+	assert.NoError(t.T(), err)
+
+	res = t.c.Agents.GetAgents(administrative.GetAgentsParams{})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusOK, rr.Code, rr.Body)
+	agentsResponse := administrative.GetAgentsOKBody{}
+	_ = agentsResponse.UnmarshalBinary(rr.Body.Bytes())
+	if assert.Len(t.T(), agentsResponse.Agents, 1, rr.Body) {
+		assert.Equal(t.T(), models.AgentStatusALIVE, agentsResponse.Agents[0].Status)
+		assert.Equal(t.T(), []string{"akamai"}, agentsResponse.Agents[0].Providers)
+		assert.Nil(t.T(), agentsResponse.Agents[0].LastSync)
+	}
+
+	res = t.c.Domains.PostDomains(domains.PostDomainsParams{Domain: domain})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+
+	// Stale agents are ignored, domains are created with a warning
+	config.Global.ApiSettings.AgentCheck = "warn"
+	_, err = t.db.Exec(t.db.Rebind("UPDATE agent SET admin_state_up = ?"), false)
+	assert.NoError(t.T(), err)
+
+	fqdn = "agent2.test.com"
+	res = t.c.Domains.PostDomains(domains.PostDomainsParams{Domain: domain})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+	assert.Contains(t.T(), rr.Header().Get("Warning"), "no live agent for provider 'akamai'")
+	domainResponse := domains.PostDomainsCreatedBody{}
+	_ = domainResponse.UnmarshalBinary(rr.Body.Bytes())
+
+	// Updates are checked as well
+	update := domains.PutDomainsDomainIDParams{DomainID: domainResponse.Domain.ID, Domain: domains.PutDomainsDomainIDBody{
+		Domain: &models.Domain{Name: conv.Pointer("updated")}}}
+	res = t.c.Domains.PutDomainsDomainID(update)
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusAccepted, rr.Code, rr.Body)
+	assert.Contains(t.T(), rr.Header().Get("Warning"), "no live agent for provider 'akamai'")
+
+	config.Global.ApiSettings.AgentCheck = "refuse"
+	res = t.c.Domains.PutDomainsDomainID(update)
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusBadRequest, rr.Code, rr.Body)
+}
@@ -0,0 +1,667 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag/conv"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/sapcc/andromeda/db"
+	"github.com/sapcc/andromeda/internal/auth"
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/utils"
+	"github.com/sapcc/andromeda/models"
+	"github.com/sapcc/andromeda/restapi/operations/configuration"
+)
+
+// ConfigurationVersion is the version of the exported configuration documents
+const ConfigurationVersion = 1
+
+var errInvalidConfiguration = errors.New("invalid configuration")
+
+// quotaColumns are the quota columns checked after an import, domain quotas are bound to the provider
+var quotaColumns = []string{"domain_akamai", "domain_f5", "domain_dns", "pool", "member", "monitor", "datacenter"}
+
+type ConfigurationController struct {
+	CommonController
+}
+
+// authenticateProject returns the project of the request, which is the project of the token unless
+// another project is requested explicitly.
+func authenticateProject(r *http.Request, projectID *string) (string, error) {
+	var requestVars map[string]string
+	if projectID != nil {
+		requestVars = map[string]string{"project_id": *projectID}
+	}
+	tokenProjectID, err := auth.Authenticate(r, requestVars)
+	if err != nil {
+		return "", err
+	}
+	if projectID != nil {
+		return *projectID, nil
+	}
+	return tokenProjectID, nil
+}
+
+// GetExport GET /export
+func (c ConfigurationController) GetExport(params configuration.GetExportParams) middleware.Responder {
+	projectID, err := authenticateProject(params.HTTPRequest, params.ProjectID)
+	if err != nil {
+		return configuration.NewGetExportDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	doc, err := exportConfiguration(c.db, projectID)
+	if err != nil {
+		panic(err)
+	}
+	return configuration.NewGetExportOK().WithPayload(doc)
+}
+
+// exportConfiguration returns all resources of the project which are not being deleted, together with
+// the public datacenters used by the project.
+func exportConfiguration(db *sqlx.DB, projectID string) (*models.Configuration, error) {
+	doc := &models.Configuration{
+		Version:     conv.Pointer(int64(ConfigurationVersion)),
+		Datacenters: []*models.Datacenter{},
+		Geomaps:     []*models.Geomap{},
+		Pools:       []*models.Pool{},
+		Members:     []*models.Member{},
+		Monitors:    []*models.Monitor{},
+		Domains:     []*models.Domain{},
+	}
+
+	selectAll := func(dest any, table string, pred string, args ...any) error {
+		sql, sqlArgs := sq.Select("*").
+			From(table).
+			Where(pred, args...).
+			Where(sq.NotEq{"provisioning_status": []string{"PENDING_DELETE", "DELETED"}}).
+			OrderBy("created_at").
+			MustSql()
+		return db.Select(dest, db.Rebind(sql), sqlArgs...)
+	}
+
+	if err := selectAll(&doc.Datacenters, "datacenter", `project_id = ? OR scope = 'public' AND (
+			id IN (SELECT datacenter_id FROM member WHERE project_id = ?) OR
+			id IN (SELECT default_datacenter FROM geographic_map WHERE project_id = ?) OR
+			id IN (SELECT gma.datacenter FROM geographic_map_assignment gma
+				JOIN geographic_map gm ON gm.id = gma.geographic_map_id WHERE gm.project_id = ?))`,
+		projectID, projectID, projectID, projectID); err != nil {
+		return nil, err
+	}
+	if err := selectAll(&doc.Geomaps, "geographic_map", "project_id = ?", projectID); err != nil {
+		return nil, err
+	}
+	for _, geomap := range doc.Geomaps {
+		if err := PopulateGeoMapAssignments(db, geomap); err != nil {
+			return nil, err
+		}
+	}
+	if err := selectAll(&doc.Pools, "pool", "project_id = ?", projectID); err != nil {
+		return nil, err
+	}
+	for _, pool := range doc.Pools {
+		// members, monitors and domains reference their pools
+		pool.Domains, pool.Members, pool.Monitors = []strfmt.UUID{}, []strfmt.UUID{}, []strfmt.UUID{}
+	}
+	if err := selectAll(&doc.Members, "member", "project_id = ?", projectID); err != nil {
+		return nil, err
+	}
+	if err := selectAll(&doc.Monitors, "monitor", "project_id = ?", projectID); err != nil {
+		return nil, err
+	}
+	if err := selectAll(&doc.Domains, "domain", "project_id = ?", projectID); err != nil {
+		return nil, err
+	}
+	for _, domain := range doc.Domains {
+		domain.Pools = []strfmt.UUID{}
+		if err := PopulateDomainPools(db, domain); err != nil {
+			return nil, err
+		}
+		if err := PopulateDomainAliases(db, domain); err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+// PostImport POST /import
+func (c ConfigurationController) PostImport(params configuration.PostImportParams) middleware.Responder {
+	projectID, err := authenticateProject(params.HTTPRequest, params.ProjectID)
+	if err != nil {
+		return configuration.NewPostImportDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	var imp *importer
+	if err = db.TxExecute(c.db, func(tx *sqlx.Tx) error {
+		imp = &importer{tx: tx, projectID: projectID, ids: map[strfmt.UUID]strfmt.UUID{}, created: map[string]int{}}
+		if err := imp.run(params.Configuration); err != nil {
+			return err
+		}
+		if config.Global.Quota.Enabled {
+			return imp.checkQuota()
+		}
+		return nil
+	}); err != nil {
+		var quotaErr *quotaExceededError
+		if errors.As(err, &quotaErr) {
+			return configuration.NewPostImportForbidden().WithPayload(utils.GetQuotaMetResponse(quotaErr.resource))
+		}
+		var rnfError *utils.ResourcesNotFoundError
+		if errors.Is(err, errInvalidConfiguration) || errors.As(err, &rnfError) {
+			return configuration.NewPostImportBadRequest().WithPayload(&models.Error{Code: 400, Message: err.Error()})
+		}
+		panic(err)
+	}
+
+	_ = PendingSync(c.nc, imp.events...)
+	return configuration.NewPostImportOK().WithPayload(&configuration.PostImportOKBody{Resources: imp.resources})
+}
+
+// importer creates or updates the resources of a configuration document in a project. Resources are imported
+// in dependency order, so that the ids referenced within the document can be mapped to ids of the project.
+// Resources are copied before their references are rewritten, the document itself is left untouched.
+type importer struct {
+	tx        *sqlx.Tx
+	projectID string
+	ids       map[strfmt.UUID]strfmt.UUID
+	created   map[string]int
+	resources []*models.ImportedResource
+	events    []driver.ChangeEvent
+}
+
+func (i *importer) run(doc *models.Configuration) error {
+	if conv.Value(doc.Version) != ConfigurationVersion {
+		return fmt.Errorf("%w: unsupported version %d", errInvalidConfiguration, conv.Value(doc.Version))
+	}
+	for _, datacenter := range doc.Datacenters {
+		if err := i.importDatacenter(datacenter); err != nil {
+			return err
+		}
+	}
+	for _, geomap := range doc.Geomaps {
+		if err := i.importGeomap(geomap); err != nil {
+			return err
+		}
+	}
+	var poolIDs []strfmt.UUID
+	for _, pool := range doc.Pools {
+		if err := i.importPool(pool); err != nil {
+			return err
+		}
+		poolIDs = append(poolIDs, i.ids[pool.ID])
+	}
+	for _, monitor := range doc.Monitors {
+		if err := i.importMonitor(monitor); err != nil {
+			return err
+		}
+	}
+	for _, member := range doc.Members {
+		if err := i.importMember(member); err != nil {
+			return err
+		}
+	}
+	for _, poolID := range poolIDs {
+		if err := UpdateCascadePool(i.tx, poolID, "PENDING_UPDATE"); err != nil {
+			return err
+		}
+	}
+	for _, domain := range doc.Domains {
+		if err := i.importDomain(domain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// record maps the id of the resource within the document to the id of the resource in the project
+func (i *importer) record(resourceType string, sourceID, id strfmt.UUID, action, provider string) {
+	i.ids[sourceID] = id
+	i.resources = append(i.resources, &models.ImportedResource{
+		ResourceType: resourceType,
+		SourceID:     sourceID,
+		ID:           id,
+		Action:       action,
+	})
+
+	status := "PENDING_UPDATE"
+	switch action {
+	case models.ImportedResourceActionReferenced:
+		return
+	case models.ImportedResourceActionCreated:
+		status = "PENDING_CREATE"
+	}
+	i.events = append(i.events, driver.ChangeEvent{
+		Model: strings.ToUpper(resourceType), ID: id.String(), Provider: provider, Status: status})
+}
+
+// resolve returns the id in the project of a resource referenced within the document
+func (i *importer) resolve(resourceType string, sourceID strfmt.UUID) (strfmt.UUID, error) {
+	id, ok := i.ids[sourceID]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown %s %s", errInvalidConfiguration, resourceType, sourceID)
+	}
+	return id, nil
+}
+
+// find returns the id of a resource of the project matching the predicate, or an empty id
+func (i *importer) find(table string, pred sq.Eq) (strfmt.UUID, error) {
+	var id strfmt.UUID
+	pred["project_id"] = i.projectID
+	sql, args := sq.Select("id").
+		From(table).
+		Where(pred).
+		Where(sq.NotEq{"provisioning_status": "DELETED"}).
+		Limit(1).
+		MustSql()
+	if err := i.tx.Get(&id, i.tx.Rebind(sql), args...); err != nil && !errors.Is(err, dbsql.ErrNoRows) {
+		return "", err
+	}
+	return id, nil
+}
+
+// findByName returns the id of a resource of the project with the same name. Unnamed resources only match
+// the resource of the project with their id within the document, i.e. when re-importing an export.
+func (i *importer) findByName(table string, name *string, sourceID strfmt.UUID, pred sq.Eq) (strfmt.UUID, error) {
+	if pred == nil {
+		pred = sq.Eq{}
+	}
+	if conv.Value(name) != "" {
+		pred["name"] = *name
+	} else if strfmt.IsUUID(sourceID.String()) {
+		pred["id"] = sourceID
+	} else {
+		return "", nil
+	}
+	return i.find(table, pred)
+}
+
+// upsert updates the resource if id is set, else inserts it and returns the new id
+func (i *importer) upsert(id strfmt.UUID, insertSQL, updateSQL string, arg any) (strfmt.UUID, string, error) {
+	if id != "" {
+		if _, err := i.tx.NamedExec(updateSQL, arg); err != nil {
+			return "", "", err
+		}
+		return id, models.ImportedResourceActionUpdated, nil
+	}
+
+	stmt, err := i.tx.PrepareNamed(insertSQL)
+	if err != nil {
+		return "", "", err
+	}
+	if err = stmt.Get(&id, arg); err != nil {
+		return "", "", err
+	}
+	return id, models.ImportedResourceActionCreated, nil
+}
+
+func (i *importer) importDatacenter(datacenter *models.Datacenter) error {
+	copied := *datacenter
+	datacenter = &copied
+	sourceID := datacenter.ID
+	id, err := i.findByName("datacenter", datacenter.Name, sourceID, nil)
+	if err != nil {
+		return err
+	}
+
+	if id == "" {
+		// public datacenters are shared, they are referenced by id within the same region, else by name
+		err = dbsql.ErrNoRows
+		if strfmt.IsUUID(sourceID.String()) {
+			sql := i.tx.Rebind(`SELECT id FROM datacenter WHERE scope = 'public' AND id = ?`)
+			err = i.tx.Get(&id, sql, sourceID)
+		}
+		if errors.Is(err, dbsql.ErrNoRows) && conv.Value(datacenter.Name) != "" {
+			sql := i.tx.Rebind(`SELECT id FROM datacenter WHERE scope = 'public' AND name = ? LIMIT 1`)
+			err = i.tx.Get(&id, sql, *datacenter.Name)
+		}
+		if err == nil {
+			i.record("datacenter", sourceID, id, models.ImportedResourceActionReferenced, datacenter.Provider)
+			return nil
+		}
+		if !errors.Is(err, dbsql.ErrNoRows) {
+			return err
+		}
+		if strings.ToLower(datacenter.Provider) == "f5" {
+			return fmt.Errorf("%w: %s", errInvalidConfiguration, utils.RestrictedDatacenterProvider.Message)
+		}
+	}
+
+	if err = utils.SetModelDefaults(datacenter); err != nil {
+		return err
+	}
+	datacenter.ID = id
+	datacenter.ProjectID = &i.projectID
+	datacenter.Scope = conv.Pointer("private")
+	id, action, err := i.upsert(id, `
+		INSERT INTO datacenter
+			(name, admin_state_up, continent, country, state_or_province,
+			 city, latitude, longitude, scope, project_id, provider)
+		VALUES
+			(:name, :admin_state_up, :continent, :country, :state_or_province,
+			 :city, :latitude, :longitude, :scope, :project_id, :provider)
+		RETURNING id`, `
+		UPDATE datacenter SET
+			admin_state_up = :admin_state_up,
+			continent = :continent,
+			country = :country,
+			state_or_province = :state_or_province,
+			city = :city,
+			latitude = :latitude,
+			longitude = :longitude,
+			provider = :provider,
+			updated_at = NOW(),
+			provisioning_status = 'PENDING_UPDATE'
+		WHERE id = :id`, datacenter)
+	if err != nil {
+		return err
+	}
+	if action == models.ImportedResourceActionCreated {
+		i.created["datacenter"]++
+	}
+	i.record("datacenter", sourceID, id, action, datacenter.Provider)
+	return nil
+}
+
+func (i *importer) importGeomap(geomap *models.Geomap) error {
+	copied := *geomap
+	geomap = &copied
+	sourceID := geomap.ID
+	id, err := i.findByName("geographic_map", geomap.Name, sourceID, nil)
+	if err != nil {
+		return err
+	}
+
+	defaultDatacenter, err := i.resolve("datacenter", conv.Value(geomap.DefaultDatacenter))
+	if err != nil {
+		return err
+	}
+	if err = utils.SetModelDefaults(geomap); err != nil {
+		return err
+	}
+	geomap.ID = id
+	geomap.ProjectID = &i.projectID
+	geomap.Scope = conv.Pointer("private")
+	geomap.DefaultDatacenter = &defaultDatacenter
+	id, action, err := i.upsert(id, `
+		INSERT INTO geographic_map
+			(name, default_datacenter, scope, provider, project_id)
+		VALUES
+			(:name, :default_datacenter, :scope, :provider, :project_id)
+		RETURNING id`, `
+		UPDATE geographic_map SET
+			default_datacenter = :default_datacenter,
+			provider = :provider,
+			updated_at = NOW(),
+			provisioning_status = 'PENDING_UPDATE'
+		WHERE id = :id`, geomap)
+	if err != nil {
+		return err
+	}
+
+	sql := i.tx.Rebind(`DELETE FROM geographic_map_assignment WHERE geographic_map_id = ?`)
+	if _, err = i.tx.Exec(sql, id); err != nil {
+		return err
+	}
+	sql = i.tx.Rebind(`INSERT INTO geographic_map_assignment (geographic_map_id, datacenter, country) VALUES (?, ?, ?)`)
+	for _, assignment := range geomap.Assignments {
+		if assignment == nil {
+			continue
+		}
+		datacenter, err := i.resolve("datacenter", assignment.Datacenter)
+		if err != nil {
+			return err
+		}
+		if _, err = i.tx.Exec(sql, id, datacenter, assignment.Country); err != nil {
+			return err
+		}
+	}
+	i.record("geomap", sourceID, id, action, geomap.Provider)
+	return nil
+}
+
+func (i *importer) importPool(pool *models.Pool) error {
+	copied := *pool
+	pool = &copied
+	sourceID := pool.ID
+	id, err := i.findByName("pool", pool.Name, sourceID, nil)
+	if err != nil {
+		return err
+	}
+
+	if err = utils.SetModelDefaults(pool); err != nil {
+		return err
+	}
+	pool.ID = id
+	pool.ProjectID = &i.projectID
+	id, action, err := i.upsert(id, `
+		INSERT INTO pool
+			(name, admin_state_up, project_id)
+		VALUES
+			(:name, :admin_state_up, :project_id)
+		RETURNING id`, `
+		UPDATE pool SET
+			admin_state_up = :admin_state_up,
+			updated_at = NOW(),
+			provisioning_status = 'PENDING_UPDATE'
+		WHERE id = :id`, pool)
+	if err != nil {
+		return err
+	}
+	if action == models.ImportedResourceActionCreated {
+		i.created["pool"]++
+	}
+	i.record("pool", sourceID, id, action, "")
+	return nil
+}
+
+func (i *importer) importMonitor(monitor *models.Monitor) error {
+	copied := *monitor
+	monitor = &copied
+	sourceID := monitor.ID
+	poolID, err := i.resolve("pool", conv.Value(monitor.PoolID))
+	if err != nil {
+		return err
+	}
+	id, err := i.findByName("monitor", monitor.Name, sourceID, sq.Eq{"pool_id": poolID})
+	if err != nil {
+		return err
+	}
+
+	if err = utils.SetModelDefaults(monitor); err != nil {
+		return err
+	}
+	if validationErr := validateMonitor(monitor); validationErr != nil {
+		return fmt.Errorf("%w: monitor %s: %s", errInvalidConfiguration, sourceID, validationErr.Message)
+	}
+	monitor.ID = id
+	monitor.ProjectID = &i.projectID
+	monitor.PoolID = &poolID
+	id, action, err := i.upsert(id, `
+		INSERT INTO monitor
+			(name, admin_state_up, type, "interval", timeout, pool_id, send, receive, http_method, domain_name, project_id)
+		VALUES
+			(:name, :admin_state_up, :type, :interval, :timeout, :pool_id, :send, :receive, :http_method, :domain_name, :project_id)
+		RETURNING id`, `
+		UPDATE monitor SET
+			admin_state_up = :admin_state_up,
+			type = :type,
+			"interval" = :interval,
+			timeout = :timeout,
+			send = :send,
+			receive = :receive,
+			http_method = :http_method,
+			domain_name = :domain_name,
+			updated_at = NOW(),
+			provisioning_status = 'PENDING_UPDATE'
+		WHERE id = :id`, monitor)
+	if err != nil {
+		return err
+	}
+	if action == models.ImportedResourceActionCreated {
+		i.created["monitor"]++
+	}
+	i.record("monitor", sourceID, id, action, "")
+	return nil
+}
+
+func (i *importer) importMember(member *models.Member) error {
+	copied := *member
+	member = &copied
+	sourceID := member.ID
+	poolID, err := i.resolve("pool", conv.Value(member.PoolID))
+	if err != nil {
+		return err
+	}
+	if member.DatacenterID != nil && *member.DatacenterID != "" {
+		datacenterID, err := i.resolve("datacenter", *member.DatacenterID)
+		if err != nil {
+			return err
+		}
+		member.DatacenterID = &datacenterID
+	}
+	id, err := i.find("member", sq.Eq{"pool_id": poolID, "address": member.Address, "port": member.Port})
+	if err != nil {
+		return err
+	}
+
+	if err = utils.SetModelDefaults(member); err != nil {
+		return err
+	}
+	member.ID = id
+	member.ProjectID = &i.projectID
+	member.PoolID = &poolID
+	id, action, err := i.upsert(id, `
+		INSERT INTO member
+			(name, admin_state_up, project_id, address, port, weight, pool_id, datacenter_id)
+		VALUES
+			(:name, :admin_state_up, :project_id, :address, :port, :weight, :pool_id, :datacenter_id)
+		RETURNING id`, `
+		UPDATE member SET
+			name = :name,
+			admin_state_up = :admin_state_up,
+			weight = :weight,
+			datacenter_id = :datacenter_id,
+			updated_at = NOW(),
+			provisioning_status = 'PENDING_UPDATE'
+		WHERE id = :id`, member)
+	if err != nil {
+		return err
+	}
+	if action == models.ImportedResourceActionCreated {
+		i.created["member"]++
+	}
+	i.record("member", sourceID, id, action, "")
+	return nil
+}
+
+func (i *importer) importDomain(domain *models.Domain) error {
+	copied := *domain
+	domain = &copied
+	sourceID := domain.ID
+	poolIDs := make([]strfmt.UUID, 0, len(domain.Pools))
+	for _, pool := range domain.Pools {
+		poolID, err := i.resolve("pool", pool)
+		if err != nil {
+			return err
+		}
+		poolIDs = append(poolIDs, poolID)
+	}
+
+	// A deleted domain with the same FQDN cannot be restored anymore once it is taken again
+	sql := i.tx.Rebind(`DELETE FROM domain WHERE fqdn = ? AND provider = ? AND provisioning_status = 'DELETED'`)
+	if _, err := i.tx.Exec(sql, domain.Fqdn, domain.Provider); err != nil {
+		return err
+	}
+	var projectID string
+	sql = i.tx.Rebind(`SELECT project_id FROM domain WHERE fqdn = ? AND provider = ?`)
+	if err := i.tx.Get(&projectID, sql, domain.Fqdn, domain.Provider); err != nil && !errors.Is(err, dbsql.ErrNoRows) {
+		return err
+	} else if err == nil && projectID != i.projectID {
+		return fmt.Errorf("%w: %s", errInvalidConfiguration, utils.DuplicateDomain.Message)
+	}
+	id, err := i.find("domain", sq.Eq{"fqdn": domain.Fqdn, "provider": domain.Provider})
+	if err != nil {
+		return err
+	}
+
+	if err = utils.SetModelDefaults(domain); err != nil {
+		return err
+	}
+	if e := validateDomain(domain); e != nil {
+		return fmt.Errorf("%w: %s", errInvalidConfiguration, e.Message)
+	}
+	domain.ID = id
+	domain.ProjectID = &i.projectID
+	id, action, err := i.upsert(id, `
+		INSERT INTO domain
+			(name, fqdn, record_type, mode, admin_state_up, provider, project_id, ttl, failover_delay,
+			 failback_delay, handout_limit)
+		VALUES
+			(:name, :fqdn, :record_type, :mode, :admin_state_up, :provider, :project_id, :ttl, :failover_delay,
+			 :failback_delay, :handout_limit)
+		RETURNING id`, `
+		UPDATE domain SET
+			name = :name,
+			record_type = :record_type,
+			mode = :mode,
+			admin_state_up = :admin_state_up,
+			ttl = :ttl,
+			failover_delay = :failover_delay,
+			failback_delay = :failback_delay,
+			handout_limit = :handout_limit,
+			deleted_at = NULL,
+			updated_at = NOW(),
+			provisioning_status = 'PENDING_UPDATE'
+		WHERE id = :id`, domain)
+	if err != nil {
+		return err
+	}
+	if action == models.ImportedResourceActionCreated {
+		i.created["domain_"+conv.Value(domain.Provider)]++
+	}
+	domain.ID = id
+	if err = updateDomainAliases(i.tx, domain); err != nil {
+		return err
+	}
+
+	sql = i.tx.Rebind(`DELETE FROM domain_pool_relation WHERE domain_id = ?`)
+	if _, err = i.tx.Exec(sql, id); err != nil {
+		return err
+	}
+	if len(poolIDs) > 0 {
+		if _, err = insertDomainPoolRelations(i.tx, id, i.projectID, poolIDs); err != nil {
+			return err
+		}
+	}
+	if err = server.UpdateDomainStatus(i.tx, id.String()); err != nil {
+		return err
+	}
+	i.record("domain", sourceID, id, action, conv.Value(domain.Provider))
+	return nil
+}
+
+// checkQuota fails the import if it created resources beyond the quota of the project
+func (i *importer) checkQuota() error {
+	for _, column := range quotaColumns {
+		if i.created[column] == 0 {
+			continue
+		}
+		if err := checkProjectQuota(i.tx, i.projectID, column); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag/conv"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sapcc/andromeda/models"
+	"github.com/sapcc/andromeda/restapi/operations/configuration"
+	"github.com/sapcc/andromeda/restapi/operations/pools"
+)
+
+func (t *SuiteTest) importConfiguration(doc *models.Configuration, projectID string) *httptest.ResponseRecorder {
+	res := t.c.Configuration.PostImport(configuration.PostImportParams{
+		Configuration: doc,
+		ProjectID:     conv.Pointer(projectID),
+	})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	return rr
+}
+
+func (t *SuiteTest) TestConfigurationExportImport() {
+	domainID := t.createDomain()
+	defer t.cleanupDomains()
+	t.createPool([]strfmt.UUID{domainID})
+	defer t.cleanupPools()
+
+	res := t.c.Configuration.GetExport(configuration.GetExportParams{})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusOK, rr.Code, rr.Body)
+
+	var doc models.Configuration
+	assert.NoError(t.T(), doc.UnmarshalBinary(rr.Body.Bytes()))
+	assert.Equal(t.T(), int64(ConfigurationVersion), conv.Value(doc.Version))
+	assert.Len(t.T(), doc.Pools, 1, rr.Body)
+	assert.Len(t.T(), doc.Domains, 1, rr.Body)
+	assert.Equal(t.T(), doc.Pools[0].ID, doc.Domains[0].Pools[0], rr.Body)
+
+	// Re-importing into the same project updates the existing resources
+	rr = t.importConfiguration(&doc, "")
+	assert.Equal(t.T(), http.StatusOK, rr.Code, rr.Body)
+	importResponse := configuration.PostImportOKBody{}
+	_ = importResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Len(t.T(), importResponse.Resources, 2, rr.Body)
+	for _, resource := range importResponse.Resources {
+		assert.Equal(t.T(), models.ImportedResourceActionUpdated, resource.Action, rr.Body)
+		assert.Equal(t.T(), resource.SourceID, resource.ID, rr.Body)
+	}
+
+	// The FQDN is taken by another project
+	rr = t.importConfiguration(&doc, "other")
+	assert.Equal(t.T(), http.StatusBadRequest, rr.Code, rr.Body)
+
+	fqdn := strfmt.Hostname("other.test.com")
+	doc.Domains[0].Fqdn = &fqdn
+	rr = t.importConfiguration(&doc, "other")
+	assert.Equal(t.T(), http.StatusOK, rr.Code, rr.Body)
+	importResponse = configuration.PostImportOKBody{}
+	_ = importResponse.UnmarshalBinary(rr.Body.Bytes())
+	for _, resource := range importResponse.Resources {
+		assert.Equal(t.T(), models.ImportedResourceActionCreated, resource.Action, rr.Body)
+		assert.NotEqual(t.T(), resource.SourceID, resource.ID, rr.Body)
+	}
+
+	// Unknown references are rejected
+	doc.Domains[0].Pools = []strfmt.UUID{"00000000-0000-0000-0000-000000000000"}
+	rr = t.importConfiguration(&doc, "other")
+	assert.Equal(t.T(), http.StatusBadRequest, rr.Code, rr.Body)
+}
+
+func (t *SuiteTest) TestConfigurationImportUnnamedPool() {
+	domainID := t.createDomain()
+	defer t.cleanupDomains()
+	res := t.c.Pools.PostPools(pools.PostPoolsParams{Pool: pools.PostPoolsBody{
+		Pool: &models.Pool{Domains: []strfmt.UUID{domainID}}}})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+	defer t.cleanupPools()
+
+	res = t.c.Configuration.GetExport(configuration.GetExportParams{})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	var doc models.Configuration
+	assert.NoError(t.T(), doc.UnmarshalBinary(rr.Body.Bytes()))
+	assert.Len(t.T(), doc.Pools, 1, rr.Body)
+
+	// Importing the export twice matches the unnamed pool by its id instead of creating copies
+	for range 2 {
+		rr = t.importConfiguration(&doc, "")
+		assert.Equal(t.T(), http.StatusOK, rr.Code, rr.Body)
+		importResponse := configuration.PostImportOKBody{}
+		_ = importResponse.UnmarshalBinary(rr.Body.Bytes())
+		assert.Len(t.T(), importResponse.Resources, 2, rr.Body)
+		for _, resource := range importResponse.Resources {
+			assert.Equal(t.T(), models.ImportedResourceActionUpdated, resource.Action, rr.Body)
+			assert.Equal(t.T(), resource.SourceID, resource.ID, rr.Body)
+		}
+	}
+
+	var count int
+	assert.NoError(t.T(), t.db.Get(&count, `SELECT COUNT(*) FROM pool`))
+	assert.Equal(t.T(), 1, count)
+}
@@ -5,28 +5,30 @@
 package controller
 
 import (
-	"context"
-	"time"
-
 	"github.com/actatum/stormrpc"
 	"github.com/apex/log"
 	"github.com/jmoiron/sqlx"
 	"github.com/nats-io/nats.go"
 
 	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
 )
 
 type Controller struct {
-	Domains     DomainController
-	Pools       PoolController
-	Datacenters DatacenterController
-	Members     MemberController
-	Monitors    MonitorController
-	Services    ServiceController
-	Quotas      QuotaController
-	Sync        SyncController
-	GeoMaps     GeoMapController
-	CidrBlocks  CidrBlocksController
+	Domains       DomainController
+	Pools         PoolController
+	Datacenters   DatacenterController
+	Members       MemberController
+	Monitors      MonitorController
+	Services      ServiceController
+	Quotas        QuotaController
+	Sync          SyncController
+	GeoMaps       GeoMapController
+	CidrBlocks    CidrBlocksController
+	F5            F5Controller
+	Agents        AgentController
+	Reset         ResetController
+	Configuration ConfigurationController
 }
 
 type CommonController struct {
@@ -62,23 +64,25 @@ func New(db *sqlx.DB) *Controller {
 		SyncController{cc},
 		GeoMapController{cc},
 		CidrBlocksController{cc, make(map[string]cidrBlocks)},
+		F5Controller{cc},
+		AgentController{cc},
+		ResetController{cc},
+		ConfigurationController{cc},
 	}
 	return &c
 }
 
-func PendingSync(client *stormrpc.Client) error {
-	if client == nil {
+// PendingSync publishes change events of objects entering a PENDING_* state, subscribed agents
+// sync pending changes once no further events arrive.
+func PendingSync(nc *nats.Conn, events ...driver.ChangeEvent) error {
+	if nc == nil {
 		return nil
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	r, err := stormrpc.NewRequest("andromeda.sync", []string{})
-	if err != nil {
-		return err
+	for _, event := range events {
+		if err := driver.PublishChange(nc, event); err != nil {
+			return err
+		}
 	}
-
-	resp := client.Do(ctx, r)
-	return resp.Err
+	return nil
 }
@@ -16,6 +16,7 @@ import (
 
 	"github.com/sapcc/andromeda/db"
 	"github.com/sapcc/andromeda/internal/auth"
+	"github.com/sapcc/andromeda/internal/driver"
 	"github.com/sapcc/andromeda/internal/utils"
 	"github.com/sapcc/andromeda/models"
 	"github.com/sapcc/andromeda/restapi/operations/datacenters"
@@ -99,7 +100,8 @@ func (c DatacenterController) PostDatacenters(params datacenters.PostDatacenters
 		panic(err)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{
+		Model: "DATACENTER", ID: datacenter.ID.String(), Provider: datacenter.Provider, Status: "PENDING_CREATE"})
 	return datacenters.NewPostDatacentersCreated().WithPayload(&datacenters.PostDatacentersCreatedBody{Datacenter: datacenter})
 }
 
@@ -158,11 +160,42 @@ func (c DatacenterController) PutDatacentersDatacenterID(params datacenters.PutD
 		panic(err)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{
+		Model: "DATACENTER", ID: datacenter.ID.String(), Provider: datacenter.Provider, Status: "PENDING_UPDATE"})
 	return datacenters.NewPutDatacentersDatacenterIDAccepted().WithPayload(
 		&datacenters.PutDatacentersDatacenterIDAcceptedBody{Datacenter: &datacenter})
 }
 
+// PostDatacentersDatacenterIDRetry POST /datacenters/:id/retry
+func (c DatacenterController) PostDatacentersDatacenterIDRetry(params datacenters.PostDatacentersDatacenterIDRetryParams) middleware.Responder {
+	datacenter := models.Datacenter{ID: params.DatacenterID}
+	if err := PopulateDatacenter(c.db, &datacenter, []string{"project_id", "scope"}); err != nil {
+		return datacenters.NewPostDatacentersDatacenterIDRetryNotFound().WithPayload(utils.NotFound)
+	}
+	requestVars := map[string]string{"project_id": *datacenter.ProjectID, "scope": *datacenter.Scope}
+	if _, err := auth.Authenticate(params.HTTPRequest, requestVars); err != nil {
+		return datacenters.NewPostDatacentersDatacenterIDRetryDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	if err := db.TxExecute(c.db, func(tx *sqlx.Tx) error {
+		return retryProvisioning(tx, "datacenter", params.DatacenterID)
+	}); err != nil {
+		if errors.Is(err, errNotInErrorState) {
+			return datacenters.NewPostDatacentersDatacenterIDRetryConflict().WithPayload(utils.NotInErrorState)
+		}
+		panic(err)
+	}
+
+	if err := PopulateDatacenter(c.db, &datacenter, []string{"*"}); err != nil {
+		panic(err)
+	}
+
+	_ = PendingSync(c.nc, driver.ChangeEvent{
+		Model: "DATACENTER", ID: datacenter.ID.String(), Provider: datacenter.Provider, Status: "PENDING_UPDATE"})
+	return datacenters.NewPostDatacentersDatacenterIDRetryAccepted().WithPayload(
+		&datacenters.PostDatacentersDatacenterIDRetryAcceptedBody{Datacenter: &datacenter})
+}
+
 // DeleteDatacentersDatacenterID DELETE /datacenters/:id
 func (c DatacenterController) DeleteDatacentersDatacenterID(params datacenters.DeleteDatacentersDatacenterIDParams) middleware.Responder {
 	datacenter := models.Datacenter{ID: params.DatacenterID}
@@ -201,7 +234,8 @@ func (c DatacenterController) DeleteDatacentersDatacenterID(params datacenters.D
 		return datacenters.NewDeleteDatacentersDatacenterIDNotFound().WithPayload(utils.NotFound)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{
+		Model: "DATACENTER", ID: datacenter.ID.String(), Provider: datacenter.Provider, Status: "PENDING_DELETE"})
 	return datacenters.NewDeleteDatacentersDatacenterIDNoContent()
 }
 
@@ -8,12 +8,16 @@ import (
 	dbsql "database/sql"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/swag/conv"
 	"github.com/go-sql-driver/mysql"
 	"github.com/jackc/pgerrcode"
 	"github.com/jmoiron/sqlx"
@@ -21,11 +25,18 @@ import (
 	"github.com/sapcc/andromeda/db"
 	"github.com/sapcc/andromeda/internal/auth"
 	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
+	"github.com/sapcc/andromeda/internal/rpc/server"
 	"github.com/sapcc/andromeda/internal/utils"
 	"github.com/sapcc/andromeda/models"
 	"github.com/sapcc/andromeda/restapi/operations/domains"
 )
 
+var (
+	errDomainNotRestorable = errors.New("domain not restorable")
+	errDomainDeleted       = errors.New("domain deleted")
+)
+
 type DomainController struct {
 	CommonController
 }
@@ -67,6 +78,9 @@ func (c DomainController) GetDomains(params domains.GetDomainsParams) middleware
 		if err := PopulateDomainPools(c.db, &domain); err != nil {
 			panic(err)
 		}
+		if err := PopulateDomainAliases(c.db, &domain); err != nil {
+			panic(err)
+		}
 		_domains = append(_domains, &domain)
 	}
 	_links := pagination.GetLinks(_domains)
@@ -93,6 +107,11 @@ func (c DomainController) PostDomains(params domains.PostDomainsParams) middlewa
 		return domains.NewPostDomainsDefault(400).WithPayload(utils.MissingProvider)
 	}
 
+	warning, refuse := checkProviderAgent(c.db, *domain.Provider)
+	if refuse {
+		return domains.NewPostDomainsDefault(400).WithPayload(utils.GetErrorNoLiveAgent(*domain.Provider))
+	}
+
 	if domain.Pools == nil {
 		domain.Pools = []strfmt.UUID{}
 	}
@@ -102,13 +121,25 @@ func (c DomainController) PostDomains(params domains.PostDomainsParams) middlewa
 		panic(err)
 	}
 
+	if e := validateDomain(domain); e != nil {
+		return domains.NewPostDomainsDefault(400).WithPayload(e)
+	}
+
 	// Wrap insert and relations into transaction
 	if err := db.TxExecute(c.db, func(tx *sqlx.Tx) error {
-		sql := `
+		// A deleted domain with the same FQDN cannot be restored anymore once it is taken again
+		sql := tx.Rebind(`DELETE FROM domain WHERE fqdn = ? AND provider = ? AND provisioning_status = 'DELETED'`)
+		if _, err := tx.Exec(sql, domain.Fqdn, domain.Provider); err != nil {
+			return err
+		}
+
+		sql = `
 			INSERT INTO domain 
-				(name, fqdn, record_type, mode, admin_state_up, provider, project_id)
+				(name, fqdn, record_type, mode, admin_state_up, provider, project_id, ttl, failover_delay,
+				 failback_delay, handout_limit)
 			VALUES
-				(:name, :fqdn, :record_type, :mode, :admin_state_up, :provider, :project_id)
+				(:name, :fqdn, :record_type, :mode, :admin_state_up, :provider, :project_id, :ttl, :failover_delay,
+				 :failback_delay, :handout_limit)
 			RETURNING *
 		`
 		stmt, err := tx.PrepareNamed(sql)
@@ -125,7 +156,7 @@ func (c DomainController) PostDomains(params domains.PostDomainsParams) middlewa
 				return err
 			}
 		}
-		return nil
+		return updateDomainAliases(tx, domain)
 	}); err != nil {
 		var rnfError *utils.ResourcesNotFoundError
 		if errors.As(err, &rnfError) {
@@ -144,9 +175,10 @@ func (c DomainController) PostDomains(params domains.PostDomainsParams) middlewa
 		panic(err)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{
+		Model: "DOMAIN", ID: domain.ID.String(), Provider: swag.StringValue(domain.Provider), Status: "PENDING_CREATE"})
 	populateCNAME(domain)
-	return domains.NewPostDomainsCreated().WithPayload(&domains.PostDomainsCreatedBody{Domain: domain})
+	return withWarning(domains.NewPostDomainsCreated().WithPayload(&domains.PostDomainsCreatedBody{Domain: domain}), warning)
 }
 
 // GetDomainsDomainID GET /domains/:id
@@ -182,6 +214,12 @@ func (c DomainController) PutDomainsDomainID(params domains.PutDomainsDomainIDPa
 		return domains.NewPutDomainsDomainIDNotFound().WithPayload(utils.ProviderUnchangeable)
 	}
 
+	// the update is provisioned by the agents of the provider, just as the creation
+	warning, refuse := checkProviderAgent(c.db, *domain.Provider)
+	if refuse {
+		return domains.NewPutDomainsDomainIDBadRequest().WithPayload(utils.GetErrorNoLiveAgent(*domain.Provider))
+	}
+
 	if params.Domain.Domain.Fqdn != nil {
 		if *params.Domain.Domain.Fqdn == "" {
 			return domains.NewPutDomainsDomainIDBadRequest().WithPayload(utils.MissingFQDN)
@@ -193,11 +231,46 @@ func (c DomainController) PutDomainsDomainID(params domains.PutDomainsDomainIDPa
 		}
 	}
 
+	// validate the domain as it will be after the update
+	updated := domain
+	if params.Domain.Domain.RecordType != nil {
+		updated.RecordType = params.Domain.Domain.RecordType
+	}
+	if params.Domain.Domain.Aliases != nil {
+		updated.Aliases = params.Domain.Domain.Aliases
+	}
+	if params.Domain.Domain.TTL != nil {
+		updated.TTL = params.Domain.Domain.TTL
+	}
+	if params.Domain.Domain.FailoverDelay != nil {
+		updated.FailoverDelay = params.Domain.Domain.FailoverDelay
+	}
+	if params.Domain.Domain.FailbackDelay != nil {
+		updated.FailbackDelay = params.Domain.Domain.FailbackDelay
+	}
+	if params.Domain.Domain.HandoutLimit != nil {
+		updated.HandoutLimit = params.Domain.Domain.HandoutLimit
+	}
+	if e := validateDomain(&updated); e != nil {
+		return domains.NewPutDomainsDomainIDBadRequest().WithPayload(e)
+	}
+
 	if err := db.TxExecute(c.db, func(tx *sqlx.Tx) error {
+		// Deleted domains must be restored first, an update would provision them again
+		var provisioningStatus string
+		sql := tx.Rebind(`SELECT provisioning_status FROM domain WHERE id = ? FOR UPDATE`)
+		if err := tx.Get(&provisioningStatus, sql, params.DomainID); err != nil {
+			return err
+		}
+		if provisioningStatus == models.DomainProvisioningStatusPENDINGDELETE ||
+			provisioningStatus == models.DomainProvisioningStatusDELETED {
+			return errDomainDeleted
+		}
+
 		// Populate args
 		if params.Domain.Domain.Pools != nil {
 			var existingPoolRefs []strfmt.UUID
-			sql := tx.Rebind(`SELECT pool_id FROM domain_pool_relation WHERE domain_id = ? FOR UPDATE`)
+			sql = tx.Rebind(`SELECT pool_id FROM domain_pool_relation WHERE domain_id = ? FOR UPDATE`)
 			if err := tx.Select(&existingPoolRefs, sql, params.DomainID); err != nil {
 				return err
 			}
@@ -215,17 +288,24 @@ func (c DomainController) PutDomainsDomainID(params domains.PutDomainsDomainIDPa
 					return err
 				}
 			}
+			if err := updateDomainPoolPriorities(tx, params.DomainID, params.Domain.Domain.Pools); err != nil {
+				return err
+			}
 		}
 
 		// Update
 		params.Domain.Domain.ID = params.DomainID
-		sql := `
+		sql = `
 			UPDATE domain SET
 				name = COALESCE(:name, name),
 				admin_state_up = COALESCE(:admin_state_up, admin_state_up),
 				fqdn = COALESCE(:fqdn, fqdn), 
 				mode = COALESCE(:mode, mode), 
 				record_type = COALESCE(:record_type, record_type), 
+				ttl = COALESCE(:ttl, ttl),
+				failover_delay = COALESCE(:failover_delay, failover_delay),
+				failback_delay = COALESCE(:failback_delay, failback_delay),
+				handout_limit = COALESCE(:handout_limit, handout_limit),
 			    provisioning_status = 'PENDING_UPDATE',
 				updated_at = NOW()
 			WHERE id = :id
@@ -233,8 +313,13 @@ func (c DomainController) PutDomainsDomainID(params domains.PutDomainsDomainIDPa
 		if _, err := tx.NamedExec(sql, params.Domain.Domain); err != nil {
 			return err
 		}
+		if params.Domain.Domain.Aliases != nil {
+			if err := updateDomainAliases(tx, params.Domain.Domain); err != nil {
+				return err
+			}
+		}
 
-		return nil
+		return server.UpdateDomainStatus(tx, params.DomainID.String())
 	}); err != nil {
 		var rnfError *utils.ResourcesNotFoundError
 		if errors.As(err, &rnfError) {
@@ -245,6 +330,9 @@ func (c DomainController) PutDomainsDomainID(params domains.PutDomainsDomainIDPa
 		if errors.Is(err, dbsql.ErrNoRows) {
 			return domains.NewPutDomainsDomainIDNotFound().WithPayload(utils.NotFound)
 		}
+		if errors.Is(err, errDomainDeleted) {
+			return domains.NewPutDomainsDomainIDConflict().WithPayload(utils.DomainDeleted)
+		}
 		// Unknown Error
 		panic(err)
 	}
@@ -254,15 +342,118 @@ func (c DomainController) PutDomainsDomainID(params domains.PutDomainsDomainIDPa
 		panic(err)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{
+		Model: "DOMAIN", ID: domain.ID.String(), Provider: swag.StringValue(domain.Provider), Status: "PENDING_UPDATE"})
+	populateCNAME(&domain)
+	return withWarning(domains.NewPutDomainsDomainIDAccepted().WithPayload(
+		&domains.PutDomainsDomainIDAcceptedBody{Domain: &domain}), warning)
+}
+
+// PostDomainsDomainIDRetry POST /domains/:id/retry
+func (c DomainController) PostDomainsDomainIDRetry(params domains.PostDomainsDomainIDRetryParams) middleware.Responder {
+	domain := models.Domain{ID: params.DomainID, Pools: []strfmt.UUID{}}
+	if err := PopulateDomain(c.db, &domain, []string{"project_id"}); err != nil {
+		return domains.NewPostDomainsDomainIDRetryNotFound().WithPayload(utils.NotFound)
+	}
+	requestVars := map[string]string{"project_id": *domain.ProjectID}
+	if _, err := auth.Authenticate(params.HTTPRequest, requestVars); err != nil {
+		return domains.NewPostDomainsDomainIDRetryDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	if err := db.TxExecute(c.db, func(tx *sqlx.Tx) error {
+		return retryProvisioning(tx, "domain", params.DomainID)
+	}); err != nil {
+		if errors.Is(err, errNotInErrorState) {
+			return domains.NewPostDomainsDomainIDRetryConflict().WithPayload(utils.NotInErrorState)
+		}
+		panic(err)
+	}
+
+	if err := PopulateDomain(c.db, &domain, []string{"*"}); err != nil {
+		panic(err)
+	}
+
+	_ = PendingSync(c.nc, driver.ChangeEvent{
+		Model: "DOMAIN", ID: domain.ID.String(), Provider: swag.StringValue(domain.Provider), Status: "PENDING_UPDATE"})
+	populateCNAME(&domain)
+	return domains.NewPostDomainsDomainIDRetryAccepted().WithPayload(
+		&domains.PostDomainsDomainIDRetryAcceptedBody{Domain: &domain})
+}
+
+// PostDomainsDomainIDRestore POST /domains/:id/restore
+func (c DomainController) PostDomainsDomainIDRestore(params domains.PostDomainsDomainIDRestoreParams) middleware.Responder {
+	domain := models.Domain{ID: params.DomainID, Pools: []strfmt.UUID{}}
+	if err := PopulateDomain(c.db, &domain, []string{"project_id", "provider"}); err != nil {
+		return domains.NewPostDomainsDomainIDRestoreNotFound().WithPayload(utils.NotFound)
+	}
+	requestVars := map[string]string{"project_id": *domain.ProjectID}
+	if _, err := auth.Authenticate(params.HTTPRequest, requestVars); err != nil {
+		return domains.NewPostDomainsDomainIDRestoreDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	var provisioningStatus string
+	if err := db.TxExecute(c.db, func(tx *sqlx.Tx) error {
+		var deleted struct {
+			ProvisioningStatus string           `db:"provisioning_status"`
+			DeletedAt          *strfmt.DateTime `db:"deleted_at"`
+		}
+		sql := tx.Rebind(`SELECT provisioning_status, deleted_at FROM domain WHERE id = ? FOR UPDATE`)
+		if err := tx.Get(&deleted, sql, params.DomainID); err != nil {
+			return err
+		}
+		window := time.Duration(config.Global.ApiSettings.DomainRestoreWindow) * time.Second
+		if deleted.DeletedAt == nil || time.Time(*deleted.DeletedAt).Before(time.Now().UTC().Add(-window)) {
+			return errDomainNotRestorable
+		}
+
+		// Domains already removed from the provider need to be created again
+		provisioningStatus = models.DomainProvisioningStatusPENDINGUPDATE
+		if deleted.ProvisioningStatus == models.DomainProvisioningStatusDELETED {
+			provisioningStatus = models.DomainProvisioningStatusPENDINGCREATE
+		}
+		sql = tx.Rebind(`UPDATE domain SET provisioning_status = ?, deleted_at = NULL, updated_at = NOW() WHERE id = ?`)
+		if _, err := tx.Exec(sql, provisioningStatus, params.DomainID); err != nil {
+			return err
+		}
+
+		// Domains in DELETED don't count against the quota, it might have been used up in the meantime
+		column := "domain_" + swag.StringValue(domain.Provider)
+		if config.Global.Quota.Enabled && deleted.ProvisioningStatus == models.DomainProvisioningStatusDELETED &&
+			slices.Contains(quotaColumns, column) {
+			if err := checkProjectQuota(tx, *domain.ProjectID, column); err != nil {
+				return err
+			}
+		}
+		return server.UpdateDomainStatus(tx, params.DomainID.String())
+	}); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return domains.NewPostDomainsDomainIDRestoreNotFound().WithPayload(utils.NotFound)
+		}
+		if errors.Is(err, errDomainNotRestorable) {
+			return domains.NewPostDomainsDomainIDRestoreConflict().WithPayload(utils.NotRestorable)
+		}
+		var quotaErr *quotaExceededError
+		if errors.As(err, &quotaErr) {
+			return domains.NewPostDomainsDomainIDRestoreDefault(403).WithPayload(utils.GetQuotaMetResponse(quotaErr.resource))
+		}
+		panic(err)
+	}
+
+	if err := PopulateDomain(c.db, &domain, []string{"*"}); err != nil {
+		panic(err)
+	}
+
+	_ = PendingSync(c.nc, driver.ChangeEvent{
+		Model: "DOMAIN", ID: domain.ID.String(), Provider: swag.StringValue(domain.Provider), Status: provisioningStatus})
 	populateCNAME(&domain)
-	return domains.NewPutDomainsDomainIDAccepted().WithPayload(&domains.PutDomainsDomainIDAcceptedBody{Domain: &domain})
+	return domains.NewPostDomainsDomainIDRestoreAccepted().WithPayload(
+		&domains.PostDomainsDomainIDRestoreAcceptedBody{Domain: &domain})
 }
 
 // DeleteDomainsDomainID DELETE /domains/:id
 func (c DomainController) DeleteDomainsDomainID(params domains.DeleteDomainsDomainIDParams) middleware.Responder {
 	domain := models.Domain{ID: params.DomainID}
-	if err := PopulateDomain(c.db, &domain, []string{"id", "project_id"}); err != nil {
+	if err := PopulateDomain(c.db, &domain, []string{"id", "project_id", "provider"}); err != nil {
 		return domains.NewDeleteDomainsDomainIDNotFound().WithPayload(utils.NotFound)
 	}
 	requestVars := map[string]string{"project_id": *domain.ProjectID}
@@ -270,13 +461,20 @@ func (c DomainController) DeleteDomainsDomainID(params domains.DeleteDomainsDoma
 		return domains.NewDeleteDomainsDomainIDDefault(403).WithPayload(utils.PolicyForbidden)
 	}
 
-	sql := c.db.Rebind(`UPDATE domain SET provisioning_status = 'PENDING_DELETE', updated_at = NOW() WHERE id = ?`)
-	res := c.db.MustExec(sql, params.DomainID)
+	sql := `UPDATE domain SET provisioning_status = 'PENDING_DELETE', updated_at = NOW(), deleted_at = ?
+		WHERE id = ? AND provisioning_status != 'DELETED'`
+	// Soft-delete, the domain can be restored until house keeping purges it after the restore window
+	var deletedAt *time.Time
+	if config.Global.ApiSettings.DomainRestoreWindow > 0 {
+		deletedAt = conv.Pointer(time.Now().UTC())
+	}
+	res := c.db.MustExec(c.db.Rebind(sql), deletedAt, params.DomainID)
 	if deleted, _ := res.RowsAffected(); deleted != 1 {
 		return domains.NewDeleteDomainsDomainIDNotFound().WithPayload(utils.NotFound)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{
+		Model: "DOMAIN", ID: domain.ID.String(), Provider: swag.StringValue(domain.Provider), Status: "PENDING_DELETE"})
 	return domains.NewDeleteDomainsDomainIDNoContent()
 }
 
@@ -309,24 +507,43 @@ func insertDomainPoolRelations(tx *sqlx.Tx, domainID strfmt.UUID, projectID stri
 		return nil, &utils.ResourcesNotFoundError{Ids: missingPools, Resource: "Pool"}
 	}
 
+	// new pools are appended to the pools of the domain, with the lowest priority
+	var priority int
+	sql = tx.Rebind(`SELECT COALESCE(MAX(priority) + 1, 0) FROM domain_pool_relation WHERE domain_id = ?`)
+	if err := tx.Get(&priority, sql, domainID); err != nil {
+		return nil, err
+	}
 	for _, poolID := range poolIDs {
 		if _, err := tx.NamedExec(
-			"INSERT INTO domain_pool_relation (domain_id, pool_id) VALUES (:domain_id, :pool_id)",
+			"INSERT INTO domain_pool_relation (domain_id, pool_id, priority) VALUES (:domain_id, :pool_id, :priority)",
 			map[string]interface{}{
 				"domain_id": domainID,
 				"pool_id":   poolID,
+				"priority":  priority,
 			},
 		); err != nil {
 			return &poolID, err
 		}
+		priority++
 	}
 	return nil, UpdateCascadeDomain(tx, domainID, "PENDING_UPDATE")
 }
 
-// PopulateDomainPools populates a domain instance with associated pools
+// updateDomainPoolPriorities orders the pools of a domain, the first pool has the highest priority
+func updateDomainPoolPriorities(tx *sqlx.Tx, domainID strfmt.UUID, poolIDs []strfmt.UUID) error {
+	sql := tx.Rebind(`UPDATE domain_pool_relation SET priority = ? WHERE domain_id = ? AND pool_id = ?`)
+	for priority, poolID := range poolIDs {
+		if _, err := tx.Exec(sql, priority, domainID, poolID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PopulateDomainPools populates a domain instance with associated pools in order of priority
 func PopulateDomainPools(db *sqlx.DB, domain *models.Domain) error {
 	// Get pool_ids associated
-	sql := db.Rebind(`SELECT pool_id FROM domain_pool_relation WHERE domain_id = ?`)
+	sql := db.Rebind(`SELECT pool_id FROM domain_pool_relation WHERE domain_id = ? ORDER BY priority, pool_id`)
 	if err := db.Select(&domain.Pools, sql, domain.ID); err != nil {
 		return err
 	}
@@ -344,6 +561,79 @@ func PopulateDomain(db *sqlx.DB, domain *models.Domain, fields []string) error {
 	if err := PopulateDomainPools(db, domain); err != nil {
 		return err
 	}
+	return PopulateDomainAliases(db, domain)
+}
+
+// PopulateDomainAliases populates a domain instance with its aliases
+func PopulateDomainAliases(db *sqlx.DB, domain *models.Domain) error {
+	domain.Aliases = []string{}
+	sql := db.Rebind(`SELECT alias FROM domain_alias WHERE domain_id = ? ORDER BY alias`)
+	return db.Select(&domain.Aliases, sql, domain.ID)
+}
+
+// updateDomainAliases replaces the aliases of a domain
+func updateDomainAliases(tx *sqlx.Tx, domain *models.Domain) error {
+	sql := tx.Rebind(`DELETE FROM domain_alias WHERE domain_id = ?`)
+	if _, err := tx.Exec(sql, domain.ID); err != nil {
+		return err
+	}
+	aliases := slices.Clone(domain.Aliases)
+	slices.Sort(aliases)
+	for _, alias := range slices.Compact(aliases) {
+		sql = tx.Rebind(`INSERT INTO domain_alias (domain_id, alias) VALUES (?, ?)`)
+		if _, err := tx.Exec(sql, domain.ID, alias); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// domainBounds are the provider specific bounds of the timing attributes of a domain, attributes without
+// bounds are only limited by the API schema.
+var domainBounds = map[string]map[string][2]int64{
+	models.DomainProviderAkamai: {
+		"ttl":           {30, 3600},
+		"handout_limit": {0, 8},
+	},
+	models.DomainProviderF5: {
+		// a pool TTL of 0 falls back to the BIG-IP default
+		"ttl": {1, 86400},
+		// wide IPs have no failover timing, pools fail over immediately
+		"failover_delay": {0, 0},
+		"failback_delay": {0, 0},
+	},
+}
+
+// validateDomain rejects record types, aliases and timing attributes a provider cannot express: Akamai has no
+// MX properties and property names are plain hostnames, F5 wide IP aliases may contain the wildcards * and ?.
+func validateDomain(domain *models.Domain) *models.Error {
+	provider := conv.Value(domain.Provider)
+	if provider == models.DomainProviderAkamai && conv.Value(domain.RecordType) == models.DomainRecordTypeMX {
+		return utils.MXUnsupportedForAkamai
+	}
+	for _, alias := range domain.Aliases {
+		hostname := alias
+		if provider == models.DomainProviderF5 {
+			hostname = strings.NewReplacer("*", "x", "?", "x").Replace(alias)
+		}
+		if !strfmt.IsHostname(hostname) {
+			return utils.GetErrorInvalidAlias(alias)
+		}
+	}
+	for _, attr := range []struct {
+		field string
+		value *int64
+	}{
+		{"ttl", domain.TTL},
+		{"failover_delay", domain.FailoverDelay},
+		{"failback_delay", domain.FailbackDelay},
+		{"handout_limit", domain.HandoutLimit},
+	} {
+		bounds, ok := domainBounds[provider][attr.field]
+		if ok && attr.value != nil && (*attr.value < bounds[0] || *attr.value > bounds[1]) {
+			return utils.GetErrorOutOfBounds(attr.field, provider, bounds[0], bounds[1])
+		}
+	}
 	return nil
 }
 
@@ -353,7 +643,8 @@ func UpdateCascadeDomain(tx *sqlx.Tx, domainID strfmt.UUID, provisioningStatus s
 	if _, err := tx.Exec(tx.Rebind(sql), domainID); err != nil {
 		return err
 	}
-	return nil
+	// Pools may have been added or removed
+	return server.UpdateDomainStatus(tx, domainID.String())
 }
 
 func populateCNAME(domain *models.Domain) {
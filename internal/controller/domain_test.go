@@ -5,16 +5,24 @@
 package controller
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag/conv"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
+	"github.com/sapcc/andromeda/internal/housekeeping"
+	"github.com/sapcc/andromeda/internal/rpc/server"
 	"github.com/sapcc/andromeda/models"
 	"github.com/sapcc/andromeda/restapi/operations/domains"
+	"github.com/sapcc/andromeda/restapi/operations/members"
 )
 
 func (t *SuiteTest) createDomain() strfmt.UUID {
@@ -82,3 +90,359 @@ func (t *SuiteTest) TestDomains() {
 	res.WriteResponse(rr, runtime.JSONProducer())
 	assert.Equal(t.T(), rr.Code, http.StatusNoContent, rr.Body)
 }
+
+func (t *SuiteTest) TestDomainRetry() {
+	domainID := t.createDomain()
+	defer t.cleanupDomains()
+
+	// Only domains in ERROR can be retried
+	res := t.c.Domains.PostDomainsDomainIDRetry(domains.PostDomainsDomainIDRetryParams{DomainID: domainID})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusConflict, rr.Code, rr.Body)
+
+	rpc := server.RPCHandler{DB: t.db}
+	resp, err := rpc.UpdateProvisioningStatus(context.Background(), &server.ProvisioningStatusRequest{
+		ProvisioningStatus: []*server.ProvisioningStatusRequest_ProvisioningStatus{{
+			Id:     domainID.String(),
+			Model:  server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN,
+			Status: server.ProvisioningStatusRequest_ProvisioningStatus_ERROR,
+			Error:  "configuration denied",
+		}},
+	})
+	assert.NoError(t.T(), err)
+	assert.True(t.T(), resp.GetProvisioningStatusResult()[0].GetSuccess())
+
+	res = t.c.Domains.GetDomainsDomainID(domains.GetDomainsDomainIDParams{DomainID: domainID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	domainResponse := domains.GetDomainsDomainIDOKBody{}
+	_ = domainResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Equal(t.T(), "ERROR", domainResponse.Domain.ProvisioningStatus, rr.Body)
+	assert.Equal(t.T(), "configuration denied", conv.Value(domainResponse.Domain.ProvisioningError), rr.Body)
+	assert.NotNil(t.T(), domainResponse.Domain.LastErrorAt, rr.Body)
+
+	// ERROR is left by retrying only
+	resp, err = rpc.UpdateProvisioningStatus(context.Background(), &server.ProvisioningStatusRequest{
+		ProvisioningStatus: []*server.ProvisioningStatusRequest_ProvisioningStatus{{
+			Id:     domainID.String(),
+			Model:  server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN,
+			Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE,
+		}},
+	})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), server.StatusResult_INVALID_TRANSITION, resp.GetProvisioningStatusResult()[0].GetReason())
+
+	res = t.c.Domains.PostDomainsDomainIDRetry(domains.PostDomainsDomainIDRetryParams{DomainID: domainID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusAccepted, rr.Code, rr.Body)
+	retryResponse := domains.PostDomainsDomainIDRetryAcceptedBody{}
+	_ = retryResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Equal(t.T(), "PENDING_UPDATE", retryResponse.Domain.ProvisioningStatus, rr.Body)
+	assert.Equal(t.T(), "configuration denied", conv.Value(retryResponse.Domain.ProvisioningError), rr.Body)
+}
+
+func (t *SuiteTest) TestDomainRestore() {
+	config.Global.ApiSettings.DomainRestoreWindow = 3600
+	defer func() { config.Global.ApiSettings.DomainRestoreWindow = 0 }()
+	domainID := t.createDomain()
+	defer t.cleanupDomains()
+
+	restore := func() *httptest.ResponseRecorder {
+		res := t.c.Domains.PostDomainsDomainIDRestore(domains.PostDomainsDomainIDRestoreParams{DomainID: domainID})
+		rr := httptest.NewRecorder()
+		res.WriteResponse(rr, runtime.JSONProducer())
+		return rr
+	}
+
+	// Only deleted domains can be restored
+	rr := restore()
+	assert.Equal(t.T(), http.StatusConflict, rr.Code, rr.Body)
+
+	res := t.c.Domains.DeleteDomainsDomainID(domains.DeleteDomainsDomainIDParams{DomainID: domainID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusNoContent, rr.Code, rr.Body)
+
+	// The agent removed the domain from the provider, it is kept until the restore window elapsed
+	rpc := server.RPCHandler{DB: t.db}
+	resp, err := rpc.UpdateProvisioningStatus(context.Background(), &server.ProvisioningStatusRequest{
+		ProvisioningStatus: []*server.ProvisioningStatusRequest_ProvisioningStatus{{
+			Id:     domainID.String(),
+			Model:  server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN,
+			Status: server.ProvisioningStatusRequest_ProvisioningStatus_DELETED,
+		}},
+	})
+	assert.NoError(t.T(), err)
+	assert.True(t.T(), resp.GetProvisioningStatusResult()[0].GetSuccess())
+
+	res = t.c.Domains.GetDomainsDomainID(domains.GetDomainsDomainIDParams{DomainID: domainID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	domainResponse := domains.GetDomainsDomainIDOKBody{}
+	_ = domainResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Equal(t.T(), "DELETED", domainResponse.Domain.ProvisioningStatus, rr.Body)
+	assert.NotNil(t.T(), domainResponse.Domain.DeletedAt, rr.Body)
+
+	// Deleted domains are not provisioned again by an update
+	res = t.c.Domains.PutDomainsDomainID(domains.PutDomainsDomainIDParams{
+		DomainID: domainID,
+		Domain:   domains.PutDomainsDomainIDBody{Domain: &models.Domain{Name: conv.Pointer("updated")}},
+	})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusConflict, rr.Code, rr.Body)
+
+	rr = restore()
+	assert.Equal(t.T(), http.StatusAccepted, rr.Code, rr.Body)
+	restoreResponse := domains.PostDomainsDomainIDRestoreAcceptedBody{}
+	_ = restoreResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Equal(t.T(), "PENDING_CREATE", restoreResponse.Domain.ProvisioningStatus, rr.Body)
+	assert.Nil(t.T(), restoreResponse.Domain.DeletedAt, rr.Body)
+
+	// Once the restore window elapsed, the domain can't be restored and is purged by house keeping
+	res = t.c.Domains.DeleteDomainsDomainID(domains.DeleteDomainsDomainIDParams{DomainID: domainID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusNoContent, rr.Code, rr.Body)
+	_, err = t.db.Exec(t.db.Rebind(`UPDATE domain SET provisioning_status = 'DELETED', deleted_at = ? WHERE id = ?`),
+		time.Now().UTC().Add(-2*time.Hour), domainID)
+	assert.NoError(t.T(), err)
+
+	rr = restore()
+	assert.Equal(t.T(), http.StatusConflict, rr.Code, rr.Body)
+
+	e := housekeeping.Executor{DB: t.db}
+	assert.NoError(t.T(), e.CleanupDeletedDomains(context.Background(), prometheus.Labels{}))
+	res = t.c.Domains.GetDomainsDomainID(domains.GetDomainsDomainIDParams{DomainID: domainID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusNotFound, rr.Code, rr.Body)
+}
+
+func (t *SuiteTest) TestDomainRestoreQuota() {
+	config.Global.ApiSettings.DomainRestoreWindow = 3600
+	config.Global.Quota.Enabled = true
+	defer func() {
+		config.Global.ApiSettings.DomainRestoreWindow = 0
+		config.Global.Quota.Enabled = false
+		_, _ = t.db.Exec("DELETE FROM quota")
+	}()
+	domainID := t.createDomain()
+	defer t.cleanupDomains()
+	_, err := t.db.Exec(t.db.Rebind(`UPDATE domain SET provisioning_status = 'DELETED', deleted_at = ? WHERE id = ?`),
+		time.Now().UTC(), domainID)
+	assert.NoError(t.T(), err)
+
+	// The quota of the deleted domain is used by another domain
+	_, err = t.db.Exec(`
+		INSERT INTO quota (project_id, domain_akamai, domain_f5, domain_dns, pool, member, monitor, datacenter)
+		SELECT project_id, 1, 0, 0, 0, 0, 0, 0 FROM domain`)
+	assert.NoError(t.T(), err)
+	fqdn := strfmt.Hostname("other.com")
+	res := t.c.Domains.PostDomains(domains.PostDomainsParams{Domain: domains.PostDomainsBody{
+		Domain: &models.Domain{Fqdn: &fqdn, Provider: conv.Pointer("akamai")},
+	}})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+
+	res = t.c.Domains.PostDomainsDomainIDRestore(domains.PostDomainsDomainIDRestoreParams{DomainID: domainID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusForbidden, rr.Code, rr.Body)
+
+	// The domain is left deleted
+	res = t.c.Domains.GetDomainsDomainID(domains.GetDomainsDomainIDParams{DomainID: domainID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	domainResponse := domains.GetDomainsDomainIDOKBody{}
+	_ = domainResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Equal(t.T(), "DELETED", domainResponse.Domain.ProvisioningStatus, rr.Body)
+}
+
+func (t *SuiteTest) TestDomainDeleteCascade() {
+	domainID := t.createDomain()
+	defer t.cleanupDomains()
+	poolID := t.createPool([]strfmt.UUID{domainID})
+	defer t.cleanupPools()
+
+	createMember := func(address string) strfmt.UUID {
+		member := members.PostMembersBody{Member: &models.Member{
+			Address: conv.Pointer(address), Port: conv.Pointer(int64(80)), PoolID: &poolID}}
+		res := t.c.Members.PostMembers(members.PostMembersParams{Member: member})
+		rr := httptest.NewRecorder()
+		res.WriteResponse(rr, runtime.JSONProducer())
+		assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+		memberResponse := members.PostMembersCreatedBody{}
+		_ = memberResponse.UnmarshalBinary(rr.Body.Bytes())
+		return memberResponse.Member.ID
+	}
+	keptID := createMember("1.2.3.4")
+	deletedID := createMember("1.2.3.5")
+
+	res := t.c.Members.DeleteMembersMemberID(members.DeleteMembersMemberIDParams{MemberID: deletedID})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusNoContent, rr.Code, rr.Body)
+	res = t.c.Domains.DeleteDomainsDomainID(domains.DeleteDomainsDomainIDParams{DomainID: domainID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusNoContent, rr.Code, rr.Body)
+
+	// The agent removed the domain's property, children pending deletion are deleted along with it
+	rpc := server.RPCHandler{DB: t.db}
+	domainsResponse, err := rpc.GetDomains(context.Background(), &server.SearchRequest{
+		Ids: []string{domainID.String()}, FullyPopulated: true})
+	assert.NoError(t.T(), err)
+	assert.Len(t.T(), domainsResponse.GetResponse(), 1)
+	resp, err := rpc.UpdateProvisioningStatus(context.Background(), &server.ProvisioningStatusRequest{
+		ProvisioningStatus: driver.DeletedDomainProvisioningRequests(domainsResponse.GetResponse()[0]),
+	})
+	assert.NoError(t.T(), err)
+	for _, result := range resp.GetProvisioningStatusResult() {
+		assert.True(t.T(), result.GetSuccess(), result.GetMessage())
+	}
+
+	res = t.c.Members.GetMembersMemberID(members.GetMembersMemberIDParams{MemberID: deletedID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusNotFound, rr.Code, rr.Body)
+
+	res = t.c.Members.GetMembersMemberID(members.GetMembersMemberIDParams{MemberID: keptID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	memberResponse := members.GetMembersMemberIDOKBody{}
+	_ = memberResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Equal(t.T(), "ACTIVE", memberResponse.Member.ProvisioningStatus, rr.Body)
+}
+
+func (t *SuiteTest) TestDomainAliases() {
+	dc := t.c.Domains
+	domainID := t.createDomain()
+	defer t.cleanupDomains()
+
+	putDomain := func(domain *models.Domain) *httptest.ResponseRecorder {
+		res := dc.PutDomainsDomainID(domains.PutDomainsDomainIDParams{
+			DomainID: domainID,
+			Domain:   domains.PutDomainsDomainIDBody{Domain: domain},
+		})
+		rr := httptest.NewRecorder()
+		res.WriteResponse(rr, runtime.JSONProducer())
+		return rr
+	}
+
+	rr := putDomain(&models.Domain{Aliases: []string{"www.test.com", "alias.test.com", "www.test.com"}})
+	assert.Equal(t.T(), http.StatusAccepted, rr.Code, rr.Body)
+
+	res := dc.GetDomainsDomainID(domains.GetDomainsDomainIDParams{DomainID: domainID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	domainResponse := domains.GetDomainsDomainIDOKBody{}
+	_ = domainResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Equal(t.T(), []string{"alias.test.com", "www.test.com"}, domainResponse.Domain.Aliases, rr.Body)
+
+	// Wildcards are only supported by F5
+	rr = putDomain(&models.Domain{Aliases: []string{"*.test.com"}})
+	assert.Equal(t.T(), http.StatusBadRequest, rr.Code, rr.Body)
+
+	// Akamai has no MX properties
+	rr = putDomain(&models.Domain{RecordType: conv.Pointer(models.DomainRecordTypeMX)})
+	assert.Equal(t.T(), http.StatusBadRequest, rr.Code, rr.Body)
+}
+
+func (t *SuiteTest) TestDomainTiming() {
+	dc := t.c.Domains
+	domainID := t.createDomain()
+	defer t.cleanupDomains()
+
+	putDomain := func(domain *models.Domain) *httptest.ResponseRecorder {
+		res := dc.PutDomainsDomainID(domains.PutDomainsDomainIDParams{
+			DomainID: domainID,
+			Domain:   domains.PutDomainsDomainIDBody{Domain: domain},
+		})
+		rr := httptest.NewRecorder()
+		res.WriteResponse(rr, runtime.JSONProducer())
+		return rr
+	}
+
+	rr := putDomain(&models.Domain{TTL: conv.Pointer(int64(300)), FailoverDelay: conv.Pointer(int64(60))})
+	assert.Equal(t.T(), http.StatusAccepted, rr.Code, rr.Body)
+
+	res := dc.GetDomainsDomainID(domains.GetDomainsDomainIDParams{DomainID: domainID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	domainResponse := domains.GetDomainsDomainIDOKBody{}
+	_ = domainResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Equal(t.T(), int64(300), conv.Value(domainResponse.Domain.TTL), rr.Body)
+	assert.Equal(t.T(), int64(60), conv.Value(domainResponse.Domain.FailoverDelay), rr.Body)
+	assert.Equal(t.T(), int64(0), conv.Value(domainResponse.Domain.FailbackDelay), rr.Body)
+
+	// Akamai properties have a TTL of at least 30 seconds and hand out up to 8 addresses
+	rr = putDomain(&models.Domain{TTL: conv.Pointer(int64(10))})
+	assert.Equal(t.T(), http.StatusBadRequest, rr.Code, rr.Body)
+	rr = putDomain(&models.Domain{HandoutLimit: conv.Pointer(int64(9))})
+	assert.Equal(t.T(), http.StatusBadRequest, rr.Code, rr.Body)
+
+	// F5 wide IPs have no failover timing
+	fqdn := strfmt.Hostname("timing.test.com")
+	res = dc.PostDomains(domains.PostDomainsParams{Domain: domains.PostDomainsBody{Domain: &models.Domain{
+		Fqdn:          &fqdn,
+		Provider:      conv.Pointer(models.DomainProviderF5),
+		FailbackDelay: conv.Pointer(int64(60)),
+	}}})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusBadRequest, rr.Code, rr.Body)
+}
+
+func (t *SuiteTest) TestDomainPoolPriority() {
+	dc := t.c.Domains
+	domainID := t.createDomain()
+	defer t.cleanupDomains()
+	defer t.cleanupPools()
+
+	// Pools associated by the pool API are appended with the lowest priority
+	primaryID := t.createPool([]strfmt.UUID{domainID})
+	fallbackID := t.createPool([]strfmt.UUID{domainID})
+
+	getPools := func() []strfmt.UUID {
+		res := dc.GetDomainsDomainID(domains.GetDomainsDomainIDParams{DomainID: domainID})
+		rr := httptest.NewRecorder()
+		res.WriteResponse(rr, runtime.JSONProducer())
+		domainResponse := domains.GetDomainsDomainIDOKBody{}
+		_ = domainResponse.UnmarshalBinary(rr.Body.Bytes())
+		return domainResponse.Domain.Pools
+	}
+	assert.Equal(t.T(), []strfmt.UUID{primaryID, fallbackID}, getPools())
+
+	// Reordering the pools changes their priority
+	res := dc.PutDomainsDomainID(domains.PutDomainsDomainIDParams{
+		DomainID: domainID,
+		Domain: domains.PutDomainsDomainIDBody{Domain: &models.Domain{
+			Pools: []strfmt.UUID{fallbackID, primaryID},
+		}},
+	})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusAccepted, rr.Code, rr.Body)
+	assert.Equal(t.T(), []strfmt.UUID{fallbackID, primaryID}, getPools())
+
+	// The agents are handed the pools with the rewritten priorities and sync the domain again, the F5
+	// declaration and the Akamai property order the pools by these priorities
+	rpc := server.RPCHandler{DB: t.db}
+	domainsResponse, err := rpc.GetDomains(context.Background(), &server.SearchRequest{
+		Ids: []string{domainID.String()}, FullyPopulated: true})
+	assert.NoError(t.T(), err)
+	if assert.Len(t.T(), domainsResponse.GetResponse(), 1) {
+		domain := domainsResponse.GetResponse()[0]
+		assert.Equal(t.T(), "PENDING_UPDATE", domain.GetProvisioningStatus())
+		if assert.Len(t.T(), domain.GetPools(), 2) {
+			assert.Equal(t.T(), fallbackID.String(), domain.GetPools()[0].GetId())
+			assert.Equal(t.T(), uint32(0), domain.GetPools()[0].GetPriority())
+			assert.Equal(t.T(), primaryID.String(), domain.GetPools()[1].GetId())
+			assert.Equal(t.T(), uint32(1), domain.GetPools()[1].GetPriority())
+		}
+	}
+}
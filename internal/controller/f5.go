@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"encoding/json"
+
+	"github.com/actatum/stormrpc"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/sapcc/andromeda/internal/auth"
+	"github.com/sapcc/andromeda/internal/utils"
+	"github.com/sapcc/andromeda/models"
+	"github.com/sapcc/andromeda/restapi/operations/administrative"
+)
+
+type F5Controller struct {
+	CommonController
+}
+
+// GetF5Diff GET /f5/diff
+func (c F5Controller) GetF5Diff(params administrative.GetF5DiffParams) middleware.Responder {
+	if _, err := auth.Authenticate(params.HTTPRequest, nil); err != nil {
+		return administrative.NewGetF5DiffDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	r, err := stormrpc.NewRequest("andromeda.f5.diff", nil)
+	if err != nil {
+		panic(err)
+	}
+
+	resp := c.rpc.Do(params.HTTPRequest.Context(), r)
+	if resp.Err != nil {
+		panic(resp.Err)
+	}
+
+	var diff models.DeclarationDiff
+	if err = json.Unmarshal(resp.Data, &diff); err != nil {
+		panic(err)
+	}
+	return administrative.NewGetF5DiffOK().WithPayload(&diff)
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/sapcc/andromeda/db"
 	"github.com/sapcc/andromeda/internal/auth"
+	"github.com/sapcc/andromeda/internal/driver"
 	"github.com/sapcc/andromeda/internal/utils"
 	"github.com/sapcc/andromeda/models"
 	"github.com/sapcc/andromeda/restapi/operations/geographic_maps"
@@ -148,7 +149,8 @@ func (c GeoMapController) PostGeomaps(params geographic_maps.PostGeomapsParams)
 		panic(err)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{
+		Model: "GEOMAP", ID: geomap.ID.String(), Provider: geomap.Provider, Status: "PENDING_CREATE"})
 	return geographic_maps.NewPostGeomapsCreated().WithPayload(&geographic_maps.PostGeomapsCreatedBody{Geomap: geomap})
 }
 
@@ -185,11 +187,52 @@ func (c GeoMapController) PutGeomapsGeoMapID(params geographic_maps.PutGeomapsGe
 	return middleware.NotImplemented("operation geographic_maps.PutGeomapsGeomapID has not yet been implemented")
 }
 
+// PostGeomapsGeoMapIDRetry POST /geoMaps/:id/retry
+func (c GeoMapController) PostGeomapsGeoMapIDRetry(params geographic_maps.PostGeomapsGeomapIDRetryParams) middleware.Responder {
+	var geomap models.Geomap
+	sql, args := sq.Select("project_id", "scope").
+		From("geographic_map").
+		Where("id = ?", params.GeomapID).
+		MustSql()
+	if err := c.db.Get(&geomap, c.db.Rebind(sql), args...); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return geographic_maps.NewPostGeomapsGeomapIDRetryNotFound().WithPayload(utils.NotFound)
+		}
+		panic(err)
+	}
+	requestVars := map[string]string{"project_id": *geomap.ProjectID, "scope": *geomap.Scope}
+	if _, err := auth.Authenticate(params.HTTPRequest, requestVars); err != nil {
+		return geographic_maps.NewPostGeomapsGeomapIDRetryDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	if err := db.TxExecute(c.db, func(tx *sqlx.Tx) error {
+		return retryProvisioning(tx, "geographic_map", params.GeomapID)
+	}); err != nil {
+		if errors.Is(err, errNotInErrorState) {
+			return geographic_maps.NewPostGeomapsGeomapIDRetryConflict().WithPayload(utils.NotInErrorState)
+		}
+		panic(err)
+	}
+
+	sql, args = sq.Select("*").From("geographic_map").Where("id = ?", params.GeomapID).MustSql()
+	if err := c.db.Get(&geomap, c.db.Rebind(sql), args...); err != nil {
+		panic(err)
+	}
+	if err := PopulateGeoMapAssignments(c.db, &geomap); err != nil {
+		panic(err)
+	}
+
+	_ = PendingSync(c.nc, driver.ChangeEvent{
+		Model: "GEOMAP", ID: geomap.ID.String(), Provider: geomap.Provider, Status: "PENDING_UPDATE"})
+	return geographic_maps.NewPostGeomapsGeomapIDRetryAccepted().WithPayload(
+		&geographic_maps.PostGeomapsGeomapIDRetryAcceptedBody{Geomap: &geomap})
+}
+
 // DeleteGeomapsGeoMapID DELETE /geoMaps/:id
 func (c GeoMapController) DeleteGeomapsGeoMapID(params geographic_maps.DeleteGeomapsGeomapIDParams) middleware.Responder {
 	geomap := models.Geomap{ID: params.GeomapID}
 	if err := db.TxExecute(c.db, func(tx *sqlx.Tx) error {
-		sql, args, err := sq.Select("project_id", "scope").
+		sql, args, err := sq.Select("project_id", "scope", "provider").
 			From("geographic_map").
 			Where("id = ?", geomap.ID).
 			Suffix("FOR UPDATE").
@@ -227,7 +270,8 @@ func (c GeoMapController) DeleteGeomapsGeoMapID(params geographic_maps.DeleteGeo
 		panic(err)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{
+		Model: "GEOMAP", ID: geomap.ID.String(), Provider: geomap.Provider, Status: "PENDING_DELETE"})
 	return geographic_maps.NewDeleteGeomapsGeomapIDNoContent()
 }
 
@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag/conv"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sapcc/andromeda/db"
+	"github.com/sapcc/andromeda/internal/driver"
+	"github.com/sapcc/andromeda/internal/housekeeping"
+	"github.com/sapcc/andromeda/models"
+	"github.com/sapcc/andromeda/restapi/operations/domains"
+	"github.com/sapcc/andromeda/restapi/operations/members"
+)
+
+func (t *SuiteTest) TestHouseKeepingStuckResources() {
+	domainID := t.createDomain()
+	defer t.cleanupDomains()
+	e := housekeeping.Executor{DB: t.db}
+
+	// Recently updated resources are not stuck
+	labels := prometheus.Labels{}
+	assert.NoError(t.T(), e.CheckStuckResources(context.Background(), labels))
+	assert.Equal(t.T(), "0", labels["count"])
+
+	_, err := t.db.Exec(t.db.Rebind(`UPDATE domain SET updated_at = ? WHERE id = ?`),
+		time.Now().UTC().Add(-2*time.Hour), domainID)
+	assert.NoError(t.T(), err)
+	assert.NoError(t.T(), e.CheckStuckResources(context.Background(), labels))
+	assert.Equal(t.T(), "1", labels["count"])
+}
+
+func (t *SuiteTest) TestHouseKeepingPurgeDeletedPoolChildren() {
+	poolID := t.createPool(nil)
+	defer t.cleanupPools()
+	member := members.PostMembersBody{Member: &models.Member{
+		Address: conv.Pointer("1.2.3.4"), Port: conv.Pointer(int64(80)), PoolID: &poolID}}
+	res := t.c.Members.PostMembers(members.PostMembersParams{Member: member})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+	memberResponse := members.PostMembersCreatedBody{}
+	_ = memberResponse.UnmarshalBinary(rr.Body.Bytes())
+	e := housekeeping.Executor{DB: t.db}
+
+	// Members of active pools are kept
+	labels := prometheus.Labels{}
+	assert.NoError(t.T(), e.PurgeDeletedPoolChildren(context.Background(), labels))
+	assert.Equal(t.T(), "0", labels["count"])
+
+	_, err := t.db.Exec(t.db.Rebind(`UPDATE pool SET provisioning_status = 'PENDING_DELETE', updated_at = ? WHERE id = ?`),
+		time.Now().UTC().Add(-2*time.Hour), poolID)
+	assert.NoError(t.T(), err)
+	assert.NoError(t.T(), e.PurgeDeletedPoolChildren(context.Background(), labels))
+	assert.Equal(t.T(), "1", labels["count"])
+
+	res = t.c.Members.GetMembersMemberID(members.GetMembersMemberIDParams{MemberID: memberResponse.Member.ID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusNotFound, rr.Code, rr.Body)
+}
+
+func (t *SuiteTest) TestHouseKeepingDeletedDatacentersAndGeomaps() {
+	datacenter := mustCreateF5Datacenter(t)
+	defer func() {
+		_, _ = t.db.Exec("DELETE FROM geographic_map")
+		_, _ = t.db.Exec("DELETE FROM datacenter")
+	}()
+	deletedAt := time.Now().UTC().Add(-2 * time.Hour)
+	_, err := t.db.Exec(t.db.Rebind(`UPDATE datacenter SET provisioning_status = 'DELETED', updated_at = ? WHERE id = ?`),
+		deletedAt, datacenter.ID)
+	assert.NoError(t.T(), err)
+	_, err = t.db.Exec(t.db.Rebind(`
+		INSERT INTO geographic_map (name, provisioning_status, default_datacenter, project_id, provider)
+		VALUES ('test', 'ACTIVE', ?, 'test-project', 'f5')`), datacenter.ID)
+	assert.NoError(t.T(), err)
+	e := housekeeping.Executor{DB: t.db}
+
+	// Datacenters referenced by geographic maps are kept
+	labels := prometheus.Labels{}
+	assert.NoError(t.T(), e.CleanupDeletedDatacentersAndGeomaps(context.Background(), labels))
+	assert.Equal(t.T(), "0", labels["count"])
+
+	_, err = t.db.Exec(t.db.Rebind(`UPDATE geographic_map SET provisioning_status = 'DELETED', updated_at = ?`), deletedAt)
+	assert.NoError(t.T(), err)
+	assert.NoError(t.T(), e.CleanupDeletedDatacentersAndGeomaps(context.Background(), labels))
+	assert.Equal(t.T(), "2", labels["count"])
+
+	var count int
+	assert.NoError(t.T(), t.db.Get(&count, `SELECT COUNT(*) FROM datacenter`))
+	assert.Zero(t.T(), count)
+}
+
+func (t *SuiteTest) TestHouseKeepingOrphanedQuotas() {
+	cleanupQuotas := func() {
+		_, _ = t.db.Exec("DELETE FROM quota")
+	}
+	cleanupQuotas()
+	defer cleanupQuotas()
+	for _, projectID := range []string{"existing-project", "deleted-project"} {
+		_, err := t.db.Exec(t.db.Rebind(`
+			INSERT INTO quota (project_id, domain_akamai, domain_f5, pool, member, monitor, datacenter)
+			VALUES (?, 1, 1, 1, 1, 1, 1)`), projectID)
+		assert.NoError(t.T(), err)
+	}
+
+	// Keystone only knows the existing project
+	keystone := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projects/existing-project" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"project": {"id": "existing-project"}}`))
+	}))
+	defer keystone.Close()
+	e := housekeeping.Executor{DB: t.db, Identity: &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       keystone.URL + "/",
+	}}
+
+	labels := prometheus.Labels{}
+	assert.NoError(t.T(), e.CleanupOrphanedQuotas(context.Background(), labels))
+	assert.Equal(t.T(), "1", labels["count"])
+
+	var projectIDs []string
+	assert.NoError(t.T(), t.db.Select(&projectIDs, `SELECT project_id FROM quota`))
+	assert.Equal(t.T(), []string{"existing-project"}, projectIDs)
+}
+
+func (t *SuiteTest) TestHouseKeepingDeleteProjectResources() {
+	postDomain := func(fqdn string) strfmt.UUID {
+		hostname := strfmt.Hostname(fqdn)
+		res := t.c.Domains.PostDomains(domains.PostDomainsParams{Domain: domains.PostDomainsBody{
+			Domain: &models.Domain{Fqdn: &hostname, Provider: conv.Pointer("akamai")},
+		}})
+		rr := httptest.NewRecorder()
+		res.WriteResponse(rr, runtime.JSONProducer())
+		assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+		domainResponse := domains.PostDomainsCreatedBody{}
+		_ = domainResponse.UnmarshalBinary(rr.Body.Bytes())
+		return domainResponse.Domain.ID
+	}
+	getStatus := func(table string, id strfmt.UUID) string {
+		var status string
+		err := t.db.Get(&status, t.db.Rebind(fmt.Sprintf(`SELECT provisioning_status FROM %s WHERE id = ?`, table)), id)
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return ""
+		}
+		assert.NoError(t.T(), err)
+		return status
+	}
+	defer t.cleanupDomains()
+	defer t.cleanupPools()
+	defer func() {
+		_, _ = t.db.Exec("DELETE FROM datacenter")
+		_, _ = t.db.Exec("DELETE FROM quota")
+	}()
+
+	// The pool is shared by both domains of the deleted project, the other pool is not related to any domain
+	domainA, domainB := postDomain("a.test.com"), postDomain("b.test.com")
+	sharedPoolID := t.createPool([]strfmt.UUID{domainA, domainB})
+	unrelatedPoolID := t.createPool(nil)
+	res := t.c.Members.PostMembers(members.PostMembersParams{Member: members.PostMembersBody{Member: &models.Member{
+		Address: conv.Pointer("1.2.3.4"), Port: conv.Pointer(int64(80)), PoolID: &sharedPoolID}}})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+	memberResponse := members.PostMembersCreatedBody{}
+	_ = memberResponse.UnmarshalBinary(rr.Body.Bytes())
+	datacenter := mustCreateF5Datacenter(t)
+	for _, table := range []string{"domain", "pool", "member", "datacenter"} {
+		_, err := t.db.Exec(fmt.Sprintf(`UPDATE %s SET project_id = 'deleted-project'`, table))
+		assert.NoError(t.T(), err)
+	}
+	otherDomain := postDomain("other.test.com")
+	_, err := t.db.Exec(t.db.Rebind(`UPDATE domain SET project_id = 'other-project' WHERE id = ?`), otherDomain)
+	assert.NoError(t.T(), err)
+	for _, projectID := range []string{"deleted-project", "other-project"} {
+		_, err = t.db.Exec(t.db.Rebind(`
+			INSERT INTO quota (project_id, domain_akamai, domain_f5, pool, member, monitor, datacenter)
+			VALUES (?, 1, 1, 1, 1, 1, 1)`), projectID)
+		assert.NoError(t.T(), err)
+	}
+
+	var events []driver.ChangeEvent
+	assert.NoError(t.T(), db.TxExecute(t.db, func(tx *sqlx.Tx) (err error) {
+		events, err = housekeeping.DeleteProjectResources(tx, "deleted-project")
+		return err
+	}))
+	assert.ElementsMatch(t.T(), []driver.ChangeEvent{
+		{Model: "DOMAIN", ID: domainA.String(), Provider: "akamai", Status: "PENDING_DELETE"},
+		{Model: "DOMAIN", ID: domainB.String(), Provider: "akamai", Status: "PENDING_DELETE"},
+		{Model: "POOL", ID: sharedPoolID.String(), Status: "PENDING_DELETE"},
+		{Model: "MEMBER", ID: memberResponse.Member.ID.String(), Status: "PENDING_DELETE"},
+	}, events)
+
+	// The shared pool is deleted by the agents along with its domains, the unrelated pool right away
+	assert.Equal(t.T(), "PENDING_DELETE", getStatus("pool", sharedPoolID))
+	assert.Empty(t.T(), getStatus("pool", unrelatedPoolID))
+
+	// Resources of other projects and the public datacenter shared with other projects are kept
+	assert.Equal(t.T(), "PENDING_CREATE", getStatus("domain", otherDomain))
+	assert.NotEqual(t.T(), "PENDING_DELETE", getStatus("datacenter", datacenter.ID))
+
+	var projectIDs []string
+	assert.NoError(t.T(), t.db.Select(&projectIDs, `SELECT project_id FROM quota`))
+	assert.Equal(t.T(), []string{"other-project"}, projectIDs)
+}
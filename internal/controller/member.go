@@ -7,9 +7,11 @@ package controller
 import (
 	"errors"
 	"fmt"
-	"net"
+	"net/netip"
 	"strings"
+	"time"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/apex/log"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -21,6 +23,8 @@ import (
 
 	"github.com/sapcc/andromeda/db"
 	"github.com/sapcc/andromeda/internal/auth"
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
 	"github.com/sapcc/andromeda/internal/utils"
 	"github.com/sapcc/andromeda/models"
 	"github.com/sapcc/andromeda/restapi/operations/members"
@@ -92,12 +96,8 @@ func (c MemberController) PostMembers(params members.PostMembersParams) middlewa
 	member.PoolID = &pool.ID
 	member.ProjectID = &projectID
 
-	f5, err := poolHasF5Domain(c.db, pool.ID)
-	if err != nil {
-		panic(err)
-	}
-	if f5 && net.ParseIP(*member.Address) == nil {
-		return members.NewPostMembersBadRequest().WithPayload(utils.InvalidMemberAddressForF5)
+	if e := validateMemberAddress(c.db, pool.ID, *member.Address); e != nil {
+		return members.NewPostMembersBadRequest().WithPayload(e)
 	}
 
 	// Set default values
@@ -109,9 +109,9 @@ func (c MemberController) PostMembers(params members.PostMembersParams) middlewa
 		// Run insert transaction
 		sql := `
 			INSERT INTO member
-				(name, admin_state_up, project_id, address, port, pool_id, datacenter_id)
+				(name, admin_state_up, project_id, address, port, weight, pool_id, datacenter_id)
 			VALUES
-				(:name, :admin_state_up, :project_id, :address, :port, :pool_id, :datacenter_id)
+				(:name, :admin_state_up, :project_id, :address, :port, :weight, :pool_id, :datacenter_id)
 			RETURNING *
 		`
 		stmt, err := tx.PrepareNamed(sql)
@@ -135,7 +135,7 @@ func (c MemberController) PostMembers(params members.PostMembersParams) middlewa
 		panic(err)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{Model: "MEMBER", ID: member.ID.String(), Status: "PENDING_CREATE"})
 	return members.NewPostMembersCreated().
 		WithPayload(&members.PostMembersCreatedBody{Member: member})
 }
@@ -171,12 +171,8 @@ func (c MemberController) PutMembersMemberID(params members.PutMembersMemberIDPa
 	}
 
 	if params.Member.Member.Address != nil {
-		f5, err := poolHasF5Domain(c.db, *member.PoolID)
-		if err != nil {
-			panic(err)
-		}
-		if f5 && net.ParseIP(*params.Member.Member.Address) == nil {
-			return members.NewPutMembersMemberIDBadRequest().WithPayload(utils.InvalidMemberAddressForF5)
+		if e := validateMemberAddress(c.db, *member.PoolID, *params.Member.Member.Address); e != nil {
+			return members.NewPutMembersMemberIDBadRequest().WithPayload(e)
 		}
 	}
 
@@ -188,6 +184,7 @@ func (c MemberController) PutMembersMemberID(params members.PutMembersMemberIDPa
 				admin_state_up = COALESCE(:admin_state_up, admin_state_up),
 				address = COALESCE(:address, address),
 				port = COALESCE(:port, port),
+				weight = COALESCE(:weight, weight),
 				updated_at = NOW(),
 				datacenter_id = COALESCE(:datacenter_id, datacenter_id),
 				provisioning_status = 'PENDING_UPDATE'
@@ -206,13 +203,46 @@ func (c MemberController) PutMembersMemberID(params members.PutMembersMemberIDPa
 		panic(err)
 	}
 
-	if err := PendingSync(c.rpc); err != nil {
+	if err := PendingSync(c.nc, driver.ChangeEvent{
+		Model: "MEMBER", ID: member.ID.String(), Status: "PENDING_UPDATE"}); err != nil {
 		log.WithError(err).Error("Failed to sync provisioning status")
 	}
 	return members.NewPutMembersMemberIDAccepted().
 		WithPayload(&members.PutMembersMemberIDAcceptedBody{Member: &member})
 }
 
+// PostMembersMemberIDRetry POST /members/:id/retry
+func (c MemberController) PostMembersMemberIDRetry(params members.PostMembersMemberIDRetryParams) middleware.Responder {
+	member := models.Member{ID: params.MemberID}
+	if err := PopulateMember(c.db, &member, []string{"project_id", "pool_id"}); err != nil {
+		return members.NewPostMembersMemberIDRetryNotFound().WithPayload(utils.NotFound)
+	}
+	requestVars := map[string]string{"project_id": *member.ProjectID}
+	if _, err := auth.Authenticate(params.HTTPRequest, requestVars); err != nil {
+		return members.NewPostMembersMemberIDRetryDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	if err := db.TxExecute(c.db, func(tx *sqlx.Tx) error {
+		if err := retryProvisioning(tx, "member", params.MemberID); err != nil {
+			return err
+		}
+		return UpdateCascadePool(tx, *member.PoolID, "PENDING_UPDATE")
+	}); err != nil {
+		if errors.Is(err, errNotInErrorState) {
+			return members.NewPostMembersMemberIDRetryConflict().WithPayload(utils.NotInErrorState)
+		}
+		panic(err)
+	}
+
+	if err := PopulateMember(c.db, &member, []string{"*"}); err != nil {
+		panic(err)
+	}
+
+	_ = PendingSync(c.nc, driver.ChangeEvent{Model: "MEMBER", ID: member.ID.String(), Status: "PENDING_UPDATE"})
+	return members.NewPostMembersMemberIDRetryAccepted().
+		WithPayload(&members.PostMembersMemberIDRetryAcceptedBody{Member: &member})
+}
+
 // DeleteMembersMemberID DELETE /pools/:id/members/:id
 func (c MemberController) DeleteMembersMemberID(params members.DeleteMembersMemberIDParams) middleware.Responder {
 	member := models.Member{ID: params.MemberID}
@@ -238,10 +268,47 @@ func (c MemberController) DeleteMembersMemberID(params members.DeleteMembersMemb
 		panic(err)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{Model: "MEMBER", ID: member.ID.String(), Status: "PENDING_DELETE"})
 	return members.NewDeleteMembersMemberIDNoContent()
 }
 
+// GetMembersMemberIDStatusHistory GET /members/:id/status-history
+func (c MemberController) GetMembersMemberIDStatusHistory(params members.GetMembersMemberIDStatusHistoryParams) middleware.Responder {
+	member := models.Member{ID: params.MemberID}
+	if err := PopulateMember(c.db, &member, []string{"project_id"}); err != nil {
+		return members.NewGetMembersMemberIDStatusHistoryNotFound().WithPayload(utils.NotFound)
+	}
+	requestVars := map[string]string{"project_id": *member.ProjectID}
+	if _, err := auth.Authenticate(params.HTTPRequest, requestVars); err != nil {
+		return members.NewGetMembersMemberIDStatusHistoryDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	limit := config.Global.ApiSettings.PaginationMaxLimit
+	if params.Limit != nil && *params.Limit > 0 && *params.Limit < limit {
+		limit = *params.Limit
+	}
+	query := sq.Select("status", "previous_status", "created_at").
+		From("member_status_history").
+		Where("member_id = ?", params.MemberID).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(uint64(limit))
+	if params.Since != nil {
+		query = query.Where(sq.GtOrEq{"created_at": time.Time(*params.Since).UTC()})
+	}
+	if params.Until != nil {
+		query = query.Where(sq.Lt{"created_at": time.Time(*params.Until).UTC()})
+	}
+	sql, args := query.MustSql()
+
+	//goland:noinspection GoPreferNilSlice
+	var history = []*models.MemberStatusTransition{}
+	if err := c.db.Select(&history, c.db.Rebind(sql), args...); err != nil {
+		panic(err)
+	}
+	return members.NewGetMembersMemberIDStatusHistoryOK().
+		WithPayload(&members.GetMembersMemberIDStatusHistoryOKBody{StatusHistory: history})
+}
+
 func PopulateMember(db *sqlx.DB, member *models.Member, fields []string) error {
 	sql := db.Rebind(
 		fmt.Sprintf(`SELECT %s FROM member WHERE id = ?`,
@@ -252,15 +319,53 @@ func PopulateMember(db *sqlx.DB, member *models.Member, fields []string) error {
 	return nil
 }
 
-func poolHasF5Domain(db *sqlx.DB, poolID strfmt.UUID) (bool, error) {
-	var count int
+// validateMemberAddress checks the address of a member against the domains of its pool: CNAME and MX
+// domains hand out hostnames, address domains IP addresses. F5 requires IP addresses for address domains,
+// which it hands out in both address families. Akamai properties only hand out the address family of the
+// domain's record type, so at least one address domain of the pool must serve the address family.
+func validateMemberAddress(db *sqlx.DB, poolID strfmt.UUID, address string) *models.Error {
+	var poolDomains []struct {
+		Provider   string `db:"provider"`
+		RecordType string `db:"record_type"`
+	}
 	sql := db.Rebind(`
-		SELECT COUNT(d.id) FROM domain d
+		SELECT d.provider, d.record_type FROM domain d
 		JOIN domain_pool_relation dpr ON d.id = dpr.domain_id
-		WHERE dpr.pool_id = ? AND d.provider = 'f5'
+		WHERE dpr.pool_id = ?
 	`)
-	if err := db.Get(&count, sql, poolID); err != nil {
-		return false, err
+	if err := db.Select(&poolDomains, sql, poolID); err != nil {
+		panic(err)
 	}
-	return count > 0, nil
+
+	addr, err := netip.ParseAddr(address)
+	isIP := err == nil
+	if isIP && (addr.Is4In6() || addr.Zone() != "") {
+		return utils.InvalidMemberAddressFamily
+	}
+
+	recordType := utils.AddressRecordType(address)
+	addressDomains, served := false, false
+	for _, domain := range poolDomains {
+		switch domain.RecordType {
+		case models.DomainRecordTypeCNAME, models.DomainRecordTypeMX:
+			if isIP {
+				return utils.InvalidMemberAddressForName
+			}
+		default:
+			if !isIP {
+				if domain.Provider == models.DomainProviderF5 {
+					return utils.InvalidMemberAddressForF5
+				}
+				continue
+			}
+			addressDomains = true
+			if domain.Provider == models.DomainProviderF5 || domain.RecordType == recordType {
+				served = true
+			}
+		}
+	}
+	if addressDomains && !served {
+		return utils.MemberAddressFamilyMismatch
+	}
+	return nil
 }
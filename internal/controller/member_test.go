@@ -5,14 +5,20 @@
 package controller
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag/conv"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/housekeeping"
+	"github.com/sapcc/andromeda/internal/rpc/server"
 	"github.com/sapcc/andromeda/models"
 	"github.com/sapcc/andromeda/restapi/operations/domains"
 	"github.com/sapcc/andromeda/restapi/operations/members"
@@ -220,3 +226,162 @@ func (t *SuiteTest) TestMembersF5AddressValidation() {
 		mc.DeleteMembersMemberID(members.DeleteMembersMemberIDParams{MemberID: memberID})
 	})
 }
+
+func (t *SuiteTest) TestMembersAddressFamilyValidation() {
+	mc := t.c.Members
+	defer t.cleanupDomains()
+	defer t.cleanupPools()
+
+	// Pool with an Akamai A domain attached
+	akamaiPoolID := t.createPool([]strfmt.UUID{t.createDomain()})
+	// Pool with F5 domain attached, F5 domains are dual-stack
+	f5PoolID := t.createPool([]strfmt.UUID{t.createF5Domain()})
+
+	postMember := func(poolID strfmt.UUID, address string) *httptest.ResponseRecorder {
+		body := members.PostMembersBody{Member: &models.Member{
+			Address: conv.Pointer(address),
+			Port:    conv.Pointer(int64(80)),
+			PoolID:  &poolID,
+		}}
+		res := mc.PostMembers(members.PostMembersParams{Member: body})
+		rr := httptest.NewRecorder()
+		res.WriteResponse(rr, runtime.JSONProducer())
+		return rr
+	}
+
+	rr := postMember(akamaiPoolID, "192.0.2.1")
+	assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+
+	// IPv6 addresses are not handed out by A domains
+	rr = postMember(akamaiPoolID, "2001:db8::1")
+	assert.Equal(t.T(), http.StatusBadRequest, rr.Code, rr.Body)
+
+	rr = postMember(f5PoolID, "2001:db8::1")
+	assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+
+	// IPv4-mapped IPv6 addresses are ambiguous
+	rr = postMember(f5PoolID, "::ffff:192.0.2.1")
+	assert.Equal(t.T(), http.StatusBadRequest, rr.Code, rr.Body)
+}
+
+func (t *SuiteTest) TestMemberStatusHistory() {
+	config.Global.StatusHistory.FlapThreshold = 3
+	config.Global.StatusHistory.FlapWindow = 600
+	defer func() { config.Global.StatusHistory.FlapThreshold = 0 }()
+	defer t.cleanupDomains()
+	defer t.cleanupPools()
+
+	poolID := t.createPool([]strfmt.UUID{t.createDomain()})
+	member := members.PostMembersBody{}
+	_ = member.UnmarshalBinary([]byte(`{ "member": { "address": "1.2.3.4", "port": 1234 } }`))
+	member.Member.PoolID = &poolID
+	res := t.c.Members.PostMembers(members.PostMembersParams{Member: member})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+
+	// Report UNKNOWN -> ONLINE -> ONLINE -> OFFLINE -> ONLINE
+	rpc := server.RPCHandler{DB: t.db}
+	for _, status := range []server.MemberStatusRequest_MemberStatus_StatusType{
+		server.MemberStatusRequest_MemberStatus_ONLINE,
+		server.MemberStatusRequest_MemberStatus_ONLINE,
+		server.MemberStatusRequest_MemberStatus_OFFLINE,
+		server.MemberStatusRequest_MemberStatus_ONLINE,
+	} {
+		_, err := rpc.UpdateMemberStatus(context.Background(), &server.MemberStatusRequest{
+			MemberStatus: []*server.MemberStatusRequest_MemberStatus{
+				{Id: member.Member.ID.String(), Status: status},
+			},
+		})
+		assert.NoError(t.T(), err)
+	}
+
+	res = t.c.Members.GetMembersMemberIDStatusHistory(members.GetMembersMemberIDStatusHistoryParams{
+		MemberID: member.Member.ID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusOK, rr.Code, rr.Body)
+
+	historyResponse := members.GetMembersMemberIDStatusHistoryOKBody{}
+	_ = historyResponse.UnmarshalBinary(rr.Body.Bytes())
+	if assert.Len(t.T(), historyResponse.StatusHistory, 3, rr.Body) {
+		assert.Equal(t.T(), "ONLINE", historyResponse.StatusHistory[0].Status)
+		assert.Equal(t.T(), "OFFLINE", historyResponse.StatusHistory[0].PreviousStatus)
+		assert.Equal(t.T(), "UNKNOWN", historyResponse.StatusHistory[2].PreviousStatus)
+	}
+
+	// Three transitions within the flap window
+	res = t.c.Members.GetMembersMemberID(members.GetMembersMemberIDParams{MemberID: member.Member.ID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	memberResponse := members.GetMembersMemberIDOKBody{}
+	_ = memberResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.True(t.T(), conv.Value(memberResponse.Member.Flapping), rr.Body)
+
+	// Time range excluding all transitions
+	since := strfmt.DateTime(time.Now().Add(time.Hour))
+	res = t.c.Members.GetMembersMemberIDStatusHistory(members.GetMembersMemberIDStatusHistoryParams{
+		MemberID: member.Member.ID, Since: &since})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	historyResponse = members.GetMembersMemberIDStatusHistoryOKBody{}
+	_ = historyResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Empty(t.T(), historyResponse.StatusHistory, rr.Body)
+
+	// Members stop flapping once flap detection is disabled, despite the transitions within the flap window
+	e := housekeeping.Executor{DB: t.db}
+	assert.NoError(t.T(), e.CleanupMemberStatusHistory(context.Background(), prometheus.Labels{}))
+	res = t.c.Members.GetMembersMemberID(members.GetMembersMemberIDParams{MemberID: member.Member.ID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	memberResponse = members.GetMembersMemberIDOKBody{}
+	_ = memberResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.True(t.T(), conv.Value(memberResponse.Member.Flapping), rr.Body)
+
+	config.Global.StatusHistory.FlapThreshold = 0
+	assert.NoError(t.T(), e.CleanupMemberStatusHistory(context.Background(), prometheus.Labels{}))
+	res = t.c.Members.GetMembersMemberID(members.GetMembersMemberIDParams{MemberID: member.Member.ID})
+	rr = httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	memberResponse = members.GetMembersMemberIDOKBody{}
+	_ = memberResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.False(t.T(), conv.Value(memberResponse.Member.Flapping), rr.Body)
+}
+
+func (t *SuiteTest) TestMembersCNAMEValidation() {
+	defer t.cleanupDomains()
+	defer t.cleanupPools()
+
+	fqdn := strfmt.Hostname("cname.test.com")
+	res := t.c.Domains.PostDomains(domains.PostDomainsParams{Domain: domains.PostDomainsBody{
+		Domain: &models.Domain{
+			Fqdn:       &fqdn,
+			Provider:   conv.Pointer(models.DomainProviderAkamai),
+			RecordType: conv.Pointer(models.DomainRecordTypeCNAME),
+		},
+	}})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+	domainResponse := domains.PostDomainsCreatedBody{}
+	_ = domainResponse.UnmarshalBinary(rr.Body.Bytes())
+	poolID := t.createPool([]strfmt.UUID{domainResponse.Domain.ID})
+
+	postMember := func(address string) *httptest.ResponseRecorder {
+		body := members.PostMembersBody{Member: &models.Member{
+			Address: conv.Pointer(address),
+			Port:    conv.Pointer(int64(80)),
+			PoolID:  &poolID,
+		}}
+		res := t.c.Members.PostMembers(members.PostMembersParams{Member: body})
+		rr := httptest.NewRecorder()
+		res.WriteResponse(rr, runtime.JSONProducer())
+		return rr
+	}
+
+	// CNAME domains hand out hostnames
+	rr = postMember("192.0.2.1")
+	assert.Equal(t.T(), http.StatusBadRequest, rr.Code, rr.Body)
+	rr = postMember("target.example.com")
+	assert.Equal(t.T(), http.StatusCreated, rr.Code, rr.Body)
+}
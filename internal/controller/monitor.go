@@ -18,6 +18,7 @@ import (
 
 	"github.com/sapcc/andromeda/db"
 	"github.com/sapcc/andromeda/internal/auth"
+	"github.com/sapcc/andromeda/internal/driver"
 	"github.com/sapcc/andromeda/internal/utils"
 	"github.com/sapcc/andromeda/models"
 	"github.com/sapcc/andromeda/restapi/operations/monitors"
@@ -123,7 +124,7 @@ func (c MonitorController) PostMonitors(params monitors.PostMonitorsParams) midd
 		panic(err)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{Model: "MONITOR", ID: monitor.ID.String(), Status: "PENDING_CREATE"})
 	return monitors.NewPostMonitorsCreated().WithPayload(&monitors.PostMonitorsCreatedBody{Monitor: monitor})
 }
 
@@ -189,11 +190,42 @@ func (c MonitorController) PutMonitorsMonitorID(params monitors.PutMonitorsMonit
 	if err := PopulateMonitor(c.db, &monitor, []string{"*"}); err != nil {
 		panic(err)
 	}
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{Model: "MONITOR", ID: monitor.ID.String(), Status: "PENDING_UPDATE"})
 	return monitors.NewPutMonitorsMonitorIDAccepted().WithPayload(
 		&monitors.PutMonitorsMonitorIDAcceptedBody{Monitor: &monitor})
 }
 
+// PostMonitorsMonitorIDRetry POST /monitors/:id/retry
+func (c MonitorController) PostMonitorsMonitorIDRetry(params monitors.PostMonitorsMonitorIDRetryParams) middleware.Responder {
+	monitor := models.Monitor{ID: params.MonitorID}
+	if err := PopulateMonitor(c.db, &monitor, []string{"project_id", "pool_id"}); err != nil {
+		return monitors.NewPostMonitorsMonitorIDRetryNotFound().WithPayload(utils.NotFound)
+	}
+	requestVars := map[string]string{"project_id": *monitor.ProjectID}
+	if _, err := auth.Authenticate(params.HTTPRequest, requestVars); err != nil {
+		return monitors.NewPostMonitorsMonitorIDRetryDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	if err := db.TxExecute(c.db, func(tx *sqlx.Tx) error {
+		if err := retryProvisioning(tx, "monitor", params.MonitorID); err != nil {
+			return err
+		}
+		return UpdateCascadePool(tx, *monitor.PoolID, "PENDING_UPDATE")
+	}); err != nil {
+		if errors.Is(err, errNotInErrorState) {
+			return monitors.NewPostMonitorsMonitorIDRetryConflict().WithPayload(utils.NotInErrorState)
+		}
+		panic(err)
+	}
+
+	if err := PopulateMonitor(c.db, &monitor, []string{"*"}); err != nil {
+		panic(err)
+	}
+	_ = PendingSync(c.nc, driver.ChangeEvent{Model: "MONITOR", ID: monitor.ID.String(), Status: "PENDING_UPDATE"})
+	return monitors.NewPostMonitorsMonitorIDRetryAccepted().WithPayload(
+		&monitors.PostMonitorsMonitorIDRetryAcceptedBody{Monitor: &monitor})
+}
+
 // DeleteMonitorsMonitorID DELETE /monitors/:id
 func (c MonitorController) DeleteMonitorsMonitorID(params monitors.DeleteMonitorsMonitorIDParams) middleware.Responder {
 	monitor := models.Monitor{ID: params.MonitorID}
@@ -218,7 +250,7 @@ func (c MonitorController) DeleteMonitorsMonitorID(params monitors.DeleteMonitor
 		}
 		panic(err)
 	}
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{Model: "MONITOR", ID: monitor.ID.String(), Status: "PENDING_DELETE"})
 	return monitors.NewDeleteMonitorsMonitorIDNoContent()
 }
 
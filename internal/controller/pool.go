@@ -15,6 +15,8 @@ import (
 
 	"github.com/sapcc/andromeda/db"
 	"github.com/sapcc/andromeda/internal/auth"
+	"github.com/sapcc/andromeda/internal/driver"
+	"github.com/sapcc/andromeda/internal/rpc/server"
 	"github.com/sapcc/andromeda/internal/utils"
 	"github.com/sapcc/andromeda/models"
 	"github.com/sapcc/andromeda/restapi/operations/pools"
@@ -134,7 +136,7 @@ func (c PoolController) PostPools(params pools.PostPoolsParams) middleware.Respo
 		panic(err)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{Model: "POOL", ID: pool.ID.String(), Status: "PENDING_CREATE"})
 	return pools.NewPostPoolsCreated().WithPayload(&pools.PostPoolsCreatedBody{Pool: pool})
 }
 
@@ -220,10 +222,42 @@ func (c PoolController) PutPoolsPoolID(params pools.PutPoolsPoolIDParams) middle
 		panic(err)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{Model: "POOL", ID: pool.ID.String(), Status: "PENDING_UPDATE"})
 	return pools.NewPutPoolsPoolIDAccepted().WithPayload(&pools.PutPoolsPoolIDAcceptedBody{Pool: &pool})
 }
 
+// PostPoolsPoolIDRetry POST /pools/:id/retry
+func (c PoolController) PostPoolsPoolIDRetry(params pools.PostPoolsPoolIDRetryParams) middleware.Responder {
+	// zero-length slice used because we want [] via json encoder, nil encodes null
+	pool := models.Pool{ID: params.PoolID, Members: []strfmt.UUID{}, Domains: []strfmt.UUID{}}
+	if err := PopulatePool(c.db, &pool, []string{"id", "project_id"}, true); err != nil {
+		return pools.NewPostPoolsPoolIDRetryNotFound().WithPayload(utils.NotFound)
+	}
+	requestVars := map[string]string{"project_id": *pool.ProjectID}
+	if _, err := auth.Authenticate(params.HTTPRequest, requestVars); err != nil {
+		return pools.NewPostPoolsPoolIDRetryDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	if err := db.TxExecute(c.db, func(tx *sqlx.Tx) error {
+		if err := retryProvisioning(tx, "pool", params.PoolID); err != nil {
+			return err
+		}
+		return UpdateCascadePool(tx, params.PoolID, "PENDING_UPDATE")
+	}); err != nil {
+		if errors.Is(err, errNotInErrorState) {
+			return pools.NewPostPoolsPoolIDRetryConflict().WithPayload(utils.NotInErrorState)
+		}
+		panic(err)
+	}
+
+	if err := PopulatePool(c.db, &pool, []string{"*"}, true); err != nil {
+		panic(err)
+	}
+
+	_ = PendingSync(c.nc, driver.ChangeEvent{Model: "POOL", ID: pool.ID.String(), Status: "PENDING_UPDATE"})
+	return pools.NewPostPoolsPoolIDRetryAccepted().WithPayload(&pools.PostPoolsPoolIDRetryAcceptedBody{Pool: &pool})
+}
+
 // DeletePoolsPoolID DELETE /pools/:id
 func (c PoolController) DeletePoolsPoolID(params pools.DeletePoolsPoolIDParams) middleware.Responder {
 	// zero-length slice used because we want [] via json encoder, nil encodes null
@@ -255,7 +289,7 @@ func (c PoolController) DeletePoolsPoolID(params pools.DeletePoolsPoolIDParams)
 		panic(err)
 	}
 
-	_ = PendingSync(c.rpc)
+	_ = PendingSync(c.nc, driver.ChangeEvent{Model: "POOL", ID: pool.ID.String(), Status: "PENDING_DELETE"})
 	return pools.NewDeletePoolsPoolIDNoContent()
 }
 
@@ -371,5 +405,6 @@ func UpdateCascadePool(tx *sqlx.Tx, poolID strfmt.UUID, provisioningStatus strin
 		return err
 	}
 
-	return nil
+	// Members or the pool may have been enabled, disabled or removed
+	return server.UpdatePoolStatus(tx, poolID.String())
 }
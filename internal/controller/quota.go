@@ -8,9 +8,11 @@ import (
 	dbsql "database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/jmoiron/sqlx"
 
 	"github.com/sapcc/andromeda/internal/auth"
 	"github.com/sapcc/andromeda/internal/config"
@@ -23,6 +25,55 @@ type QuotaController struct {
 	CommonController
 }
 
+type quotaExceededError struct {
+	resource string
+}
+
+func (e *quotaExceededError) Error() string {
+	return "quota exceeded for " + e.resource
+}
+
+// checkProjectQuota fails if the project uses more resources of the quota column than its quota allows,
+// it is called after the resources have been created or restored within the transaction
+func checkProjectQuota(tx *sqlx.Tx, projectID string, column string) error {
+	defaults := map[string]int64{
+		"domain_akamai": config.Global.Quota.DefaultQuotaDomainAkamai,
+		"domain_f5":     config.Global.Quota.DefaultQuotaDomainF5,
+		"domain_dns":    config.Global.Quota.DefaultQuotaDomainDNS,
+		"pool":          config.Global.Quota.DefaultQuotaPool,
+		"member":        config.Global.Quota.DefaultQuotaMember,
+		"monitor":       config.Global.Quota.DefaultQuotaMonitor,
+		"datacenter":    config.Global.Quota.DefaultQuotaDatacenter,
+	}
+
+	var limit int64
+	sql := tx.Rebind(fmt.Sprintf(`SELECT %s FROM quota WHERE project_id = ?`, column))
+	if err := tx.Get(&limit, sql, projectID); err != nil {
+		if !errors.Is(err, dbsql.ErrNoRows) {
+			return err
+		}
+		limit = defaults[column]
+	}
+
+	resource, provider, _ := strings.Cut(column, "_")
+	query := sq.Select("COUNT(id)").
+		From(resource).
+		Where(sq.Eq{"project_id": projectID}).
+		Where(sq.NotEq{"provisioning_status": "DELETED"})
+	if provider != "" {
+		query = query.Where(sq.Eq{"provider": provider})
+	}
+	var used int64
+	sql, args := query.MustSql()
+	if err := tx.Get(&used, tx.Rebind(sql), args...); err != nil {
+		return err
+	}
+	if used > limit {
+		return &quotaExceededError{resource}
+	}
+	return nil
+}
+
 // GetQuotas GET /quotas
 func (c QuotaController) GetQuotas(params administrative.GetQuotasParams) middleware.Responder {
 	rows, err := c.db.Queryx(`SELECT * FROM quota`)
@@ -53,7 +104,7 @@ func (c QuotaController) GetQuotasProjectID(params administrative.GetQuotasProje
 
 	body := administrative.GetQuotasProjectIDOKBody{}
 
-	sql, args, err := sq.Select("domain_akamai, domain_f5, pool, member, monitor, datacenter").
+	sql, args, err := sq.Select("domain_akamai, domain_f5, domain_dns, pool, member, monitor, datacenter").
 		Column(sq.Alias(
 			sq.Select("COUNT(id)").From("domain").Where(sq.Eq{"project_id": params.ProjectID, "provider": "akamai"}).
 				Where(sq.NotEq{"provisioning_status": "DELETED"}),
@@ -62,6 +113,10 @@ func (c QuotaController) GetQuotasProjectID(params administrative.GetQuotasProje
 			sq.Select("COUNT(id)").From("domain").Where(sq.Eq{"project_id": params.ProjectID, "provider": "f5"}).
 				Where(sq.NotEq{"provisioning_status": "DELETED"}),
 			"in_use_domain_f5")).
+		Column(sq.Alias(
+			sq.Select("COUNT(id)").From("domain").Where(sq.Eq{"project_id": params.ProjectID, "provider": "dns"}).
+				Where(sq.NotEq{"provisioning_status": "DELETED"}),
+			"in_use_domain_dns")).
 		Column(sq.Alias(
 			sq.Select("COUNT(id)").From("pool").Where(sq.Eq{"project_id": params.ProjectID}),
 			"in_use_pool")).
@@ -102,6 +157,7 @@ func (c QuotaController) GetQuotasDefaults(params administrative.GetQuotasDefaul
 			Datacenter:   &config.Global.Quota.DefaultQuotaDatacenter,
 			DomainAkamai: &config.Global.Quota.DefaultQuotaDomainAkamai,
 			DomainF5:     &config.Global.Quota.DefaultQuotaDomainF5,
+			DomainDNS:    &config.Global.Quota.DefaultQuotaDomainDNS,
 			Member:       &config.Global.Quota.DefaultQuotaMember,
 			Monitor:      &config.Global.Quota.DefaultQuotaMonitor,
 			Pool:         &config.Global.Quota.DefaultQuotaPool,
@@ -129,6 +185,7 @@ func (c QuotaController) PutQuotasProjectID(params administrative.PutQuotasProje
 			INSERT INTO quota SET
 				domain_akamai = COALESCE(:domain_akamai, %d),
 				domain_f5 = COALESCE(:domain_f5, %d),
+				domain_dns = COALESCE(:domain_dns, %d),
 				pool = COALESCE(:pool, %d),
 				member = COALESCE(:member, %d),
 				monitor = COALESCE(:monitor, %d),
@@ -137,6 +194,7 @@ func (c QuotaController) PutQuotasProjectID(params administrative.PutQuotasProje
 			ON DUPLICATE KEY UPDATE
 				domain_akamai = COALESCE(:domain_akamai, domain_akamai),
 				domain_f5 = COALESCE(:domain_f5, domain_f5),
+				domain_dns = COALESCE(:domain_dns, domain_dns),
 				pool = COALESCE(:pool, pool),
 				member = COALESCE(:member, member), 
 				monitor = COALESCE(:monitor, monitor),
@@ -146,11 +204,12 @@ func (c QuotaController) PutQuotasProjectID(params administrative.PutQuotasProje
 	} else {
 		sql = `
 			INSERT INTO quota
-				(domain_akamai, domain_f5, pool, member, monitor, datacenter, project_id)
+				(domain_akamai, domain_f5, domain_dns, pool, member, monitor, datacenter, project_id)
 			VALUES 
 			    (
 					 COALESCE(:domain_akamai, %d),
 					 COALESCE(:domain_f5, %d),
+					 COALESCE(:domain_dns, %d),
 					 COALESCE(:pool, %d),
 					 COALESCE(:member, %d),
 					 COALESCE(:monitor, %d),
@@ -160,6 +219,7 @@ func (c QuotaController) PutQuotasProjectID(params administrative.PutQuotasProje
 			ON CONFLICT (project_id) DO UPDATE SET 
 				domain_akamai = COALESCE(:domain_akamai, quota.domain_akamai),
 				domain_f5 = COALESCE(:domain_f5, quota.domain_f5),
+				domain_dns = COALESCE(:domain_dns, quota.domain_dns),
 				pool = COALESCE(:pool, quota.pool),
 				member = COALESCE(:member, quota.member), 
 				monitor = COALESCE(:monitor, quota.monitor),
@@ -170,6 +230,7 @@ func (c QuotaController) PutQuotasProjectID(params administrative.PutQuotasProje
 	sql = fmt.Sprintf(sql,
 		config.Global.Quota.DefaultQuotaDomainAkamai,
 		config.Global.Quota.DefaultQuotaDomainF5,
+		config.Global.Quota.DefaultQuotaDomainDNS,
 		config.Global.Quota.DefaultQuotaPool,
 		config.Global.Quota.DefaultQuotaMember,
 		config.Global.Quota.DefaultQuotaMonitor,
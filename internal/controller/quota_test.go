@@ -76,7 +76,7 @@ func (t *SuiteTest) TestQuotasUpdateSelective() {
 	rr := httptest.NewRecorder()
 	res.WriteResponse(rr, runtime.JSONProducer())
 	assert.Equal(t.T(), http.StatusAccepted, rr.Code)
-	assert.JSONEq(t.T(), `{"quota":{"datacenter":0, "domain_akamai":1234, "domain_f5":2345, "member":0, "monitor":0, "pool":0}}`,
+	assert.JSONEq(t.T(), `{"quota":{"datacenter":0, "domain_akamai":1234, "domain_f5":2345, "domain_dns":0, "member":0, "monitor":0, "pool":0}}`,
 		rr.Body.String())
 
 	// Update selective
@@ -88,6 +88,6 @@ func (t *SuiteTest) TestQuotasUpdateSelective() {
 	rr = httptest.NewRecorder()
 	res.WriteResponse(rr, runtime.JSONProducer())
 	assert.Equal(t.T(), http.StatusAccepted, rr.Code)
-	assert.JSONEq(t.T(), `{"quota":{"datacenter":1, "domain_akamai":1234, "domain_f5":2345, "member":0, "monitor":0, "pool":0}}`,
+	assert.JSONEq(t.T(), `{"quota":{"datacenter":1, "domain_akamai":1234, "domain_f5":2345, "domain_dns":0, "member":0, "monitor":0, "pool":0}}`,
 		rr.Body.String())
 }
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	dbsql "database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/apex/log"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag/conv"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/sapcc/andromeda/db"
+	"github.com/sapcc/andromeda/internal/auth"
+	"github.com/sapcc/andromeda/internal/driver"
+	"github.com/sapcc/andromeda/internal/utils"
+	"github.com/sapcc/andromeda/models"
+	"github.com/sapcc/andromeda/restapi/operations/administrative"
+)
+
+// resetTables maps the resource types of the reset API to their tables
+var resetTables = map[string]string{
+	models.StuckResourceResourceTypeDomain:     "domain",
+	models.StuckResourceResourceTypePool:       "pool",
+	models.StuckResourceResourceTypeMember:     "member",
+	models.StuckResourceResourceTypeMonitor:    "monitor",
+	models.StuckResourceResourceTypeDatacenter: "datacenter",
+	models.StuckResourceResourceTypeGeomap:     "geographic_map",
+}
+
+// resetResourceTypes in the order stuck resources are listed
+var resetResourceTypes = []string{
+	models.StuckResourceResourceTypeDomain,
+	models.StuckResourceResourceTypePool,
+	models.StuckResourceResourceTypeMember,
+	models.StuckResourceResourceTypeMonitor,
+	models.StuckResourceResourceTypeDatacenter,
+	models.StuckResourceResourceTypeGeomap,
+}
+
+type ResetController struct {
+	CommonController
+}
+
+// GetReset GET /reset
+func (c ResetController) GetReset(params administrative.GetResetParams) middleware.Responder {
+	if _, err := auth.Authenticate(params.HTTPRequest, nil); err != nil {
+		return administrative.NewGetResetDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	resourceTypes := resetResourceTypes
+	if params.ResourceType != nil {
+		resourceTypes = []string{*params.ResourceType}
+	}
+	olderThan := time.Duration(conv.Value(params.OlderThan)) * time.Second
+	if params.OlderThan == nil {
+		olderThan = time.Hour
+	}
+	before := time.Now().UTC().Add(-olderThan)
+
+	//goland:noinspection GoPreferNilSlice
+	resources := []*models.StuckResource{}
+	for _, resourceType := range resourceTypes {
+		sql, args := sq.Select("id", "project_id", "provisioning_status", "provisioning_error", "updated_at").
+			From(resetTables[resourceType]).
+			Where(sq.Eq{"provisioning_status": []string{"PENDING_CREATE", "PENDING_UPDATE", "PENDING_DELETE", "ERROR"}}).
+			Where(sq.Lt{"updated_at": before}).
+			OrderBy("updated_at").
+			MustSql()
+		var stuck []*models.StuckResource
+		if err := c.db.Select(&stuck, c.db.Rebind(sql), args...); err != nil {
+			panic(err)
+		}
+		for _, resource := range stuck {
+			resource.ResourceType = resourceType
+		}
+		resources = append(resources, stuck...)
+	}
+	return administrative.NewGetResetOK().WithPayload(&administrative.GetResetOKBody{Resources: resources})
+}
+
+// PostResetResourceTypeResourceID POST /reset/:resource_type/:resource_id
+func (c ResetController) PostResetResourceTypeResourceID(params administrative.PostResetResourceTypeResourceIDParams) middleware.Responder {
+	if _, err := auth.Authenticate(params.HTTPRequest, nil); err != nil {
+		return administrative.NewPostResetResourceTypeResourceIDDefault(403).WithPayload(utils.PolicyForbidden)
+	}
+
+	table := resetTables[params.ResourceType]
+	action := conv.Value(params.Reset.Action)
+	var resource models.StuckResource
+	if err := db.TxExecute(c.db, func(tx *sqlx.Tx) error {
+		sql, args := sq.Select("id", "project_id", "provisioning_status").
+			From(table).
+			Where("id = ?", params.ResourceID).
+			Suffix("FOR UPDATE").
+			MustSql()
+		if err := tx.Get(&resource, tx.Rebind(sql), args...); err != nil {
+			return err
+		}
+
+		update := sq.Update(table).Set("updated_at", sq.Expr("NOW()")).Where("id = ?", params.ResourceID)
+		switch action {
+		case administrative.PostResetResourceTypeResourceIDBodyActionRequeue:
+			if resource.ProvisioningStatus == models.StuckResourceProvisioningStatusERROR ||
+				resource.ProvisioningStatus == models.StuckResourceProvisioningStatusACTIVE {
+				update = update.Set("provisioning_status", models.StuckResourceProvisioningStatusPENDINGUPDATE)
+			}
+		case administrative.PostResetResourceTypeResourceIDBodyActionActive:
+			update = update.Set("provisioning_status", models.StuckResourceProvisioningStatusACTIVE).
+				Set("provisioning_error", nil)
+		case administrative.PostResetResourceTypeResourceIDBodyActionError:
+			reason := params.Reset.Reason
+			if reason == "" {
+				reason = "reset by operator"
+			}
+			update = update.Set("provisioning_status", models.StuckResourceProvisioningStatusERROR).
+				Set("provisioning_error", reason).
+				Set("last_error_at", time.Now().UTC())
+		}
+		sql, args = update.MustSql()
+		if _, err := tx.Exec(tx.Rebind(sql), args...); err != nil {
+			return err
+		}
+
+		// Requeued children need their domains to be synced again
+		if action == administrative.PostResetResourceTypeResourceIDBodyActionRequeue {
+			var poolID strfmt.UUID
+			switch table {
+			case "pool":
+				poolID = params.ResourceID
+			case "member", "monitor":
+				sql = tx.Rebind(`SELECT pool_id FROM ` + table + ` WHERE id = ?`)
+				if err := tx.Get(&poolID, sql, params.ResourceID); err != nil {
+					return err
+				}
+			}
+			if poolID != "" {
+				if err := UpdateCascadePool(tx, poolID, "PENDING_UPDATE"); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return administrative.NewPostResetResourceTypeResourceIDNotFound().WithPayload(utils.NotFound)
+		}
+		panic(err)
+	}
+
+	sql, args := sq.Select("id", "project_id", "provisioning_status", "provisioning_error", "updated_at").
+		From(table).
+		Where("id = ?", params.ResourceID).
+		MustSql()
+	if err := c.db.Get(&resource, c.db.Rebind(sql), args...); err != nil {
+		panic(err)
+	}
+	resource.ResourceType = params.ResourceType
+	log.WithField("action", action).Infof("Reset %s %s to %s", params.ResourceType, resource.ID, resource.ProvisioningStatus)
+
+	if strings.HasPrefix(resource.ProvisioningStatus, "PENDING_") {
+		_ = PendingSync(c.nc, driver.ChangeEvent{
+			Model: strings.ToUpper(params.ResourceType), ID: resource.ID.String(), Status: resource.ProvisioningStatus})
+	}
+	return administrative.NewPostResetResourceTypeResourceIDAccepted().WithPayload(
+		&administrative.PostResetResourceTypeResourceIDAcceptedBody{Resource: &resource})
+}
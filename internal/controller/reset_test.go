@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag/conv"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sapcc/andromeda/restapi/operations/administrative"
+)
+
+func (t *SuiteTest) resetResource(resourceType string, id strfmt.UUID, action, reason string) *httptest.ResponseRecorder {
+	res := t.c.Reset.PostResetResourceTypeResourceID(administrative.PostResetResourceTypeResourceIDParams{
+		ResourceType: resourceType,
+		ResourceID:   id,
+		Reset: administrative.PostResetResourceTypeResourceIDBody{
+			Action: conv.Pointer(action),
+			Reason: reason,
+		},
+	})
+	rr := httptest.NewRecorder()
+	res.WriteResponse(rr, runtime.JSONProducer())
+	return rr
+}
+
+func (t *SuiteTest) TestReset() {
+	domainID := t.createDomain()
+	defer t.cleanupDomains()
+
+	rr := t.resetResource("domain", domainID, "error", "stuck in provider")
+	assert.Equal(t.T(), http.StatusAccepted, rr.Code, rr.Body)
+	resetResponse := administrative.PostResetResourceTypeResourceIDAcceptedBody{}
+	_ = resetResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Equal(t.T(), "ERROR", resetResponse.Resource.ProvisioningStatus, rr.Body)
+	assert.Equal(t.T(), "stuck in provider", conv.Value(resetResponse.Resource.ProvisioningError), rr.Body)
+
+	rr = t.resetResource("domain", domainID, "requeue", "")
+	assert.Equal(t.T(), http.StatusAccepted, rr.Code, rr.Body)
+	_ = resetResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Equal(t.T(), "PENDING_UPDATE", resetResponse.Resource.ProvisioningStatus, rr.Body)
+
+	rr = t.resetResource("domain", domainID, "active", "")
+	assert.Equal(t.T(), http.StatusAccepted, rr.Code, rr.Body)
+	_ = resetResponse.UnmarshalBinary(rr.Body.Bytes())
+	assert.Equal(t.T(), "ACTIVE", resetResponse.Resource.ProvisioningStatus, rr.Body)
+	assert.Nil(t.T(), resetResponse.Resource.ProvisioningError, rr.Body)
+
+	rr = t.resetResource("pool", domainID, "requeue", "")
+	assert.Equal(t.T(), http.StatusNotFound, rr.Code, rr.Body)
+}
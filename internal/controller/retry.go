@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-openapi/strfmt"
+	"github.com/jmoiron/sqlx"
+)
+
+var errNotInErrorState = errors.New("provisioning status is not ERROR")
+
+// retryProvisioning moves a resource with provisioning status ERROR back to PENDING_UPDATE, the provisioning
+// error is kept until the resource has been provisioned successfully.
+func retryProvisioning(tx *sqlx.Tx, table string, id strfmt.UUID) error {
+	sql, args := sq.Update(table).
+		Set("provisioning_status", "PENDING_UPDATE").
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": id, "provisioning_status": "ERROR"}).
+		MustSql()
+	res, err := tx.Exec(tx.Rebind(sql), args...)
+	if err != nil {
+		return err
+	}
+	if updated, _ := res.RowsAffected(); updated != 1 {
+		return errNotInErrorState
+	}
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/apex/log"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/jmoiron/sqlx"
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/micro"
 
@@ -30,6 +32,11 @@ func (c ServiceController) GetServices(params administrative.GetServicesParams)
 		return administrative.NewGetServicesDefault(403).WithPayload(utils.PolicyForbidden)
 	}
 
+	leaders, err := getLeaders(c.db)
+	if err != nil {
+		panic(err)
+	}
+
 	//goland:noinspection GoPreferNilSlice
 	var responseServices = []*models.Service{}
 	var response micro.Info
@@ -66,6 +73,7 @@ func (c ServiceController) GetServices(params administrative.GetServicesParams)
 			Type:     response.Name,
 			Provider: driver.GetServiceType(response.Name),
 			Metadata: response.Metadata,
+			Leader:   isLeader(response, leaders),
 			// Todo: add metadata support to stormRPC
 		})
 	}
@@ -73,3 +81,36 @@ func (c ServiceController) GetServices(params administrative.GetServicesParams)
 
 	return administrative.NewGetServicesOK().WithPayload(&administrative.GetServicesOKBody{Services: responseServices})
 }
+
+// getLeaders returns the holder of each unexpired leader election lease
+func getLeaders(db *sqlx.DB) (map[string]string, error) {
+	var leases []struct {
+		Name      string          `db:"name"`
+		Holder    string          `db:"holder"`
+		ExpiresAt strfmt.DateTime `db:"expires_at"`
+	}
+	if err := db.Select(&leases, `SELECT name, holder, expires_at FROM leader_lease`); err != nil {
+		return nil, err
+	}
+
+	leaders := make(map[string]string, len(leases))
+	now := time.Now().UTC()
+	for _, lease := range leases {
+		if time.Time(lease.ExpiresAt).After(now) {
+			leaders[lease.Name] = lease.Holder
+		}
+	}
+	return leaders, nil
+}
+
+// isLeader matches the leader subjects served by the service against the election leaders,
+// returns nil if the service takes no part in a leader election
+func isLeader(info micro.Info, leaders map[string]string) *bool {
+	for _, endpoint := range info.Endpoints {
+		if election, holder, ok := driver.ParseLeaderSubject(endpoint.Subject); ok {
+			leader := leaders[election] == holder
+			return &leader
+		}
+	}
+	return nil
+}
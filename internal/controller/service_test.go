@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sapcc/andromeda/internal/rpc/server"
+)
+
+func (t *SuiteTest) TestLeadership() {
+	rpc := server.RPCHandler{DB: t.db}
+	defer func() {
+		_, _ = t.db.Exec("DELETE FROM leader_lease")
+	}()
+	acquire := func(holder string, leaseDuration int32, release bool) *server.LeadershipResponse {
+		resp, err := rpc.AcquireLeadership(context.Background(), &server.LeadershipRequest{
+			Name:          "test",
+			Holder:        holder,
+			LeaseDuration: leaseDuration,
+			Release:       release,
+		})
+		assert.NoError(t.T(), err)
+		return resp
+	}
+
+	// The first holder creates the lease
+	resp := acquire("replica-a", 60, false)
+	assert.True(t.T(), resp.GetLeader())
+
+	// The lease is renewed by its holder and not taken over before it expires
+	assert.True(t.T(), acquire("replica-a", 60, false).GetLeader())
+	resp = acquire("replica-b", 60, false)
+	assert.False(t.T(), resp.GetLeader())
+	assert.Equal(t.T(), "replica-a", resp.GetHolder())
+
+	leaders, err := getLeaders(t.db)
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), map[string]string{"test": "replica-a"}, leaders)
+
+	// An expired lease is taken over
+	_, err = t.db.Exec(t.db.Rebind("UPDATE leader_lease SET expires_at = ? WHERE name = ?"),
+		"2000-01-01 00:00:00", "test")
+	assert.NoError(t.T(), err)
+	leaders, err = getLeaders(t.db)
+	assert.NoError(t.T(), err)
+	assert.Empty(t.T(), leaders)
+	assert.True(t.T(), acquire("replica-b", 60, false).GetLeader())
+
+	// Only the holder can release the lease
+	assert.False(t.T(), acquire("replica-a", 60, true).GetLeader())
+	acquire("replica-b", 60, true)
+	assert.True(t.T(), acquire("replica-a", 60, false).GetLeader())
+
+	// A replica racing with the creation of the lease follows its holder
+	_, err = t.db.Exec("DELETE FROM leader_lease")
+	assert.NoError(t.T(), err)
+	tx := t.db.MustBegin()
+	_, err = tx.Exec(tx.Rebind(`INSERT INTO leader_lease (name, holder, acquired_at, expires_at) VALUES (?, ?, ?, ?)`),
+		"test", "replica-a", time.Now().UTC(), time.Now().UTC().Add(time.Minute))
+	assert.NoError(t.T(), err)
+	raced := make(chan *server.LeadershipResponse)
+	go func() { raced <- acquire("replica-b", 60, false) }()
+	time.Sleep(100 * time.Millisecond)
+	assert.NoError(t.T(), tx.Commit())
+	resp = <-raced
+	assert.False(t.T(), resp.GetLeader())
+	assert.Equal(t.T(), "replica-a", resp.GetHolder())
+}
@@ -68,14 +68,17 @@ func (t *SuiteTest) SetupSuite() {
 	}
 	// initialize controller
 	t.c = &Controller{
-		Domains:     DomainController{cc},
-		Pools:       PoolController{cc},
-		Datacenters: DatacenterController{cc},
-		Members:     MemberController{cc},
-		Monitors:    MonitorController{cc},
-		Services:    ServiceController{cc},
-		Quotas:      QuotaController{cc},
-		Sync:        SyncController{cc},
+		Domains:       DomainController{cc},
+		Pools:         PoolController{cc},
+		Datacenters:   DatacenterController{cc},
+		Members:       MemberController{cc},
+		Monitors:      MonitorController{cc},
+		Services:      ServiceController{cc},
+		Quotas:        QuotaController{cc},
+		Sync:          SyncController{cc},
+		Agents:        AgentController{cc},
+		Reset:         ResetController{cc},
+		Configuration: ConfigurationController{cc},
 	}
 
 	if err := migration.Migrate(t.dbUrl); err != nil {
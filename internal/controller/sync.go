@@ -5,11 +5,19 @@
 package controller
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+
 	"github.com/actatum/stormrpc"
+	"github.com/apex/log"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/jmoiron/sqlx"
 
 	"github.com/sapcc/andromeda/internal/auth"
 	"github.com/sapcc/andromeda/internal/utils"
+	"github.com/sapcc/andromeda/models"
 	"github.com/sapcc/andromeda/restapi/operations/administrative"
 )
 
@@ -23,18 +31,76 @@ func (c SyncController) PostSync(params administrative.PostSyncParams) middlewar
 		return administrative.NewPostSyncDefault(403).WithPayload(utils.PolicyForbidden)
 	}
 
-	var domainIDs []string
-	for _, domainUUID := range params.Domains.Domains {
-		domainIDs = append(domainIDs, domainUUID.String())
+	var subjects map[string][]string
+	var err error
+	if len(params.Domains.Domains) > 0 {
+		if subjects, err = c.syncSubjects(params.Domains.Domains); err != nil {
+			if errors.Is(err, errUnknownSyncDomain) {
+				return administrative.NewPostSyncDefault(404).WithPayload(utils.NotFound)
+			}
+			panic(err)
+		}
+	} else if subjects, err = c.fullSyncSubjects(); err != nil {
+		panic(err)
 	}
 
-	r, err := stormrpc.NewRequest("andromeda.sync", domainIDs)
+	// syncs are sent to the agents of each provider, which reply once done. Agents share a queue group,
+	// a request to a common subject would reach only a single agent of any provider.
+	for subject, domainIDs := range subjects {
+		r, err := stormrpc.NewRequest(subject, domainIDs)
+		if err != nil {
+			panic(err)
+		}
+
+		if resp := c.rpc.Do(params.HTTPRequest.Context(), r); resp.Err != nil {
+			log.WithError(resp.Err).WithField("subject", subject).Error("Sync failed")
+			return administrative.NewPostSyncDefault(500).WithPayload(&models.Error{
+				Code:    500,
+				Message: fmt.Sprintf("sync failed: %s", resp.Err.Error()),
+			})
+		}
+	}
+	return administrative.NewPostSyncAccepted()
+}
+
+var errUnknownSyncDomain = errors.New("unknown domain")
+
+// syncSubjects groups the given domain IDs by the sync subject of their provider
+func (c SyncController) syncSubjects(domains []strfmt.UUID) (map[string][]string, error) {
+	var rows []struct {
+		ID       string `db:"id"`
+		Provider string `db:"provider"`
+	}
+	sql, args, err := sqlx.In(`SELECT id, provider FROM domain WHERE id IN (?)`, domains)
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+	if err = c.db.Select(&rows, c.db.Rebind(sql), args...); err != nil {
+		return nil, err
 	}
 
-	if resp := c.rpc.Do(params.HTTPRequest.Context(), r); resp.Err != nil {
-		panic(resp.Err)
+	subjects := make(map[string][]string)
+	for _, row := range rows {
+		subject := "andromeda.sync." + row.Provider
+		subjects[subject] = append(subjects[subject], row.ID)
 	}
-	return administrative.NewPostSyncAccepted()
+	if len(rows) < len(slices.Compact(slices.Sorted(slices.Values(domains)))) {
+		return nil, errUnknownSyncDomain
+	}
+	return subjects, nil
+}
+
+// fullSyncSubjects returns the sync subjects of all providers in use, an empty domain list requests a full sync
+func (c SyncController) fullSyncSubjects() (map[string][]string, error) {
+	var providers []string
+	sql := `SELECT provider FROM domain UNION SELECT provider FROM datacenter UNION SELECT provider FROM geographic_map`
+	if err := c.db.Select(&providers, sql); err != nil {
+		return nil, err
+	}
+
+	subjects := make(map[string][]string, len(providers))
+	for _, provider := range providers {
+		subjects["andromeda.sync."+provider] = []string{}
+	}
+	return subjects, nil
 }
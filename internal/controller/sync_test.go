@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func (t *SuiteTest) TestSyncSubjects() {
+	defer t.cleanupDomains()
+	akamaiDomain := t.createDomain()
+	f5Domain := t.createF5Domain()
+
+	// targeted syncs are grouped by the providers of the domains
+	subjects, err := t.c.Sync.syncSubjects([]strfmt.UUID{akamaiDomain, f5Domain, f5Domain})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), map[string][]string{
+		"andromeda.sync.akamai": {akamaiDomain.String()},
+		"andromeda.sync.f5":     {f5Domain.String()},
+	}, subjects)
+
+	// unknown domains are rejected instead of being skipped
+	_, err = t.c.Sync.syncSubjects([]strfmt.UUID{akamaiDomain, "00000000-0000-0000-0000-000000000000"})
+	assert.ErrorIs(t.T(), err, errUnknownSyncDomain)
+
+	// full syncs are sent to the agents of every provider in use
+	subjects, err = t.c.Sync.fullSyncSubjects()
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), []string{}, subjects["andromeda.sync.akamai"])
+	assert.Equal(t.T(), []string{}, subjects["andromeda.sync.f5"])
+}
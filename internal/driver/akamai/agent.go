@@ -21,6 +21,7 @@ import (
 	"github.com/nats-io/nats.go"
 
 	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
 	"github.com/sapcc/andromeda/internal/rpc"
 	"github.com/sapcc/andromeda/internal/rpc/server"
 	"github.com/sapcc/andromeda/internal/utils"
@@ -46,6 +47,11 @@ type AkamaiAgent struct {
 	forceSync         chan []string
 	executing         bool
 	datacenterIdCache *lru.Cache[string, int]
+	lastDriftCheck    time.Time
+	driftCheck        chan driftCheck
+	changes           <-chan struct{}
+	election          *driver.LeaderElection
+	heartbeat         *driver.Heartbeat
 }
 
 var akamaiAgent *AkamaiAgent
@@ -101,29 +107,58 @@ func ExecuteAkamaiAgent() error {
 	}
 
 	cache, _ := lru.New[string, int](64)
+	rpcClient := server.NewRPCServerClient(client)
+	leaseDuration := time.Duration(config.Global.Default.LeaderLeaseDuration) * time.Second
 
 	akamaiAgent = &AkamaiAgent{
 		s,
 		gtm.Client(*s),
 		sync.Mutex{},
 		domainType,
-		server.NewRPCServerClient(client),
+		rpcClient,
 		time.NewTicker(interval * time.Second),
 		time.Unix(0, 0),
 		time.Unix(0, 0),
 		make(chan []string),
 		false,
 		cache,
+		time.Now(),
+		make(chan driftCheck),
+		nil,
+		driver.NewLeaderElection(rpcClient, "akamai", leaseDuration),
+		driver.NewHeartbeat(rpcClient, driver.AgentAkamai, []string{"akamai"}, []string{
+			driver.CapabilitySync,
+			driver.CapabilityMemberStatus,
+			driver.CapabilityDriftCheck,
+			driver.CapabilityCIDRBlocks,
+		}),
+	}
+
+	if debounce := config.Global.Default.ChangeEventDebounce; debounce > 0 {
+		if akamaiAgent.changes, err = driver.SubscribeChanges(nc, "akamai", time.Duration(debounce)*time.Second); err != nil {
+			return err
+		}
 	}
 
 	if err := akamaiAgent.EnsureDomain(domainType); err != nil {
 		return err
 	}
 
+	// Only the leader writes to Akamai, requests received by other replicas are forwarded to it
 	srv := rpc.NewServer("andromeda-akamai-agent", stormrpc.WithNatsConn(nc))
-	srv.Handle("andromeda.sync", Sync)
-	srv.Handle("andromeda.get_cidrs.akamai", GetCidrs)
-
+	election := akamaiAgent.election
+	election.Handle(srv, nc, "andromeda.sync", Sync)
+	election.Handle(srv, nc, "andromeda.sync.akamai", Sync)
+	election.Handle(srv, nc, "andromeda.get_cidrs.akamai", GetCidrs)
+	election.Handle(srv, nc, "andromeda.drift_check.akamai", DriftCheck)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	electionDone := make(chan struct{})
+	go func() {
+		election.Run(ctx)
+		close(electionDone)
+	}()
+	go akamaiAgent.heartbeat.Run(ctx, time.Duration(config.Global.Default.HeartbeatInterval)*time.Second)
 	go func() {
 		_ = srv.Run()
 	}()
@@ -135,52 +170,51 @@ func ExecuteAkamaiAgent() error {
 
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, syscall.SIGINT, syscall.SIGTERM)
-	// full sync immediately
-
-	akamaiAgent.forceSync <- nil
 	<-done
 	log.Info("Shutting down")
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+	// releases the lease, so that another replica takes over without waiting for it to expire
+	cancel()
+	<-electionDone
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
 
-	return srv.Shutdown(ctx)
+	return srv.Shutdown(shutdownCtx)
 }
 
 func (s *AkamaiAgent) WorkerThread() {
 	syncInterval := time.Duration(config.Global.AkamaiConfig.SyncInterval) * time.Second
 	memberStatusInterval := time.Duration(config.Global.AkamaiConfig.MemberStatusInterval) * time.Second
+	driftCheckInterval := time.Duration(config.Global.AkamaiConfig.DriftCheckInterval) * time.Second
 
 	for {
 		select {
+		case <-s.election.Elected():
+			// full sync immediately after becoming leader
+			log.Info("Running full sync after becoming leader")
+			s.fullSync(nil)
 		case domains := <-s.forceSync:
 			log.Debug("Running force sync")
-			if err := s.FetchAndSyncDatacenters(nil, true); err != nil {
-				log.Error(err.Error())
-			}
-
-			if err := s.FetchAndSyncGeomaps(nil, true); err != nil {
-				log.Error(err.Error())
+			s.fullSync(domains)
+		case req := <-s.driftCheck:
+			if s.heartbeat.Disabled() {
+				req.report <- driftCheckResult{nil, driver.ErrAgentDisabled}
+				continue
 			}
-
-			if err := s.FetchAndSyncDomains(domains, true); err != nil {
-				log.Error(err.Error())
+			report, err := s.DriftCheck(req.revert)
+			req.report <- driftCheckResult{report, err}
+		case <-s.changes:
+			if !s.election.IsLeader() || s.heartbeat.Disabled() {
+				continue
 			}
+			log.Debug("Running sync of pending changes")
+			s.syncPending()
 		case <-s.workerTicker.C: // Activate periodically
+			if !s.election.IsLeader() || s.heartbeat.Disabled() {
+				continue
+			}
 			if time.Since(s.lastSync) > syncInterval {
 				log.Debug("Running periodic sync")
-				if err := s.FetchAndSyncDatacenters(nil, false); err != nil {
-					log.Error(err.Error())
-				}
-
-				if err := s.FetchAndSyncGeomaps(nil, false); err != nil {
-					log.Error(err.Error())
-				}
-
-				if err := s.FetchAndSyncDomains(nil, false); err != nil {
-					log.Error(err.Error())
-				}
-
-				s.lastSync = time.Now()
+				s.syncPending()
 			}
 			if time.Since(s.lastMemberStatus) > memberStatusInterval {
 				if err := s.memberStatusSync(); err != nil {
@@ -188,10 +222,56 @@ func (s *AkamaiAgent) WorkerThread() {
 				}
 				s.lastMemberStatus = time.Now()
 			}
+			if driftCheckInterval > 0 && time.Since(s.lastDriftCheck) > driftCheckInterval {
+				log.Debug("Running periodic drift check")
+				if _, err := s.DriftCheck(config.Global.AkamaiConfig.DriftAutoRevert); err != nil {
+					log.Error(err.Error())
+				}
+				s.lastDriftCheck = time.Now()
+			}
 		}
 	}
 }
 
+// fullSync syncs the given domains regardless of their provisioning status, all domains if nil
+func (s *AkamaiAgent) fullSync(domains []string) {
+	if s.heartbeat.Disabled() {
+		log.Warn("Skipping full sync, agent disabled")
+		return
+	}
+	if err := s.FetchAndSyncDatacenters(nil, true); err != nil {
+		log.Error(err.Error())
+	}
+
+	if err := s.FetchAndSyncGeomaps(nil, true); err != nil {
+		log.Error(err.Error())
+	}
+
+	if err := s.FetchAndSyncDomains(domains, true); err != nil {
+		log.Error(err.Error())
+	} else {
+		s.heartbeat.Synced()
+	}
+}
+
+func (s *AkamaiAgent) syncPending() {
+	if err := s.FetchAndSyncDatacenters(nil, false); err != nil {
+		log.Error(err.Error())
+	}
+
+	if err := s.FetchAndSyncGeomaps(nil, false); err != nil {
+		log.Error(err.Error())
+	}
+
+	if err := s.FetchAndSyncDomains(nil, false); err != nil {
+		log.Error(err.Error())
+	} else {
+		s.heartbeat.Synced()
+	}
+
+	s.lastSync = time.Now()
+}
+
 func (s *AkamaiAgent) memberStatusSync() error {
 	log.Debugf("Running member status sync")
 	response, err := s.rpc.GetDomains(context.Background(), &server.SearchRequest{
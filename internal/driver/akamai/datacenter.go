@@ -129,6 +129,28 @@ func (s *AkamaiAgent) FetchAndSyncDatacenters(datacenters []string, force bool)
 	return nil
 }
 
+var datacenterFieldsToCompare = []string{
+	"City",
+	"Continent",
+	"Country",
+	"StateOrProvince",
+	"Longitude",
+	"Nickname",
+}
+
+// constructAkamaiDatacenter returns the reference GTM datacenter of a datacenter
+func constructAkamaiDatacenter(datacenter *rpcmodels.Datacenter) gtm.Datacenter {
+	return gtm.Datacenter{
+		City:            datacenter.GetCity(),
+		Continent:       datacenter.GetContinent(),
+		Country:         datacenter.GetCountry(),
+		StateOrProvince: datacenter.GetStateOrProvince(),
+		Latitude:        datacenter.GetLatitude(),
+		Longitude:       datacenter.GetLongitude(),
+		Nickname:        datacenter.Id,
+	}
+}
+
 func (s *AkamaiAgent) SyncDatacenter(datacenter *rpcmodels.Datacenter, force bool) (*rpcmodels.Datacenter, error) {
 	log.Debugf("SyncDatacenter(%s, force=%t)", datacenter.Id, force)
 
@@ -180,27 +202,9 @@ func (s *AkamaiAgent) SyncDatacenter(datacenter *rpcmodels.Datacenter, force boo
 		return datacenter, nil
 	}
 
-	// reference datacenter
-	referenceDatacenter := gtm.Datacenter{
-		City:            datacenter.GetCity(),
-		Continent:       datacenter.GetContinent(),
-		Country:         datacenter.GetCountry(),
-		StateOrProvince: datacenter.GetStateOrProvince(),
-		Latitude:        datacenter.GetLatitude(),
-		Longitude:       datacenter.GetLongitude(),
-		Nickname:        datacenter.Id,
-	}
-
 	// compare to reference datacenter
-	fieldsToCompare := []string{
-		"City",
-		"Continent",
-		"Country",
-		"StateOrProvince",
-		"Longitude",
-		"Nickname",
-	}
-	if utils.DeepEqualFields(&referenceDatacenter, backendDatacenter, fieldsToCompare) {
+	referenceDatacenter := constructAkamaiDatacenter(datacenter)
+	if utils.DeepEqualFields(&referenceDatacenter, backendDatacenter, datacenterFieldsToCompare) {
 		// no change
 		return datacenter, nil
 	}
@@ -92,17 +92,21 @@ func (s *AkamaiAgent) FetchAndSyncDomains(domains []string, force bool) error {
 			defer s.gtmLock.Unlock()
 			s.gtmLock.Lock()
 
+			var syncErr error
 			if domain.ProvisioningStatus == models.DomainProvisioningStatusPENDINGDELETE {
 				// Run Delete
-				if err := s.DeleteProperty(domain, trafficManagementDomain); err != nil {
-					return err
+				if syncErr = s.DeleteProperty(domain, trafficManagementDomain); syncErr == nil {
+					provRequests = driver.DeletedDomainProvisioningRequests(domain)
 				}
-				provRequests = s.CascadeUpdateDomainProvisioningStatus(domain, "DELETED")
 			} else {
 				// Run Sync
-				if provRequests, err = s.SyncProperty(domain, trafficManagementDomain); err != nil {
-					return err
-				}
+				provRequests, syncErr = s.SyncProperty(domain, trafficManagementDomain)
+			}
+			if syncErr != nil {
+				// Report the domain as failed, it is not retried until requested
+				driver.UpdateProvisioningStatus(s.rpc,
+					ProvRequests{driver.GetProvisioningErrorRequest(domain.Id, "DOMAIN", syncErr)})
+				return syncErr
 			}
 
 			// Wait for status propagation
@@ -114,6 +118,10 @@ func (s *AkamaiAgent) FetchAndSyncDomains(domains []string, force bool) error {
 					log.Error(err.Error())
 				}
 			}
+			if status == "DENIED" && err != nil {
+				// The configuration has been rejected, report the domain as failed
+				provRequests = ProvRequests{driver.GetProvisioningErrorRequest(domain.Id, "DOMAIN", err)}
+			}
 			driver.UpdateProvisioningStatus(s.rpc, provRequests)
 			log.Infof("FetchAndSyncDomains(%s) finished with '%s'", domain.Id, status)
 			return nil
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package akamai
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/actatum/stormrpc"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v13/pkg/gtm"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+	"github.com/sapcc/andromeda/models"
+)
+
+type syncRPCClient struct {
+	server.RPCServerClient
+	domains  []*rpcmodels.Domain
+	requests []*server.ProvisioningStatusRequest_ProvisioningStatus
+}
+
+func (c *syncRPCClient) GetDomains(_ context.Context, _ *server.SearchRequest, _ ...stormrpc.CallOption) (*server.DomainsResponse, error) {
+	return &server.DomainsResponse{Response: c.domains}, nil
+}
+
+func (c *syncRPCClient) UpdateProvisioningStatus(_ context.Context, in *server.ProvisioningStatusRequest, _ ...stormrpc.CallOption) (*server.ProvisioningStatusResponse, error) {
+	c.requests = append(c.requests, in.GetProvisioningStatus()...)
+	return &server.ProvisioningStatusResponse{}, nil
+}
+
+// failingGTM rejects all property updates
+type failingGTM struct {
+	gtm.GTM
+}
+
+func (failingGTM) GetProperty(context.Context, gtm.GetPropertyRequest) (*gtm.GetPropertyResponse, error) {
+	return nil, errors.New("property not found")
+}
+
+func (failingGTM) UpdateProperty(context.Context, gtm.UpdatePropertyRequest) (*gtm.UpdatePropertyResponse, error) {
+	return nil, errors.New("rate limit exceeded")
+}
+
+func TestFetchAndSyncDomainsFailure(t *testing.T) {
+	cache, _ := lru.New[string, int](64)
+	rpc := &syncRPCClient{domains: []*rpcmodels.Domain{{
+		Id:                 "dom1-uuid",
+		Fqdn:               "test.example.com",
+		Mode:               models.DomainModeROUNDROBIN,
+		RecordType:         models.DomainRecordTypeA,
+		ProvisioningStatus: models.DomainProvisioningStatusPENDINGUPDATE,
+		Datacenters: []*rpcmodels.Datacenter{
+			{Id: "dc1-uuid", Meta: 3131, ProvisioningStatus: models.DatacenterProvisioningStatusACTIVE},
+		},
+		Pools: []*rpcmodels.Pool{{
+			Id: "pool1-uuid",
+			Members: []*rpcmodels.Member{
+				{Id: "member1-uuid", Address: "192.0.2.1", Port: 80, DatacenterId: "dc1-uuid", AdminStateUp: true},
+			},
+		}},
+	}}}
+	agent := &AkamaiAgent{gtm: failingGTM{}, rpc: rpc, datacenterIdCache: cache}
+
+	err := agent.FetchAndSyncDomains(nil, false)
+	assert.ErrorContains(t, err, "rate limit exceeded")
+	if assert.Len(t, rpc.requests, 1) {
+		assert.Equal(t, "dom1-uuid", rpc.requests[0].GetId())
+		assert.Equal(t, server.ProvisioningStatusRequest_ProvisioningStatus_ERROR, rpc.requests[0].GetStatus())
+		assert.Contains(t, rpc.requests[0].GetError(), "rate limit exceeded")
+	}
+}
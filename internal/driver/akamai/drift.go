@@ -0,0 +1,314 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package akamai
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/actatum/stormrpc"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v13/pkg/gtm"
+	"github.com/apex/log"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/utils"
+	"github.com/sapcc/andromeda/models"
+)
+
+var (
+	driftGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "akamai_drift",
+			Help: "Akamai objects drifted from the Andromeda database, 1 if drifted.",
+		},
+		[]string{"type", "id", "name"},
+	)
+	orphanedPropertiesGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "akamai_orphaned_properties",
+			Help: "Number of Akamai properties referencing no longer existing domains.",
+		},
+	)
+	lastDriftCheckGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "akamai_drift_check_timestamp_seconds",
+			Help: "Unix timestamp of the last completed drift check.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(driftGauge, orphanedPropertiesGauge, lastDriftCheckGauge)
+}
+
+// DriftEntry is a single Akamai object differing from the Andromeda database
+type DriftEntry struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	// Missing is true if the object doesn't exist in Akamai
+	Missing bool `json:"missing"`
+	// Fields lists the differing top-level fields of the object
+	Fields   []string `json:"fields,omitempty"`
+	Reverted bool     `json:"reverted"`
+	Error    string   `json:"error,omitempty"`
+}
+
+// DriftReport is the result of a drift check of the traffic management domain
+type DriftReport struct {
+	Domain             string       `json:"domain"`
+	CheckedAt          time.Time    `json:"checked_at"`
+	Datacenters        []DriftEntry `json:"datacenters"`
+	Geomaps            []DriftEntry `json:"geomaps"`
+	Properties         []DriftEntry `json:"properties"`
+	OrphanedProperties []string     `json:"orphaned_properties"`
+}
+
+type DriftCheckRequest struct {
+	Revert bool `json:"revert"`
+}
+
+type driftCheck struct {
+	revert bool
+	report chan driftCheckResult
+}
+
+type driftCheckResult struct {
+	report *DriftReport
+	err    error
+}
+
+// DriftCheck runs an on-demand drift check, optionally reverting all drifted objects
+func DriftCheck(ctx context.Context, req stormrpc.Request) stormrpc.Response {
+	var driftReq DriftCheckRequest
+	if err := req.Decode(&driftReq); err != nil {
+		return stormrpc.NewErrorResponse(req.Reply, err)
+	}
+	log.WithField("revert", driftReq.Revert).Info("[DriftCheck] Checking for drift")
+
+	// serialized with syncs by the worker thread
+	result := make(chan driftCheckResult, 1)
+	akamaiAgent.driftCheck <- driftCheck{driftReq.Revert, result}
+	res := <-result
+	if res.err != nil {
+		return stormrpc.NewErrorResponse(req.Reply, res.err)
+	}
+
+	resp, err := stormrpc.NewResponse(req.Reply, res.report)
+	if err != nil {
+		return stormrpc.NewErrorResponse(req.Reply, err)
+	}
+	return resp
+}
+
+// DriftCheck diffs all datacenters, geomaps and properties of the traffic management domain
+// against the Andromeda database and reports out-of-band changes.
+func (s *AkamaiAgent) DriftCheck(revert bool) (*DriftReport, error) {
+	trafficManagementDomain := config.Global.AkamaiConfig.Domain
+	report := &DriftReport{
+		Domain:             trafficManagementDomain,
+		CheckedAt:          time.Now(),
+		Datacenters:        []DriftEntry{},
+		Geomaps:            []DriftEntry{},
+		Properties:         []DriftEntry{},
+		OrphanedProperties: []string{},
+	}
+
+	var err error
+	if report.Datacenters, err = s.datacenterDrift(trafficManagementDomain, revert); err != nil {
+		return nil, err
+	}
+	if report.Geomaps, err = s.geomapDrift(trafficManagementDomain, revert); err != nil {
+		return nil, err
+	}
+	if report.Properties, report.OrphanedProperties, err = s.propertyDrift(trafficManagementDomain, revert); err != nil {
+		return nil, err
+	}
+
+	driftGauge.Reset()
+	for kind, entries := range map[string][]DriftEntry{
+		"datacenter": report.Datacenters,
+		"geomap":     report.Geomaps,
+		"property":   report.Properties,
+	} {
+		for _, entry := range entries {
+			if !entry.Reverted {
+				driftGauge.WithLabelValues(kind, entry.ID, entry.Name).Set(1)
+			}
+		}
+	}
+	orphanedPropertiesGauge.Set(float64(len(report.OrphanedProperties)))
+	lastDriftCheckGauge.SetToCurrentTime()
+
+	log.WithField("datacenters", len(report.Datacenters)).
+		WithField("geomaps", len(report.Geomaps)).
+		WithField("properties", len(report.Properties)).
+		WithField("orphaned", len(report.OrphanedProperties)).
+		Infof("Drift check of %s finished", trafficManagementDomain)
+	if len(report.Datacenters)+len(report.Geomaps)+len(report.Properties)+len(report.OrphanedProperties) > 0 {
+		log.Warnf("Drift report: %s", PrettyJson(report))
+	}
+	return report, nil
+}
+
+func (s *AkamaiAgent) datacenterDrift(trafficManagementDomain string, revert bool) ([]DriftEntry, error) {
+	datacenters, err := s.GetDatacenters(nil)
+	if err != nil {
+		return nil, err
+	}
+	backendDatacenters, err := s.gtm.ListDatacenters(context.Background(),
+		gtm.ListDatacentersRequest{DomainName: trafficManagementDomain})
+	if err != nil {
+		return nil, err
+	}
+	byNickname := make(map[string]*gtm.Datacenter, len(backendDatacenters))
+	for i := range backendDatacenters {
+		byNickname[backendDatacenters[i].Nickname] = &backendDatacenters[i]
+	}
+
+	entries := []DriftEntry{}
+	for _, datacenter := range datacenters {
+		// pending datacenters get synced anyway
+		if datacenter.GetProvisioningStatus() != models.DatacenterProvisioningStatusACTIVE {
+			continue
+		}
+
+		entry := DriftEntry{ID: datacenter.GetId(), Name: datacenter.GetName()}
+		referenceDatacenter := constructAkamaiDatacenter(datacenter)
+		if backendDatacenter, ok := byNickname[datacenter.GetId()]; !ok {
+			entry.Missing = true
+		} else if entry.Fields = utils.DifferingFields(&referenceDatacenter, backendDatacenter,
+			datacenterFieldsToCompare); len(entry.Fields) == 0 {
+			continue
+		}
+
+		if revert {
+			_, err := s.SyncDatacenter(datacenter, true)
+			entry.setReverted(err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (s *AkamaiAgent) geomapDrift(trafficManagementDomain string, revert bool) ([]DriftEntry, error) {
+	response, err := s.rpc.GetGeomaps(context.Background(), &server.SearchRequest{
+		Provider:       "akamai",
+		FullyPopulated: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []DriftEntry{}
+	for _, geomap := range response.GetResponse() {
+		if geomap.GetProvisioningStatus() != models.GeomapProvisioningStatusACTIVE {
+			continue
+		}
+
+		referenceGeoMap, err := s.constructAkamaiGeoMap(geomap)
+		if err != nil {
+			return nil, err
+		}
+		backendGeoMap, err := s.gtm.GetGeoMap(context.Background(), gtm.GetGeoMapRequest{
+			MapName:    geomap.GetId(),
+			DomainName: trafficManagementDomain,
+		})
+
+		entry := DriftEntry{ID: geomap.GetId()}
+		var gtmErr *gtm.Error
+		if errors.As(err, &gtmErr) && gtmErr.StatusCode == 404 {
+			entry.Missing = true
+		} else if err != nil {
+			return nil, err
+		} else if entry.Fields = utils.DifferingFields((*gtm.GeoMap)(backendGeoMap), referenceGeoMap,
+			geomapFieldsToCompare); len(entry.Fields) == 0 {
+			continue
+		}
+
+		if revert {
+			_, err := s.SyncGeomap(geomap, true)
+			entry.setReverted(err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// propertyDrift returns drifted properties of active domains and orphaned properties, whose
+// comments reference a domain ID not known to Andromeda.
+func (s *AkamaiAgent) propertyDrift(trafficManagementDomain string, revert bool) ([]DriftEntry, []string, error) {
+	response, err := s.rpc.GetDomains(context.Background(), &server.SearchRequest{
+		Provider:       "akamai",
+		FullyPopulated: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.gtmLock.Lock()
+	defer s.gtmLock.Unlock()
+
+	entries := []DriftEntry{}
+	domainIDs := make(map[string]bool)
+	for _, domain := range response.GetResponse() {
+		domainIDs[domain.GetId()] = true
+		if domain.GetProvisioningStatus() != models.DomainProvisioningStatusACTIVE {
+			continue
+		}
+
+		property, _ := s.constructProperty(domain)
+		if len(property.TrafficTargets) == 0 {
+			// property is not provisioned without traffic targets
+			continue
+		}
+		backendProperty, err := s.gtm.GetProperty(context.Background(), gtm.GetPropertyRequest{
+			PropertyName: property.Name,
+			DomainName:   trafficManagementDomain,
+		})
+
+		entry := DriftEntry{ID: domain.GetId(), Name: domain.GetFqdn()}
+		var gtmErr *gtm.Error
+		if errors.As(err, &gtmErr) && gtmErr.StatusCode == 404 {
+			entry.Missing = true
+		} else if err != nil {
+			return nil, nil, err
+		} else if entry.Fields = utils.DifferingFields(property, (*gtm.Property)(backendProperty),
+			propertyFieldsToCompare); len(entry.Fields) == 0 {
+			continue
+		}
+
+		if revert {
+			_, err := s.SyncProperty(domain, trafficManagementDomain)
+			entry.setReverted(err)
+		}
+		entries = append(entries, entry)
+	}
+
+	properties, err := s.gtm.ListProperties(context.Background(),
+		gtm.ListPropertiesRequest{DomainName: trafficManagementDomain})
+	if err != nil {
+		return nil, nil, err
+	}
+	orphaned := []string{}
+	for _, property := range properties {
+		if property.Comments != "" && !domainIDs[property.Comments] {
+			orphaned = append(orphaned, property.Name)
+		}
+	}
+	return entries, orphaned, nil
+}
+
+func (e *DriftEntry) setReverted(err error) {
+	if err != nil {
+		log.WithError(err).Errorf("Reverting drift of %s failed", e.ID)
+		e.Error = err.Error()
+		return
+	}
+	log.Infof("Reverted drift of %s", e.ID)
+	e.Reverted = true
+}
@@ -125,6 +125,16 @@ func (s *AkamaiAgent) FetchAndSyncGeomaps(geomaps []string, force bool) error {
 	return nil
 }
 
+var geomapFieldsToCompare = []string{
+	//"Name", # Name is unique identifier, we don't want to compare it
+	"DefaultDatacenter",
+	"DefaultDatacenter.Nickname",
+	"DefaultDatacenter.DatacenterId",
+	"Assignments",
+	"Assignments.DatacenterBase",
+	"Assignments.Countries",
+}
+
 func (s *AkamaiAgent) SyncGeomap(geomap *rpcmodels.Geomap, force bool) (*gtm.GeoMap, error) {
 	log.Debugf("SyncGeomap(%s, force=%t)", geomap.Id, force)
 
@@ -166,16 +176,7 @@ func (s *AkamaiAgent) SyncGeomap(geomap *rpcmodels.Geomap, force bool) (*gtm.Geo
 		return nil, err
 	}
 
-	fieldsToCompare := []string{
-		//"Name", # Name is unique identifier, we don't want to compare it
-		"DefaultDatacenter",
-		"DefaultDatacenter.Nickname",
-		"DefaultDatacenter.DatacenterId",
-		"Assignments",
-		"Assignments.DatacenterBase",
-		"Assignments.Countries",
-	}
-	if utils.DeepEqualFields((*gtm.GeoMap)(backendGeoMap), referenceGeoMap, fieldsToCompare) {
+	if utils.DeepEqualFields((*gtm.GeoMap)(backendGeoMap), referenceGeoMap, geomapFieldsToCompare) {
 		// everything's equal, nothing to do
 		// cast backendGeoMap to gtm.GeoMap
 		return (*gtm.GeoMap)(backendGeoMap), nil
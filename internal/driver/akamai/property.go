@@ -5,28 +5,46 @@
 package akamai
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v13/pkg/gtm"
 	"github.com/apex/log"
 	"github.com/go-openapi/swag/conv"
 
+	"github.com/sapcc/andromeda/internal/config"
 	"github.com/sapcc/andromeda/internal/driver"
 	"github.com/sapcc/andromeda/internal/rpcmodels"
 	"github.com/sapcc/andromeda/internal/utils"
 	"github.com/sapcc/andromeda/models"
 )
 
+var (
+	errMXNotSupported              = errors.New("MX domains are not supported by akamai")
+	errMultipleCNamesPerDatacenter = errors.New("a datacenter can only hand out a single CNAME")
+)
+
+// DeleteProperty deletes the properties of the FQDN and the aliases of a domain
 func (s *AkamaiAgent) DeleteProperty(domain *rpcmodels.Domain, trafficManagementDomain string) error {
+	for _, name := range propertyNames(domain) {
+		if err := s.deletePropertyByName(name, trafficManagementDomain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *AkamaiAgent) deletePropertyByName(name string, trafficManagementDomain string) error {
 	// Delete
-	log.Infof("DeleteProperty(domain=%s, property=%s)", trafficManagementDomain, domain.GetFqdn())
+	log.Infof("DeleteProperty(domain=%s, property=%s)", trafficManagementDomain, name)
 
 	// Check if property exists
 	if _, err := s.gtm.GetProperty(context.Background(), gtm.GetPropertyRequest{
 		DomainName:   trafficManagementDomain,
-		PropertyName: domain.GetFqdn(),
+		PropertyName: name,
 	}); err != nil {
 		var gtmErr *gtm.Error
 		if errors.As(err, &gtmErr) && gtmErr.StatusCode == 404 {
@@ -37,7 +55,7 @@ func (s *AkamaiAgent) DeleteProperty(domain *rpcmodels.Domain, trafficManagement
 
 	request := gtm.DeletePropertyRequest{
 		DomainName:   trafficManagementDomain,
-		PropertyName: domain.GetFqdn(),
+		PropertyName: name,
 	}
 	ret, err := s.gtm.DeleteProperty(context.Background(), request)
 	if err != nil {
@@ -48,20 +66,81 @@ func (s *AkamaiAgent) DeleteProperty(domain *rpcmodels.Domain, trafficManagement
 	return nil
 }
 
-func (s *AkamaiAgent) SyncProperty(domain *rpcmodels.Domain, trafficManagementDomain string) (ProvRequests, error) {
+// deleteStaleAliasProperties deletes the properties of removed aliases, which are identified by the
+// domain ID in their comments.
+func (s *AkamaiAgent) deleteStaleAliasProperties(domain *rpcmodels.Domain, trafficManagementDomain string) error {
+	properties, err := s.gtm.ListProperties(context.Background(),
+		gtm.ListPropertiesRequest{DomainName: trafficManagementDomain})
+	if err != nil {
+		return err
+	}
+	names := propertyNames(domain)
+	for _, property := range properties {
+		if property.Comments == domain.GetId() && !slices.Contains(names, property.Name) {
+			if err := s.deletePropertyByName(property.Name, trafficManagementDomain); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+var propertyFieldsToCompare = []string{
+	"Name",
+	"Type",
+	"IPv6",
+	"Comments",
+	"HandoutMode",
+	"HandoutLimit",
+	"DynamicTTL",
+	"FailoverDelay",
+	"FailbackDelay",
+	"TrafficTargets",
+	"ScoreAggregationType",
+	"TrafficTargets.DatacenterId",
+	"TrafficTargets.Enabled",
+	"TrafficTargets.Weight",
+	"TrafficTargets.Servers",
+	"TrafficTargets.HandoutCName",
+	//"TrafficTargets.Name", # bug in Akamai API
+	"LivenessTests",
+	"LivenessTests.Name",
+	"LivenessTests.TestObject",
+	"LivenessTests.TestObjectPort",
+	"LivenessTests.TestInterval",
+	"LivenessTests.TestTimeout",
+	"LivenessTests.RequestString",
+	"LivenessTests.ResponseString",
+	"LivenessTests.TestObjectProtocol",
+	"LivenessTests.HTTPMethod",
+}
+
+// constructProperty returns the desired GTM property of a domain and the provisioning status
+// updates of its pools, members and monitors.
+func (s *AkamaiAgent) constructProperty(domain *rpcmodels.Domain) (*gtm.Property, ProvRequests) {
 	var provRequests ProvRequests
 	var members []*rpcmodels.Member
 	var monitors []*rpcmodels.Monitor
 
-	pools := domain.GetPools()
+	// failover properties hand out the traffic target with the highest weight, which is derived from the
+	// priority of the pools: members of the first pool are primary, the following pools are fallbacks.
+	failover := domain.GetMode() == models.DomainModeAVAILABILITY && config.Global.AkamaiConfig.AvailabilityFailover
+	pools := slices.Clone(domain.GetPools())
+	slices.SortStableFunc(pools, func(a, b *rpcmodels.Pool) int {
+		return cmp.Compare(a.GetPriority(), b.GetPriority())
+	})
+	failoverWeights := map[string]float64{}
 	if len(pools) > 0 {
 		// flatten Members and Monitors
-		for _, pool := range pools {
+		for i, pool := range pools {
 			if pool.ProvisioningStatus == models.PoolProvisioningStatusPENDINGDELETE {
 				provRequests = append(provRequests,
 					driver.GetProvisioningStatusRequest(pool.Id, "POOL", "DELETED"))
 				continue
 			}
+			for _, member := range pool.GetMembers() {
+				failoverWeights[member.GetId()] = float64(len(pools) - i)
+			}
 			members = append(members, pool.GetMembers()...)
 			monitors = append(monitors, pool.GetMonitors()...)
 			provRequests = append(provRequests,
@@ -73,15 +152,27 @@ func (s *AkamaiAgent) SyncProperty(domain *rpcmodels.Domain, trafficManagementDo
 	property := gtm.Property{
 		Name:                 domain.GetFqdn(),
 		Type:                 PROPERTY_TYPE_MAP[domain.GetMode()],
+		IPv6:                 domain.GetRecordType() == models.DomainRecordTypeAAAA,
 		Comments:             domain.Id,
 		ScoreAggregationType: "best",
 		HandoutMode:          "all-live-ips",
-		FailbackDelay:        0,
-		FailoverDelay:        0,
+		DynamicTTL:           int(domain.GetTtl()),
+		FailbackDelay:        int(domain.GetFailbackDelay()),
+		FailoverDelay:        int(domain.GetFailoverDelay()),
 		TrafficTargets:       []gtm.TrafficTarget{},
 		LivenessTests:        []gtm.LivenessTest{},
 	}
 
+	if failover {
+		// opt-in, as it changes the traffic distribution of existing AVAILABILITY domains
+		property.Type = "failover"
+	}
+	if limit := domain.GetHandoutLimit(); limit > 0 {
+		// hand out up to limit live servers instead of all
+		property.HandoutMode = "normal"
+		property.HandoutLimit = int(limit)
+	}
+
 	// Process Members
 MEMBERLOOP:
 	for _, member := range members {
@@ -90,6 +181,13 @@ MEMBERLOOP:
 				driver.GetProvisioningStatusRequest(member.Id, "MEMBER", "DELETED"))
 			continue
 		}
+		if !servesMember(domain, member.GetAddress()) {
+			// a property hands out a single address family or hostnames, the other members of a mixed
+			// pool are served by another domain of the pool and need no traffic target here.
+			provRequests = append(provRequests,
+				driver.GetProvisioningStatusRequest(member.Id, "MEMBER", "ACTIVE"))
+			continue
+		}
 
 		datacenterUUID := member.GetDatacenterId()
 		var datacenterID int
@@ -103,10 +201,24 @@ MEMBERLOOP:
 			}
 
 			// check if we have already a traffic target for this datacenter
-			for _, target := range property.TrafficTargets {
+			for i := range property.TrafficTargets {
+				target := &property.TrafficTargets[i]
 				if target.DatacenterID == datacenterID {
+					if target.HandoutCName != "" {
+						provRequests = append(provRequests,
+							driver.GetProvisioningErrorRequest(member.Id, "MEMBER", errMultipleCNamesPerDatacenter))
+						continue MEMBERLOOP
+					}
 					// just add the server to the existing traffic target
 					target.Servers = append(target.Servers, member.Address)
+					switch {
+					case domain.GetMode() == models.DomainModeWEIGHTED:
+						// weighted traffic targets are the sum of their members weights
+						target.Weight += float64(member.GetWeight())
+					case failover:
+						// a datacenter serving several pools fails over by its highest priority pool
+						target.Weight = max(target.Weight, failoverWeights[member.GetId()])
+					}
 					provRequests = append(provRequests,
 						driver.GetProvisioningStatusRequest(member.Id, "MEMBER", "ACTIVE"))
 					continue MEMBERLOOP
@@ -122,6 +234,17 @@ MEMBERLOOP:
 			Weight:       50,
 			DatacenterID: datacenterID,
 		}
+		switch {
+		case domain.GetMode() == models.DomainModeWEIGHTED:
+			trafficTarget.Weight = float64(member.GetWeight())
+		case failover:
+			trafficTarget.Weight = failoverWeights[member.GetId()]
+		}
+		if domain.GetRecordType() == models.DomainRecordTypeCNAME {
+			// CNAME traffic targets hand out the hostname of their member instead of servers
+			trafficTarget.Servers = nil
+			trafficTarget.HandoutCName = member.Address
+		}
 		property.TrafficTargets = append(property.TrafficTargets, trafficTarget)
 		provRequests = append(provRequests,
 			driver.GetProvisioningStatusRequest(member.Id, "MEMBER", "ACTIVE"))
@@ -131,7 +254,9 @@ MEMBERLOOP:
 	// collect unique member ports
 	uniquePorts := make(map[uint32]interface{})
 	for _, member := range members {
-		uniquePorts[member.GetPort()] = nil
+		if servesMember(domain, member.GetAddress()) {
+			uniquePorts[member.GetPort()] = nil
+		}
 	}
 
 	// Add new Monitors
@@ -172,9 +297,9 @@ MEMBERLOOP:
 				livenessTest.ResponseString = monitor.GetReceive()
 			default:
 				// unsupported type
-				log.Warnf("Unsupported monitor type: %s", monitor.GetType())
-				provRequests = append(provRequests,
-					driver.GetProvisioningStatusRequest(monitor.Id, "MONITOR", models.MonitorProvisioningStatusERROR))
+				err := fmt.Errorf("unsupported monitor type: %s", monitor.GetType())
+				log.Warn(err.Error())
+				provRequests = append(provRequests, driver.GetProvisioningErrorRequest(monitor.Id, "MONITOR", err))
 				continue monitorLoop
 			}
 			property.LivenessTests = append(property.LivenessTests, livenessTest)
@@ -185,6 +310,33 @@ MEMBERLOOP:
 
 	provRequests = append(provRequests,
 		driver.GetProvisioningStatusRequest(domain.Id, "DOMAIN", models.DomainProvisioningStatusACTIVE))
+	return &property, provRequests
+}
+
+// servesMember reports whether the property of a domain hands out the address of a member: IPv4 addresses
+// are handed out by A, IPv6 addresses by AAAA and hostnames by CNAME domains.
+func servesMember(domain *rpcmodels.Domain, address string) bool {
+	recordType := utils.AddressRecordType(address)
+	switch domain.GetRecordType() {
+	case models.DomainRecordTypeA, models.DomainRecordTypeAAAA:
+		return recordType == "" || recordType == domain.GetRecordType()
+	case models.DomainRecordTypeCNAME:
+		return recordType == ""
+	}
+	return true
+}
+
+// propertyNames returns the names of the properties of a domain, aliases are served by additional
+// properties with the same configuration as the property of the FQDN.
+func propertyNames(domain *rpcmodels.Domain) []string {
+	return append([]string{domain.GetFqdn()}, domain.GetAliases()...)
+}
+
+func (s *AkamaiAgent) SyncProperty(domain *rpcmodels.Domain, trafficManagementDomain string) (ProvRequests, error) {
+	if domain.GetRecordType() == models.DomainRecordTypeMX {
+		return ProvRequests{driver.GetProvisioningErrorRequest(domain.Id, "DOMAIN", errMXNotSupported)}, nil
+	}
+	property, provRequests := s.constructProperty(domain)
 
 	// Pre-Validation
 	if len(property.TrafficTargets) == 0 {
@@ -193,6 +345,17 @@ MEMBERLOOP:
 		return provRequests, s.DeleteProperty(domain, trafficManagementDomain)
 	}
 
+	for _, name := range propertyNames(domain) {
+		namedProperty := *property
+		namedProperty.Name = name
+		if err := s.syncPropertyByName(&namedProperty, trafficManagementDomain); err != nil {
+			return nil, err
+		}
+	}
+	return provRequests, s.deleteStaleAliasProperties(domain, trafficManagementDomain)
+}
+
+func (s *AkamaiAgent) syncPropertyByName(property *gtm.Property, trafficManagementDomain string) error {
 	request := gtm.GetPropertyRequest{
 		PropertyName: property.Name,
 		DomainName:   trafficManagementDomain,
@@ -202,46 +365,23 @@ MEMBERLOOP:
 		log.Debugf("Property '%s' doesn't exist, creating...", property.Name)
 	}
 
-	fieldsToCompare := []string{
-		"Name",
-		"Type",
-		"Comments",
-		"HandoutMode",
-		"TrafficTargets",
-		"ScoreAggregationType",
-		"TrafficTargets.DatacenterId",
-		"TrafficTargets.Enabled",
-		"TrafficTargets.Weight",
-		"TrafficTargets.Servers",
-		//"TrafficTargets.Name", # bug in Akamai API
-		"LivenessTests",
-		"LivenessTests.Name",
-		"LivenessTests.TestObject",
-		"LivenessTests.TestObjectPort",
-		"LivenessTests.TestInterval",
-		"LivenessTests.TestTimeout",
-		"LivenessTests.RequestString",
-		"LivenessTests.ResponseString",
-		"LivenessTests.TestObjectProtocol",
-		"LivenessTests.HTTPMethod",
-	}
-	if utils.DeepEqualFields(&property, (*gtm.Property)(existingProperty), fieldsToCompare) {
-		return provRequests, nil
+	if utils.DeepEqualFields(property, (*gtm.Property)(existingProperty), propertyFieldsToCompare) {
+		return nil
 	}
 
 	// Update
 	log.Infof("UpdateProperty(domain=%s, property=%s)", trafficManagementDomain, property.Name)
 	updateRequest := gtm.UpdatePropertyRequest{
-		Property:   &property,
+		Property:   property,
 		DomainName: trafficManagementDomain,
 	}
 	ret, err3 := s.gtm.UpdateProperty(context.Background(), updateRequest)
 	if err3 != nil {
-		return nil, fmt.Errorf("request %s: %w", PrettyJson(property), err3)
+		return fmt.Errorf("request %s: %w", PrettyJson(property), err3)
 	}
 
 	log.Debugf("Request: %s\nResponse: %s",
 		PrettyJson(property),
 		PrettyJson(ret))
-	return provRequests, nil
+	return nil
 }
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package akamai
+
+import (
+	"testing"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+	"github.com/sapcc/andromeda/models"
+)
+
+func TestConstructPropertyAddressFamilies(t *testing.T) {
+	cache, _ := lru.New[string, int](64)
+	agent := &AkamaiAgent{datacenterIdCache: cache}
+	domain := &rpcmodels.Domain{
+		Id:          "dom1-uuid",
+		Fqdn:        "test.example.com",
+		Mode:        models.DomainModeROUNDROBIN,
+		Datacenters: []*rpcmodels.Datacenter{{Id: "dc1-uuid", Meta: 3131}},
+		Pools: []*rpcmodels.Pool{
+			{
+				Id: "pool1-uuid",
+				Members: []*rpcmodels.Member{
+					{Id: "member1-uuid", Address: "192.0.2.1", Port: 80, DatacenterId: "dc1-uuid", AdminStateUp: true},
+					{Id: "member2-uuid", Address: "2001:db8::1", Port: 443, DatacenterId: "dc1-uuid", AdminStateUp: true},
+				},
+			},
+		},
+	}
+
+	t.Run("A domains hand out IPv4 servers", func(t *testing.T) {
+		domain.RecordType = models.DomainRecordTypeA
+		property, provRequests := agent.constructProperty(domain)
+		assert.False(t, property.IPv6)
+		if assert.Len(t, property.TrafficTargets, 1) {
+			assert.Equal(t, []string{"192.0.2.1"}, property.TrafficTargets[0].Servers)
+		}
+		assert.Len(t, property.LivenessTests, 0)
+		ids := []string{}
+		for _, req := range provRequests {
+			ids = append(ids, req.Id)
+		}
+		// the IPv6 member is served by another domain of the pool, but still reported provisioned
+		assert.Equal(t, []string{"pool1-uuid", "member1-uuid", "member2-uuid", "dom1-uuid"}, ids)
+	})
+
+	t.Run("AAAA domains hand out IPv6 servers", func(t *testing.T) {
+		domain.RecordType = models.DomainRecordTypeAAAA
+		property, _ := agent.constructProperty(domain)
+		assert.True(t, property.IPv6)
+		if assert.Len(t, property.TrafficTargets, 1) {
+			assert.Equal(t, 3131, property.TrafficTargets[0].DatacenterID)
+			assert.Equal(t, []string{"2001:db8::1"}, property.TrafficTargets[0].Servers)
+		}
+	})
+
+	t.Run("CNAME domains hand out a single hostname per datacenter", func(t *testing.T) {
+		cnameDomain := &rpcmodels.Domain{
+			Id:          "dom2-uuid",
+			Fqdn:        "alias.example.com",
+			Mode:        models.DomainModeROUNDROBIN,
+			RecordType:  models.DomainRecordTypeCNAME,
+			Datacenters: domain.Datacenters,
+			Pools: []*rpcmodels.Pool{
+				{
+					Id: "pool2-uuid",
+					Members: []*rpcmodels.Member{
+						{Id: "member3-uuid", Address: "target1.example.com", Port: 80, DatacenterId: "dc1-uuid", AdminStateUp: true},
+						{Id: "member4-uuid", Address: "target2.example.com", Port: 80, DatacenterId: "dc1-uuid", AdminStateUp: true},
+						{Id: "member5-uuid", Address: "192.0.2.1", Port: 80, DatacenterId: "dc1-uuid", AdminStateUp: true},
+					},
+				},
+			},
+		}
+		property, provRequests := agent.constructProperty(cnameDomain)
+		if assert.Len(t, property.TrafficTargets, 1) {
+			assert.Equal(t, "target1.example.com", property.TrafficTargets[0].HandoutCName)
+			assert.Empty(t, property.TrafficTargets[0].Servers)
+		}
+		statuses := map[string]string{}
+		for _, req := range provRequests {
+			statuses[req.Id] = req.Status.String()
+		}
+		assert.Equal(t, "ACTIVE", statuses["member3-uuid"])
+		assert.Equal(t, "ERROR", statuses["member4-uuid"])
+		assert.NotContains(t, statuses, "member5-uuid")
+	})
+
+	t.Run("Maps the domain TTL, failover timing and handout limit", func(t *testing.T) {
+		domain.RecordType = models.DomainRecordTypeA
+		property, _ := agent.constructProperty(domain)
+		assert.Equal(t, "all-live-ips", property.HandoutMode)
+
+		timedDomain := &rpcmodels.Domain{
+			Id:            "dom3-uuid",
+			Fqdn:          "timed.example.com",
+			Mode:          models.DomainModeROUNDROBIN,
+			RecordType:    models.DomainRecordTypeA,
+			Ttl:           300,
+			FailoverDelay: 60,
+			FailbackDelay: 120,
+			HandoutLimit:  2,
+		}
+		property, _ = agent.constructProperty(timedDomain)
+		assert.Equal(t, 300, property.DynamicTTL)
+		assert.Equal(t, 60, property.FailoverDelay)
+		assert.Equal(t, 120, property.FailbackDelay)
+		assert.Equal(t, "normal", property.HandoutMode)
+		assert.Equal(t, 2, property.HandoutLimit)
+	})
+
+	t.Run("AVAILABILITY domains fail over in order of the pool priorities if enabled", func(t *testing.T) {
+		availabilityDomain := &rpcmodels.Domain{
+			Id:         "dom4-uuid",
+			Fqdn:       "failover.example.com",
+			Mode:       models.DomainModeAVAILABILITY,
+			RecordType: models.DomainRecordTypeA,
+			Datacenters: []*rpcmodels.Datacenter{
+				{Id: "dc1-uuid", Meta: 3131},
+				{Id: "dc2-uuid", Meta: 3132},
+			},
+			Pools: []*rpcmodels.Pool{
+				{
+					Id:       "fallback-uuid",
+					Priority: 1,
+					Members: []*rpcmodels.Member{
+						{Id: "member6-uuid", Address: "192.0.2.2", Port: 80, DatacenterId: "dc2-uuid", AdminStateUp: true},
+					},
+				},
+				{
+					Id:       "primary-uuid",
+					Priority: 0,
+					Members: []*rpcmodels.Member{
+						{Id: "member7-uuid", Address: "192.0.2.1", Port: 80, DatacenterId: "dc1-uuid", AdminStateUp: true},
+					},
+				},
+			},
+		}
+		// without availability_failover, traffic is distributed across all pools
+		property, _ := agent.constructProperty(availabilityDomain)
+		assert.Equal(t, "weighted-round-robin", property.Type)
+		for _, target := range property.TrafficTargets {
+			assert.Equal(t, float64(50), target.Weight)
+		}
+
+		config.Global.AkamaiConfig.AvailabilityFailover = true
+		defer func() { config.Global.AkamaiConfig.AvailabilityFailover = false }()
+		property, _ = agent.constructProperty(availabilityDomain)
+		assert.Equal(t, "failover", property.Type)
+		if assert.Len(t, property.TrafficTargets, 2) {
+			assert.Equal(t, 3131, property.TrafficTargets[0].DatacenterID)
+			assert.Equal(t, float64(2), property.TrafficTargets[0].Weight)
+			assert.Equal(t, 3132, property.TrafficTargets[1].DatacenterID)
+			assert.Equal(t, float64(1), property.TrafficTargets[1].Weight)
+		}
+	})
+}
@@ -11,57 +11,14 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v13/pkg/gtm"
 
 	"github.com/sapcc/andromeda/internal/config"
-	"github.com/sapcc/andromeda/internal/driver"
 	"github.com/sapcc/andromeda/internal/rpc/server"
 	"github.com/sapcc/andromeda/internal/rpcmodels"
-	"github.com/sapcc/andromeda/models"
 
 	"github.com/apex/log"
 )
 
 type ProvRequests []*server.ProvisioningStatusRequest_ProvisioningStatus
 
-// CascadeUpdateDomainProvisioningStatus updates the provisioning status of a domain and its related objects
-func (s *AkamaiAgent) CascadeUpdateDomainProvisioningStatus(domain *rpcmodels.Domain, value string) ProvRequests {
-	var provisioningStatusRequests ProvRequests
-
-	provisioningStatusRequests = append(provisioningStatusRequests,
-		driver.GetProvisioningStatusRequest(domain.Id, "DOMAIN", value))
-	if value == "DELETED" {
-		// Only delete domain, set related objects to active
-		value = models.DomainProvisioningStatusACTIVE
-	}
-
-	for _, datacenter := range domain.Datacenters {
-		if datacenter.ProvisioningStatus != models.DatacenterProvisioningStatusACTIVE {
-			provisioningStatusRequests = append(provisioningStatusRequests,
-				driver.GetProvisioningStatusRequest(datacenter.Id, "DATACENTER", value))
-		}
-	}
-
-	for _, pool := range domain.Pools {
-		// No pool representation in Akamai
-		if pool.ProvisioningStatus != models.PoolProvisioningStatusACTIVE {
-			provisioningStatusRequests = append(provisioningStatusRequests,
-				driver.GetProvisioningStatusRequest(pool.Id, "POOL", value))
-		}
-		for _, monitor := range pool.Monitors {
-			if monitor.ProvisioningStatus != models.MonitorProvisioningStatusACTIVE {
-				provisioningStatusRequests = append(provisioningStatusRequests,
-					driver.GetProvisioningStatusRequest(monitor.Id, "MONITOR", value))
-			}
-		}
-		for _, member := range pool.Members {
-			if member.ProvisioningStatus != models.MemberProvisioningStatusACTIVE {
-				provisioningStatusRequests = append(provisioningStatusRequests,
-					driver.GetProvisioningStatusRequest(member.Id, "MEMBER", value))
-			}
-		}
-	}
-
-	return provisioningStatusRequests
-}
-
 func (s *AkamaiAgent) syncProvisioningStatus(domain *rpcmodels.Domain) (string, error) {
 	// Check for running domain's propagation state
 	request := gtm.GetDomainStatusRequest{DomainName: config.Global.AkamaiConfig.Domain}
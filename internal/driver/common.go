@@ -25,10 +25,30 @@ func GetProvisioningStatusRequest(id string, model string, status string) *serve
 	}
 }
 
+// GetProvisioningErrorRequest returns a request setting the provisioning status to ERROR, with the cause
+func GetProvisioningErrorRequest(id string, model string, err error) *server.ProvisioningStatusRequest_ProvisioningStatus {
+	request := GetProvisioningStatusRequest(id, model, "ERROR")
+	request.Error = err.Error()
+	return request
+}
+
 func UpdateProvisioningStatus(rpc server.RPCServerClient, statusRequests []*server.ProvisioningStatusRequest_ProvisioningStatus) {
-	if _, err := rpc.UpdateProvisioningStatus(context.Background(),
-		&server.ProvisioningStatusRequest{ProvisioningStatus: statusRequests}); err != nil {
+	res, err := rpc.UpdateProvisioningStatus(context.Background(),
+		&server.ProvisioningStatusRequest{ProvisioningStatus: statusRequests})
+	if err != nil {
 		log.Error(err.Error())
+		return
+	}
+	LogRejectedStatusResults(res.GetProvisioningStatusResult())
+}
+
+// LogRejectedStatusResults logs the status updates rejected by Andromeda Server
+func LogRejectedStatusResults(results []*server.StatusResult) {
+	for _, result := range results {
+		if !result.GetSuccess() {
+			log.WithField("reason", result.GetReason().String()).
+				Warnf("Failed updating provisioning status of %s: %s", result.GetId(), result.GetMessage())
+		}
 	}
 }
 
@@ -49,6 +69,8 @@ func UpdateMemberStatus(rpc server.RPCServerClient, statusRequests []*server.Mem
 const (
 	AgentAkamai = "andromeda-akamai-agent"
 	AgentF5     = "andromeda-f5-agent"
+	AgentNoop   = "andromeda-noop-agent"
+	AgentDNS    = "andromeda-dns-agent"
 	Server      = "andromeda-server"
 )
 
@@ -58,6 +80,10 @@ func GetServiceType(name string) string {
 		return "akamai"
 	case AgentF5:
 		return "f5"
+	case AgentNoop:
+		return "noop"
+	case AgentDNS:
+		return "dns"
 	case Server:
 		return "server"
 	default:
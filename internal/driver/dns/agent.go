@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dns implements a self-hosted authoritative DNS provider, which serves
+// the Andromeda domains directly instead of provisioning them on a vendor system.
+package dns
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/actatum/stormrpc"
+	"github.com/apex/log"
+	mdns "github.com/miekg/dns"
+	"github.com/nats-io/nats.go"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
+	"github.com/sapcc/andromeda/internal/rpc"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/utils"
+)
+
+const providerName = "dns"
+
+type DNSAgent struct {
+	rpc       server.RPCServerClient
+	config    config.DNSConfig
+	geo       geoLocator
+	zone      atomic.Pointer[zone]
+	forceSync chan []string
+	changes   <-chan struct{}
+	heartbeat *driver.Heartbeat
+}
+
+func (a *DNSAgent) Sync(ctx context.Context, req stormrpc.Request) stormrpc.Response {
+	var domainIDs []string
+	if err := req.Decode(&domainIDs); err != nil {
+		return stormrpc.NewErrorResponse(req.Reply, err)
+	}
+	log.WithField("domainIDs", domainIDs).Info("[Sync] Syncing domains")
+
+	a.forceSync <- domainIDs
+	resp, err := stormrpc.NewResponse(req.Reply, nil)
+	if err != nil {
+		return stormrpc.NewErrorResponse(req.Reply, err)
+	}
+	return resp
+}
+
+func ExecuteDNSAgent() error {
+	geo, err := newGeoLocator(config.Global.DNSConfig.GeoNetworks)
+	if err != nil {
+		return err
+	}
+
+	nc, err := nats.Connect(config.Global.Default.TransportURL)
+	if err != nil {
+		return err
+	}
+	client, err := stormrpc.NewClient("", stormrpc.WithNatsConn(nc))
+	if err != nil {
+		return err
+	}
+
+	rpcClient := server.NewRPCServerClient(client)
+	agent := &DNSAgent{
+		rpc:       rpcClient,
+		config:    config.Global.DNSConfig,
+		geo:       geo,
+		forceSync: make(chan []string),
+		heartbeat: driver.NewHeartbeat(rpcClient, driver.AgentDNS, []string{providerName},
+			[]string{driver.CapabilitySync}),
+	}
+	if agent.config.Nameserver == "" {
+		agent.config.Nameserver = driver.Hostname()
+	}
+
+	// Initial zone, DNS queries are not answered before
+	if err := agent.refresh(); err != nil {
+		return err
+	}
+	agent.heartbeat.Synced()
+
+	if debounce := config.Global.Default.ChangeEventDebounce; debounce > 0 {
+		if agent.changes, err = driver.SubscribeChanges(nc, providerName, time.Duration(debounce)*time.Second); err != nil {
+			return err
+		}
+	}
+
+	srv := rpc.NewServer(driver.AgentDNS, stormrpc.WithNatsConn(nc))
+	srv.Handle("andromeda.sync", agent.Sync)
+	srv.Handle("andromeda.sync.dns", agent.Sync)
+
+	dnsServers := []*mdns.Server{
+		{Addr: agent.config.Listen, Net: "udp", Handler: agent},
+		{Addr: agent.config.Listen, Net: "tcp", Handler: agent},
+	}
+	errs := make(chan error, len(dnsServers))
+	for _, dnsServer := range dnsServers {
+		go func() {
+			errs <- dnsServer.ListenAndServe()
+		}()
+	}
+
+	go func() {
+		_ = srv.Run()
+	}()
+	go agent.WorkerThread()
+	heartbeatCtx, heartbeatCancel := context.WithCancel(context.Background())
+	defer heartbeatCancel()
+	go agent.heartbeat.Run(heartbeatCtx, time.Duration(config.Global.Default.HeartbeatInterval)*time.Second)
+	if config.Global.Default.Prometheus {
+		go utils.PrometheusListen()
+	}
+	log.WithField("subjects", srv.Subjects()).
+		WithField("listen", agent.config.Listen).
+		Info("Subscribed")
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case <-done:
+	case err := <-errs:
+		log.WithError(err).Error("DNS server failed")
+	}
+	log.Info("Shutting down")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, dnsServer := range dnsServers {
+		_ = dnsServer.ShutdownContext(ctx)
+	}
+	return srv.Shutdown(ctx)
+}
+
+func (a *DNSAgent) WorkerThread() {
+	syncTicker := time.NewTicker(time.Duration(a.config.SyncInterval) * time.Second)
+
+	for {
+		select {
+		case <-a.forceSync:
+			log.Debug("Running force sync")
+		case <-a.changes:
+			log.Debug("Running sync of pending changes")
+		case <-syncTicker.C:
+			log.Debug("Running periodic sync")
+		}
+		if a.heartbeat.Disabled() {
+			// the last zone is served until the agent is enabled again
+			log.Debug("Skipping sync, agent disabled")
+			continue
+		}
+		// the zone is always rebuilt from all domains, so there is no partial sync
+		if err := a.refresh(); err != nil {
+			log.Error(err.Error())
+		} else {
+			a.heartbeat.Synced()
+		}
+	}
+}
+
+// refresh fetches all domains and geomaps of the DNS provider, swaps the served zone and
+// reports pending entities as provisioned afterward.
+func (a *DNSAgent) refresh() error {
+	var provRequests []*server.ProvisioningStatusRequest_ProvisioningStatus
+
+	datacenters, err := a.rpc.GetDatacenters(context.Background(), &server.SearchRequest{
+		Provider: providerName,
+		Pending:  true,
+	})
+	if err != nil {
+		return err
+	}
+	for _, datacenter := range datacenters.GetResponse() {
+		if status, ok := driver.NextProvisioningStatus(datacenter.GetProvisioningStatus()); ok {
+			provRequests = append(provRequests,
+				driver.GetProvisioningStatusRequest(datacenter.GetId(), "DATACENTER", status))
+		}
+	}
+
+	geomaps, err := a.rpc.GetGeomaps(context.Background(), &server.SearchRequest{
+		Provider: providerName,
+	})
+	if err != nil {
+		return err
+	}
+	for _, geomap := range geomaps.GetResponse() {
+		if status, ok := driver.NextProvisioningStatus(geomap.GetProvisioningStatus()); ok {
+			provRequests = append(provRequests,
+				driver.GetProvisioningStatusRequest(geomap.GetId(), "GEOGRAPHIC_MAP", status))
+		}
+	}
+
+	domains, err := a.rpc.GetDomains(context.Background(), &server.SearchRequest{
+		Provider:       providerName,
+		FullyPopulated: true,
+	})
+	if err != nil {
+		return err
+	}
+	for _, domain := range domains.GetResponse() {
+		provRequests = append(provRequests, driver.DomainProvisioningRequests(domain)...)
+	}
+
+	a.zone.Store(newZone(domains.GetResponse(), geomaps.GetResponse()))
+	log.Debugf("Serving %d domains", len(domains.GetResponse()))
+
+	if len(provRequests) > 0 {
+		log.Debugf("RPC provisioning status updates: %v", provRequests)
+		driver.UpdateProvisioningStatus(a.rpc, provRequests)
+	}
+	return nil
+}
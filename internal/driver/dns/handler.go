@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"net"
+
+	"github.com/apex/log"
+	mdns "github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var queriesCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "andromeda_dns_queries_total",
+		Help: "Number of answered DNS queries",
+	},
+	[]string{"qtype", "rcode"},
+)
+
+func init() {
+	prometheus.MustRegister(queriesCounter)
+}
+
+// ServeDNS answers queries for the served domains authoritatively
+func (a *DNSAgent) ServeDNS(w mdns.ResponseWriter, req *mdns.Msg) {
+	m := new(mdns.Msg)
+	m.SetReply(req)
+	m.Authoritative = true
+
+	z := a.zone.Load()
+	switch {
+	case req.Opcode != mdns.OpcodeQuery:
+		m.SetRcode(req, mdns.RcodeNotImplemented)
+	case len(req.Question) != 1:
+		m.SetRcode(req, mdns.RcodeFormatError)
+	case z == nil:
+		m.SetRcode(req, mdns.RcodeServerFailure)
+	default:
+		q := req.Question[0]
+		apex, ok := z.apex(q.Name)
+		if !ok {
+			// not authoritative for names outside of the served domains
+			m.Authoritative = false
+			m.SetRcode(req, mdns.RcodeRefused)
+			break
+		}
+
+		if r, ok := z.lookup(q.Name); !ok {
+			m.SetRcode(req, mdns.RcodeNameError)
+		} else if q.Qtype == mdns.TypeSOA {
+			m.Answer = []mdns.RR{a.soa(apex, z.serial)}
+		} else {
+			country := a.geo.country(clientIP(w, req))
+			m.Answer = r.answer(q.Name, q.Qtype, country)
+		}
+		if len(m.Answer) == 0 {
+			// negative answers are cached by resolvers up to the minimum TTL of the SOA record
+			m.Ns = []mdns.RR{a.soa(apex, z.serial)}
+		}
+	}
+
+	qtype := ""
+	if len(req.Question) > 0 {
+		qtype = mdns.TypeToString[req.Question[0].Qtype]
+	}
+	queriesCounter.WithLabelValues(qtype, mdns.RcodeToString[m.Rcode]).Inc()
+
+	if err := w.WriteMsg(m); err != nil {
+		log.WithError(err).Warn("Failed writing DNS response")
+	}
+}
+
+// soa returns the SOA record of a served domain, which is the apex of its own zone
+func (a *DNSAgent) soa(apex string, serial uint32) *mdns.SOA {
+	return &mdns.SOA{
+		Hdr:     mdns.RR_Header{Name: apex, Rrtype: mdns.TypeSOA, Class: mdns.ClassINET, Ttl: a.config.TTL},
+		Ns:      mdns.Fqdn(a.config.Nameserver),
+		Mbox:    "hostmaster." + apex,
+		Serial:  serial,
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  a.config.TTL,
+	}
+}
+
+// clientIP returns the EDNS client subnet address if present, else the resolver address
+func clientIP(w mdns.ResponseWriter, req *mdns.Msg) net.IP {
+	if opt := req.IsEdns0(); opt != nil {
+		for _, option := range opt.Option {
+			if subnet, ok := option.(*mdns.EDNS0_SUBNET); ok {
+				return subnet.Address
+			}
+		}
+	}
+
+	switch addr := w.RemoteAddr().(type) {
+	case *net.UDPAddr:
+		return addr.IP
+	case *net.TCPAddr:
+		return addr.IP
+	default:
+		return nil
+	}
+}
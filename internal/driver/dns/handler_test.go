@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"net"
+	"testing"
+
+	mdns "github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+)
+
+// responseRecorder records the response written by the handler
+type responseRecorder struct {
+	mdns.ResponseWriter
+	msg *mdns.Msg
+}
+
+func (r *responseRecorder) RemoteAddr() net.Addr {
+	return &net.UDPAddr{IP: net.ParseIP("192.0.2.1"), Port: 53}
+}
+
+func (r *responseRecorder) WriteMsg(m *mdns.Msg) error {
+	r.msg = m
+	return nil
+}
+
+func TestServeDNS(t *testing.T) {
+	noMonitor := onlineMember("m1", "1.1.1.1", "dc1", 1)
+	noMonitor.Status = "NO_MONITOR"
+	a := &DNSAgent{config: config.DNSConfig{TTL: 30, Nameserver: "ns1.example.com"}}
+	domain := testDomain("ROUND_ROBIN", []*rpcmodels.Member{noMonitor})
+	domain.Aliases = []string{"alias.example.com"}
+	a.zone.Store(newZone([]*rpcmodels.Domain{domain}, nil))
+
+	query := func(name string, qtype uint16) *mdns.Msg {
+		req := new(mdns.Msg)
+		req.SetQuestion(name, qtype)
+		w := &responseRecorder{}
+		a.ServeDNS(w, req)
+		require.NotNil(t, w.msg)
+		return w.msg
+	}
+	assertSOA := func(rrs []mdns.RR) {
+		if assert.Len(t, rrs, 1) {
+			soa, ok := rrs[0].(*mdns.SOA)
+			if assert.True(t, ok) {
+				assert.Equal(t, "www.example.com.", soa.Hdr.Name)
+				assert.Equal(t, "ns1.example.com.", soa.Ns)
+				assert.Equal(t, "hostmaster.www.example.com.", soa.Mbox)
+			}
+		}
+	}
+
+	t.Run("Serves members without monitor", func(t *testing.T) {
+		m := query("www.example.com.", mdns.TypeA)
+		assert.Equal(t, mdns.RcodeSuccess, m.Rcode)
+		assert.Equal(t, []string{"1.1.1.1"}, answerAddresses(m.Answer))
+		assert.Empty(t, m.Ns)
+	})
+
+	t.Run("Answers NODATA with SOA", func(t *testing.T) {
+		m := query("www.example.com.", mdns.TypeAAAA)
+		assert.Equal(t, mdns.RcodeSuccess, m.Rcode)
+		assert.True(t, m.Authoritative)
+		assert.Empty(t, m.Answer)
+		assertSOA(m.Ns)
+	})
+
+	t.Run("Answers NXDOMAIN with SOA for subdomains", func(t *testing.T) {
+		m := query("foo.www.example.com.", mdns.TypeA)
+		assert.Equal(t, mdns.RcodeNameError, m.Rcode)
+		assert.True(t, m.Authoritative)
+		assertSOA(m.Ns)
+	})
+
+	t.Run("Answers SOA queries of the apex", func(t *testing.T) {
+		m := query("www.example.com.", mdns.TypeSOA)
+		assert.Equal(t, mdns.RcodeSuccess, m.Rcode)
+		assertSOA(m.Answer)
+	})
+
+	t.Run("Answers aliases like the FQDN", func(t *testing.T) {
+		m := query("alias.example.com.", mdns.TypeA)
+		assert.Equal(t, mdns.RcodeSuccess, m.Rcode)
+		assert.Equal(t, []string{"1.1.1.1"}, answerAddresses(m.Answer))
+		assert.Equal(t, "alias.example.com.", m.Answer[0].Header().Name)
+	})
+
+	t.Run("Refuses names outside of the served domains", func(t *testing.T) {
+		m := query("example.com.", mdns.TypeA)
+		assert.Equal(t, mdns.RcodeRefused, m.Rcode)
+		assert.False(t, m.Authoritative)
+		assert.Empty(t, m.Ns)
+	})
+}
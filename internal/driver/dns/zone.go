@@ -0,0 +1,314 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	mdns "github.com/miekg/dns"
+
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+	"github.com/sapcc/andromeda/models"
+)
+
+// record is the served representation of a single Andromeda domain
+type record struct {
+	domain *rpcmodels.Domain
+	geomap *rpcmodels.Geomap
+	// TTL of the answers and maximum number of addresses per answer, 0 hands out all addresses
+	ttl          uint32
+	handoutLimit int
+	// rotates the answers of ROUND_ROBIN and GEOGRAPHIC domains
+	counter atomic.Uint64
+}
+
+// zone is an immutable snapshot of all served domains, keyed by canonical FQDN and alias. Each domain and
+// alias is the apex of its own zone, the serial of their SOA records changes with every snapshot.
+type zone struct {
+	records map[string]*record
+	serial  uint32
+}
+
+func newZone(domains []*rpcmodels.Domain, geomaps []*rpcmodels.Geomap) *zone {
+	z := &zone{records: make(map[string]*record, len(domains)), serial: uint32(time.Now().Unix())}
+
+	// deterministic geomap choice
+	geomaps = slices.Clone(geomaps)
+	slices.SortFunc(geomaps, func(a, b *rpcmodels.Geomap) int {
+		return strings.Compare(a.GetId(), b.GetId())
+	})
+
+	aliases := make(map[string]*record)
+	for _, domain := range domains {
+		if !domain.GetAdminStateUp() ||
+			domain.GetProvisioningStatus() == models.DomainProvisioningStatusPENDINGDELETE {
+			continue
+		}
+		r := &record{domain: domain, ttl: domain.GetTtl(), handoutLimit: int(domain.GetHandoutLimit())}
+		if domain.GetMode() == models.DomainModeGEOGRAPHIC {
+			r.geomap = geomapForDomain(domain, geomaps)
+		}
+		z.records[mdns.CanonicalName(domain.GetFqdn())] = r
+		for _, alias := range domain.GetAliases() {
+			if _, ok := aliases[mdns.CanonicalName(alias)]; !ok {
+				aliases[mdns.CanonicalName(alias)] = r
+			}
+		}
+	}
+
+	// aliases resolve like the FQDN of their domain, the FQDN of another domain takes precedence
+	for alias, r := range aliases {
+		if _, ok := z.records[alias]; !ok {
+			z.records[alias] = r
+		}
+	}
+	return z
+}
+
+// geomapForDomain returns the first geomap referencing any datacenter of the domain's members,
+// since domains don't reference geomaps directly.
+func geomapForDomain(domain *rpcmodels.Domain, geomaps []*rpcmodels.Geomap) *rpcmodels.Geomap {
+	datacenters := make(map[string]bool)
+	for _, pool := range domain.GetPools() {
+		for _, member := range pool.GetMembers() {
+			datacenters[member.GetDatacenterId()] = true
+		}
+	}
+
+	for _, geomap := range geomaps {
+		if geomap.GetProvisioningStatus() == models.GeomapProvisioningStatusPENDINGDELETE {
+			continue
+		}
+		if datacenters[geomap.GetDefaultDatacenter()] {
+			return geomap
+		}
+		for _, assignment := range geomap.GetAssignment() {
+			if datacenters[assignment.GetDatacenter()] {
+				return geomap
+			}
+		}
+	}
+	return nil
+}
+
+func (z *zone) lookup(name string) (*record, bool) {
+	r, ok := z.records[mdns.CanonicalName(name)]
+	return r, ok
+}
+
+// apex returns the closest served domain the name equals or is a subdomain of
+func (z *zone) apex(name string) (string, bool) {
+	name = mdns.CanonicalName(name)
+	for off, end := 0, false; !end; off, end = mdns.NextLabel(name, off) {
+		if _, ok := z.records[name[off:]]; ok {
+			return name[off:], true
+		}
+	}
+	return "", false
+}
+
+// answer returns the resource records for the given query type, country is the
+// ISO 3166 country code of the client, if known.
+func (r *record) answer(name string, qtype uint16, country string) []mdns.RR {
+	hdr := func(rrtype uint16) mdns.RR_Header {
+		return mdns.RR_Header{Name: name, Rrtype: rrtype, Class: mdns.ClassINET, Ttl: r.ttl}
+	}
+
+	if r.domain.GetRecordType() == models.DomainRecordTypeCNAME {
+		switch qtype {
+		case mdns.TypeCNAME, mdns.TypeA, mdns.TypeAAAA, mdns.TypeANY:
+		default:
+			return nil
+		}
+		// only a single CNAME is allowed per owner name
+		members := r.selectMembers(hostnameMember, country)
+		if len(members) == 0 {
+			return nil
+		}
+		return []mdns.RR{&mdns.CNAME{Hdr: hdr(mdns.TypeCNAME), Target: mdns.Fqdn(members[0].GetAddress())}}
+	}
+
+	var rrs []mdns.RR
+	switch qtype {
+	case mdns.TypeA:
+		for _, member := range r.selectMembers(ipv4Member, country) {
+			rrs = append(rrs, &mdns.A{Hdr: hdr(mdns.TypeA), A: net.ParseIP(member.GetAddress())})
+		}
+	case mdns.TypeAAAA:
+		for _, member := range r.selectMembers(ipv6Member, country) {
+			rrs = append(rrs, &mdns.AAAA{Hdr: hdr(mdns.TypeAAAA), AAAA: net.ParseIP(member.GetAddress())})
+		}
+	}
+	if r.handoutLimit > 0 && len(rrs) > r.handoutLimit {
+		rrs = rrs[:r.handoutLimit]
+	}
+	return rrs
+}
+
+type memberFilter func(member *rpcmodels.Member) bool
+
+func ipv4Member(member *rpcmodels.Member) bool {
+	ip := net.ParseIP(member.GetAddress())
+	return ip != nil && ip.To4() != nil
+}
+
+func ipv6Member(member *rpcmodels.Member) bool {
+	ip := net.ParseIP(member.GetAddress())
+	return ip != nil && ip.To4() == nil
+}
+
+func hostnameMember(member *rpcmodels.Member) bool {
+	return net.ParseIP(member.GetAddress()) == nil
+}
+
+// candidates returns the members eligible for being handed out, grouped by pool in domain order.
+// Only enabled members with last health status ONLINE or without monitor are eligible.
+func (r *record) candidates(filter memberFilter) [][]*rpcmodels.Member {
+	var pools [][]*rpcmodels.Member
+	for _, pool := range r.domain.GetPools() {
+		if !pool.GetAdminStateUp() || pool.GetProvisioningStatus() == models.PoolProvisioningStatusPENDINGDELETE {
+			continue
+		}
+
+		var members []*rpcmodels.Member
+		for _, member := range pool.GetMembers() {
+			if !member.GetAdminStateUp() ||
+				member.GetProvisioningStatus() == models.MemberProvisioningStatusPENDINGDELETE ||
+				(member.GetStatus() != models.MemberStatusONLINE && member.GetStatus() != models.MemberStatusNOMONITOR) ||
+				!filter(member) {
+				continue
+			}
+			members = append(members, member)
+		}
+		if len(members) > 0 {
+			pools = append(pools, members)
+		}
+	}
+	return pools
+}
+
+// selectMembers picks the members to hand out according to the domain mode
+func (r *record) selectMembers(filter memberFilter, country string) []*rpcmodels.Member {
+	pools := r.candidates(filter)
+	if len(pools) == 0 {
+		return nil
+	}
+
+	switch r.domain.GetMode() {
+	case models.DomainModeAVAILABILITY:
+		// first available member of the first available pool
+		return pools[0][:1]
+	case models.DomainModeWEIGHTED:
+		if member := weightedPick(slices.Concat(pools...)); member != nil {
+			return []*rpcmodels.Member{member}
+		}
+		return nil
+	case models.DomainModeGEOGRAPHIC:
+		return r.rotate(r.geographicMembers(slices.Concat(pools...), country))
+	default:
+		return r.rotate(slices.Concat(pools...))
+	}
+}
+
+// geographicMembers returns the members of the datacenter the client's country is assigned to,
+// falling back to the geomap's default datacenter.
+func (r *record) geographicMembers(members []*rpcmodels.Member, country string) []*rpcmodels.Member {
+	if r.geomap == nil {
+		return members
+	}
+
+	datacenter := r.geomap.GetDefaultDatacenter()
+	for _, assignment := range r.geomap.GetAssignment() {
+		if slices.Contains(assignment.GetCountries(), country) {
+			datacenter = assignment.GetDatacenter()
+			break
+		}
+	}
+
+	for _, dc := range []string{datacenter, r.geomap.GetDefaultDatacenter()} {
+		var selected []*rpcmodels.Member
+		for _, member := range members {
+			if member.GetDatacenterId() == dc {
+				selected = append(selected, member)
+			}
+		}
+		if len(selected) > 0 {
+			return selected
+		}
+	}
+	return nil
+}
+
+func (r *record) rotate(members []*rpcmodels.Member) []*rpcmodels.Member {
+	if len(members) < 2 {
+		return members
+	}
+	offset := int(r.counter.Add(1) % uint64(len(members)))
+	return slices.Concat(members[offset:], members[:offset])
+}
+
+// weightedPick picks a random member with a probability proportional to its weight
+func weightedPick(members []*rpcmodels.Member) *rpcmodels.Member {
+	var total uint64
+	for _, member := range members {
+		total += uint64(member.GetWeight())
+	}
+	if total == 0 {
+		return nil
+	}
+
+	pick := rand.Uint64N(total)
+	for _, member := range members {
+		if pick < uint64(member.GetWeight()) {
+			return member
+		}
+		pick -= uint64(member.GetWeight())
+	}
+	return nil
+}
+
+type geoNetwork struct {
+	network *net.IPNet
+	country string
+}
+
+// geoLocator resolves client addresses to ISO 3166 country codes by longest prefix match
+type geoLocator []geoNetwork
+
+func newGeoLocator(networks map[string][]string) (geoLocator, error) {
+	var g geoLocator
+	for country, cidrs := range networks {
+		for _, cidr := range cidrs {
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid network %q for country %s: %w", cidr, country, err)
+			}
+			g = append(g, geoNetwork{network, strings.ToUpper(country)})
+		}
+	}
+
+	// most specific networks first
+	slices.SortFunc(g, func(a, b geoNetwork) int {
+		onesA, _ := a.network.Mask.Size()
+		onesB, _ := b.network.Mask.Size()
+		return onesB - onesA
+	})
+	return g, nil
+}
+
+func (g geoLocator) country(ip net.IP) string {
+	for _, n := range g {
+		if n.network.Contains(ip) {
+			return n.country
+		}
+	}
+	return ""
+}
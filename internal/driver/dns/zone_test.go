@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"net"
+	"testing"
+
+	mdns "github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+)
+
+func onlineMember(id, address, datacenter string, weight uint32) *rpcmodels.Member {
+	return &rpcmodels.Member{
+		Id:                 id,
+		AdminStateUp:       true,
+		Address:            address,
+		DatacenterId:       datacenter,
+		ProvisioningStatus: "ACTIVE",
+		Weight:             weight,
+		Status:             "ONLINE",
+	}
+}
+
+func testDomain(mode string, pools ...[]*rpcmodels.Member) *rpcmodels.Domain {
+	domain := &rpcmodels.Domain{
+		Id:                 "domain",
+		AdminStateUp:       true,
+		Fqdn:               "www.example.com",
+		Mode:               mode,
+		RecordType:         "A",
+		ProvisioningStatus: "ACTIVE",
+	}
+	for _, members := range pools {
+		domain.Pools = append(domain.Pools, &rpcmodels.Pool{
+			AdminStateUp:       true,
+			ProvisioningStatus: "ACTIVE",
+			Members:            members,
+		})
+	}
+	return domain
+}
+
+func answerAddresses(rrs []mdns.RR) []string {
+	var addresses []string
+	for _, rr := range rrs {
+		switch v := rr.(type) {
+		case *mdns.A:
+			addresses = append(addresses, v.A.String())
+		case *mdns.AAAA:
+			addresses = append(addresses, v.AAAA.String())
+		case *mdns.CNAME:
+			addresses = append(addresses, v.Target)
+		}
+	}
+	return addresses
+}
+
+func TestZone(t *testing.T) {
+	t.Run("Looks up names case-insensitively and skips disabled domains", func(t *testing.T) {
+		disabled := testDomain("ROUND_ROBIN")
+		disabled.Fqdn = "disabled.example.com"
+		disabled.AdminStateUp = false
+		z := newZone([]*rpcmodels.Domain{testDomain("ROUND_ROBIN"), disabled}, nil)
+
+		_, ok := z.lookup("WWW.Example.com.")
+		assert.True(t, ok)
+		_, ok = z.lookup("disabled.example.com.")
+		assert.False(t, ok)
+	})
+
+	t.Run("Resolves aliases like the FQDN of their domain", func(t *testing.T) {
+		domain := testDomain("ROUND_ROBIN")
+		domain.Aliases = []string{"alias.example.com", "other.example.com"}
+		other := testDomain("ROUND_ROBIN")
+		other.Fqdn = "other.example.com"
+		z := newZone([]*rpcmodels.Domain{domain, other}, nil)
+
+		r, ok := z.lookup("Alias.example.com.")
+		require.True(t, ok)
+		assert.Equal(t, domain, r.domain)
+		apex, ok := z.apex("foo.alias.example.com.")
+		require.True(t, ok)
+		assert.Equal(t, "alias.example.com.", apex)
+
+		// the FQDN of another domain takes precedence over an alias
+		r, ok = z.lookup("other.example.com.")
+		require.True(t, ok)
+		assert.Equal(t, other, r.domain)
+	})
+
+	t.Run("Rotates ROUND_ROBIN answers", func(t *testing.T) {
+		r := &record{domain: testDomain("ROUND_ROBIN", []*rpcmodels.Member{
+			onlineMember("m1", "1.1.1.1", "dc1", 1),
+			onlineMember("m2", "2.2.2.2", "dc1", 1),
+		})}
+
+		first := answerAddresses(r.answer("www.example.com.", mdns.TypeA, ""))
+		second := answerAddresses(r.answer("www.example.com.", mdns.TypeA, ""))
+		assert.ElementsMatch(t, []string{"1.1.1.1", "2.2.2.2"}, first)
+		assert.NotEqual(t, first[0], second[0])
+	})
+
+	t.Run("Only hands out enabled ONLINE members", func(t *testing.T) {
+		offline := onlineMember("m2", "2.2.2.2", "dc1", 1)
+		offline.Status = "OFFLINE"
+		disabled := onlineMember("m3", "3.3.3.3", "dc1", 1)
+		disabled.AdminStateUp = false
+		r := &record{domain: testDomain("ROUND_ROBIN", []*rpcmodels.Member{
+			onlineMember("m1", "1.1.1.1", "dc1", 1), offline, disabled,
+		})}
+
+		assert.Equal(t, []string{"1.1.1.1"}, answerAddresses(r.answer("www.example.com.", mdns.TypeA, "")))
+	})
+
+	t.Run("Answers AAAA queries with IPv6 members only", func(t *testing.T) {
+		r := &record{domain: testDomain("ROUND_ROBIN", []*rpcmodels.Member{
+			onlineMember("m1", "1.1.1.1", "dc1", 1),
+			onlineMember("m2", "2001:db8::1", "dc1", 1),
+		})}
+
+		assert.Equal(t, []string{"2001:db8::1"}, answerAddresses(r.answer("www.example.com.", mdns.TypeAAAA, "")))
+		assert.Equal(t, []string{"1.1.1.1"}, answerAddresses(r.answer("www.example.com.", mdns.TypeA, "")))
+	})
+
+	t.Run("Uses the first available pool in AVAILABILITY mode", func(t *testing.T) {
+		offline := onlineMember("m1", "1.1.1.1", "dc1", 1)
+		offline.Status = "OFFLINE"
+		r := &record{domain: testDomain("AVAILABILITY",
+			[]*rpcmodels.Member{offline},
+			[]*rpcmodels.Member{onlineMember("m2", "2.2.2.2", "dc2", 1), onlineMember("m3", "3.3.3.3", "dc2", 1)},
+		)}
+
+		assert.Equal(t, []string{"2.2.2.2"}, answerAddresses(r.answer("www.example.com.", mdns.TypeA, "")))
+	})
+
+	t.Run("Never hands out zero weight members in WEIGHTED mode", func(t *testing.T) {
+		r := &record{domain: testDomain("WEIGHTED", []*rpcmodels.Member{
+			onlineMember("m1", "1.1.1.1", "dc1", 0),
+			onlineMember("m2", "2.2.2.2", "dc1", 10),
+		})}
+
+		for range 20 {
+			assert.Equal(t, []string{"2.2.2.2"}, answerAddresses(r.answer("www.example.com.", mdns.TypeA, "")))
+		}
+	})
+
+	t.Run("Hands out members of the assigned datacenter in GEOGRAPHIC mode", func(t *testing.T) {
+		domain := testDomain("GEOGRAPHIC", []*rpcmodels.Member{
+			onlineMember("m1", "1.1.1.1", "dc-de", 1),
+			onlineMember("m2", "2.2.2.2", "dc-us", 1),
+		})
+		geomap := &rpcmodels.Geomap{
+			Id:                 "geomap",
+			DefaultDatacenter:  "dc-us",
+			ProvisioningStatus: "ACTIVE",
+			Assignment: []*rpcmodels.GeomapAssignment{
+				{Datacenter: "dc-de", Countries: []string{"DE", "AT"}},
+			},
+		}
+		r, ok := newZone([]*rpcmodels.Domain{domain}, []*rpcmodels.Geomap{geomap}).lookup("www.example.com")
+		require.True(t, ok)
+		require.Equal(t, geomap, r.geomap)
+
+		assert.Equal(t, []string{"1.1.1.1"}, answerAddresses(r.answer("www.example.com.", mdns.TypeA, "AT")))
+		assert.Equal(t, []string{"2.2.2.2"}, answerAddresses(r.answer("www.example.com.", mdns.TypeA, "FR")))
+		assert.Equal(t, []string{"2.2.2.2"}, answerAddresses(r.answer("www.example.com.", mdns.TypeA, "")))
+	})
+
+	t.Run("Hands out the domain TTL and at most handout limit addresses", func(t *testing.T) {
+		domain := testDomain("ROUND_ROBIN", []*rpcmodels.Member{
+			onlineMember("m1", "1.1.1.1", "dc1", 1),
+			onlineMember("m2", "2.2.2.2", "dc1", 1),
+			onlineMember("m3", "3.3.3.3", "dc1", 1),
+		})
+		domain.Ttl = 300
+		domain.HandoutLimit = 2
+		r, ok := newZone([]*rpcmodels.Domain{domain}, nil).lookup("www.example.com")
+		require.True(t, ok)
+
+		rrs := r.answer("www.example.com.", mdns.TypeA, "")
+		require.Len(t, rrs, 2)
+		assert.Equal(t, uint32(300), rrs[0].Header().Ttl)
+
+		// all addresses are handed out without limit
+		r.handoutLimit = 0
+		assert.Len(t, r.answer("www.example.com.", mdns.TypeA, ""), 3)
+	})
+
+	t.Run("Answers CNAME domains with a single CNAME record", func(t *testing.T) {
+		domain := testDomain("ROUND_ROBIN", []*rpcmodels.Member{
+			onlineMember("m1", "a.example.org", "dc1", 1),
+			onlineMember("m2", "b.example.org", "dc1", 1),
+		})
+		domain.RecordType = "CNAME"
+		r := &record{domain: domain}
+
+		rrs := r.answer("www.example.com.", mdns.TypeA, "")
+		require.Len(t, rrs, 1)
+		assert.Equal(t, mdns.TypeCNAME, rrs[0].Header().Rrtype)
+		assert.Empty(t, r.answer("www.example.com.", mdns.TypeMX, ""))
+	})
+}
+
+func TestGeoLocator(t *testing.T) {
+	g, err := newGeoLocator(map[string][]string{
+		"de": {"10.0.0.0/8"},
+		"at": {"10.1.0.0/16"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "AT", g.country(net.ParseIP("10.1.2.3")))
+	assert.Equal(t, "DE", g.country(net.ParseIP("10.2.2.3")))
+	assert.Empty(t, g.country(net.ParseIP("192.168.0.1")))
+	assert.Empty(t, g.country(nil))
+
+	_, err = newGeoLocator(map[string][]string{"de": {"invalid"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driver
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/nats-io/nats.go"
+)
+
+// ChangeEventSubject is published by Andromeda Server whenever an object enters a PENDING_* state.
+// In contrast to RPC subjects, all subscribed agents receive the event.
+const ChangeEventSubject = "andromeda.changes"
+
+// changeEventMaxDelayFactor limits how long a continuous stream of events defers the sync,
+// relative to the debounce interval
+const changeEventMaxDelayFactor = 10
+
+// ChangeEvent announces a pending change of an Andromeda object
+type ChangeEvent struct {
+	// Model is one of DOMAIN, POOL, MEMBER, MONITOR, DATACENTER or GEOMAP
+	Model string `json:"model"`
+	ID    string `json:"id"`
+	// Provider is empty for objects not bound to a provider, e.g. pools
+	Provider string `json:"provider,omitempty"`
+	Status   string `json:"status"`
+}
+
+func PublishChange(nc *nats.Conn, event ChangeEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return nc.Publish(ChangeEventSubject, data)
+}
+
+// SubscribeChanges returns a channel signalling change events relevant to the provider, once no
+// further events arrived within the debounce interval.
+func SubscribeChanges(nc *nats.Conn, provider string, debounce time.Duration) (<-chan struct{}, error) {
+	msgs := make(chan *nats.Msg, 64)
+	if _, err := nc.ChanSubscribe(ChangeEventSubject, msgs); err != nil {
+		return nil, err
+	}
+	events := make(chan ChangeEvent)
+	go func() {
+		for msg := range msgs {
+			var event ChangeEvent
+			if err := json.Unmarshal(msg.Data, &event); err != nil {
+				log.WithError(err).Warn("Ignoring malformed change event")
+				continue
+			}
+			if event.Provider != "" && event.Provider != provider {
+				continue
+			}
+			log.WithField("event", event).Debug("Received change event")
+			events <- event
+		}
+	}()
+	return debounceChanges(events, debounce, changeEventMaxDelayFactor*debounce), nil
+}
+
+// debounceChanges coalesces events into a single signal, sent once no event arrived for the quiet
+// period, but at the latest maxDelay after the first event.
+func debounceChanges(events <-chan ChangeEvent, quiet, maxDelay time.Duration) <-chan struct{} {
+	signal := make(chan struct{}, 1)
+	go func() {
+		var timer <-chan time.Time
+		var deadline time.Time
+		for {
+			select {
+			case <-events:
+				if timer == nil {
+					deadline = time.Now().Add(maxDelay)
+				}
+				timer = time.After(min(quiet, time.Until(deadline)))
+			case <-timer:
+				timer = nil
+				// coalesces with a signal not yet consumed
+				select {
+				case signal <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return signal
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebounceChanges(t *testing.T) {
+	t.Run("Signals once after a burst of events", func(t *testing.T) {
+		events := make(chan ChangeEvent)
+		signal := debounceChanges(events, 50*time.Millisecond, time.Second)
+		for range 5 {
+			events <- ChangeEvent{Model: "DOMAIN"}
+		}
+
+		select {
+		case <-signal:
+		case <-time.After(time.Second):
+			t.Fatal("no signal after quiet period")
+		}
+		select {
+		case <-signal:
+			t.Fatal("burst signalled more than once")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("Signals after max delay on continuous events", func(t *testing.T) {
+		events := make(chan ChangeEvent)
+		signal := debounceChanges(events, 50*time.Millisecond, 200*time.Millisecond)
+		start := time.Now()
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				events <- ChangeEvent{Model: "MEMBER"}
+				continue
+			case <-signal:
+			}
+			break
+		}
+		assert.Less(t, time.Since(start), 400*time.Millisecond)
+	})
+}
@@ -6,9 +6,13 @@ package f5
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
+	"slices"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -18,39 +22,69 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
 	"github.com/sapcc/andromeda/internal/rpc"
 	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/rpcmodels"
 	"github.com/sapcc/andromeda/internal/utils"
 )
 
 type syncFunc func(f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient) error
-type instrumentedSyncFunc func(f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient)
+type instrumentedSyncFunc func(f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient) error
 
-func syncWorker(syncInterval time.Duration, f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient, syncFn instrumentedSyncFunc) {
-	syncFn(f5Config, session, rpc)
+// rpcHandlerFunc handles agent specific RPC requests, the result is sent back as response payload
+type rpcHandlerFunc func(f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient, req stormrpc.Request) (any, error)
+
+// declarationLock serializes periodic and requested declaration syncs, AS3 processes one declaration at a time
+var declarationLock sync.Mutex
+
+// syncWorker syncs periodically and on change events while leading, changes and election may be nil
+func syncWorker(syncInterval time.Duration, f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient,
+	syncFn instrumentedSyncFunc, changes <-chan struct{}, election *driver.LeaderElection) {
 	c := time.Tick(syncInterval)
 	for {
-		<-c
-		syncFn(f5Config, session, rpc)
+		if election == nil || election.IsLeader() {
+			_ = syncFn(f5Config, session, rpc)
+		} else {
+			log.Debugf("Skipping sync, following leader %q", election.Leader())
+		}
+		select {
+		case <-c:
+		case <-changes:
+			log.Info("Syncing pending changes")
+		case <-election.Elected():
+			log.Info("Syncing after becoming leader")
+		}
 	}
 }
 
-func newInstrumentedSyncFunc(agentName string, syncInterval time.Duration, syncFn syncFunc) instrumentedSyncFunc {
-	return func(f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient) {
+func newInstrumentedSyncFunc(agentName string, syncInterval time.Duration, syncFn syncFunc,
+	heartbeat *driver.Heartbeat) instrumentedSyncFunc {
+	return func(f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient) error {
+		if heartbeat.Disabled() {
+			log.Debug("Skipping sync, agent disabled")
+			return driver.ErrAgentDisabled
+		}
 		syncStart := time.Now()
 		err := syncFn(f5Config, session, rpc)
 		elapsed := time.Since(syncStart)
 		if err != nil {
 			log.Errorf("Sync failed after %s (next iteration in %s): %s", elapsed, syncInterval, err.Error())
-			return
+			return err
 		}
 		log.Infof("Sync completed in %s (next iteration in %s)", elapsed, syncInterval)
 		lastSyncTimestampGauge.WithLabelValues(agentName).Set(float64(time.Now().Unix()))
 		lastSyncDurationSecondsGauge.WithLabelValues(agentName).Set(elapsed.Seconds())
+		heartbeat.Synced()
+		return nil
 	}
 }
 
-func ExecuteF5Agent(agentName string, syncInterval time.Duration, syncFn syncFunc) error {
+// ExecuteF5Agent runs syncFn periodically, agents syncing pending changes additionally run it on change events.
+// With leaderElection, only the elected replica syncs and handles requests, others forward requests to it.
+// The capabilities are announced to the agent registry.
+func ExecuteF5Agent(agentName string, syncInterval time.Duration, syncFn syncFunc, handlers map[string]rpcHandlerFunc,
+	syncOnChange, leaderElection bool, capabilities ...string) error {
 	log.Debugf("Enabled=%+v Devices=%v VCMPs=%v PhysicalNetwork=%v",
 		config.Global.F5Config.Enabled,
 		config.Global.F5Config.Devices,
@@ -77,31 +111,70 @@ func ExecuteF5Agent(agentName string, syncInterval time.Duration, syncFn syncFun
 
 	// Create F5 worker instance with Server RPC interface
 	rpcClient := server.NewRPCServerClient(client)
-	srv := rpc.NewServer(fmt.Sprintf("andromeda-%s-agent", agentName), stormrpc.WithNatsConn(nc))
+	serviceName := fmt.Sprintf("andromeda-%s-agent", agentName)
+	srv := rpc.NewServer(serviceName, stormrpc.WithNatsConn(nc))
 
-	instrumentedSyncFunc := newInstrumentedSyncFunc(agentName, syncInterval, syncFn)
+	heartbeat := driver.NewHeartbeat(rpcClient, serviceName, []string{"f5"}, capabilities)
+	instrumentedSyncFunc := newInstrumentedSyncFunc(agentName, syncInterval, syncFn, heartbeat)
 
-	// Allows the sync to be invoked over RPC via an HTTP handler in Andromeda Server
-	// see `m31ctl sync`
-	// TODO it doesn't work; the first handler to receive the message
-	// runs the sync, while the other will not receive any event.
-	srv.Handle("andromeda.sync", func(ctx context.Context, req stormrpc.Request) stormrpc.Response {
-		log.WithField("request", req).Info("[pubsub.1] Received event")
-		instrumentedSyncFunc(config.Global.F5Config, activeF5Session, rpcClient)
-		resp, err := stormrpc.NewResponse(req.Reply, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	go heartbeat.Run(ctx, time.Duration(config.Global.Default.HeartbeatInterval)*time.Second)
 
-		// TODO if setting resp.Err works, then instrumentedSyncFunc
-		// should return an error and make this possible.
-		// Update: if set to an error, it will cause the Andromeda Server to panic
-		// resp.Err = syncErr
+	var election *driver.LeaderElection
+	handle := srv.Handle
+	if leaderElection {
+		leaseDuration := time.Duration(config.Global.Default.LeaderLeaseDuration) * time.Second
+		election = driver.NewLeaderElection(rpcClient, agentName, leaseDuration)
+		handle = func(subject string, fn stormrpc.HandlerFunc) {
+			election.Handle(srv, nc, subject, fn)
+		}
+		electionDone := make(chan struct{})
+		go func() {
+			election.Run(ctx)
+			close(electionDone)
+		}()
+		defer func() { <-electionDone }()
+	}
+	defer cancel()
 
+	// Runs a full sync on pending changes, note that only one of all agents receives the message.
+	// Andromeda Server requests syncs via "andromeda.sync.f5" instead, see `m31ctl sync`
+	handle("andromeda.sync", func(ctx context.Context, req stormrpc.Request) stormrpc.Response {
+		log.WithField("request", req).Info("[pubsub.1] Received event")
+		if err := instrumentedSyncFunc(config.Global.F5Config, activeF5Session, rpcClient); err != nil {
+			return stormrpc.NewErrorResponse(req.Reply, err)
+		}
+		resp, err := stormrpc.NewResponse(req.Reply, nil)
 		if err != nil {
 			return stormrpc.NewErrorResponse(req.Reply, err)
 		}
 		return resp
 	})
+	for subject, handlerFn := range handlers {
+		handle(subject, func(ctx context.Context, req stormrpc.Request) stormrpc.Response {
+			log.WithField("subject", subject).Info("Received request")
+			if heartbeat.Disabled() {
+				return stormrpc.NewErrorResponse(req.Reply, driver.ErrAgentDisabled)
+			}
+			result, err := handlerFn(config.Global.F5Config, activeF5Session, rpcClient, req)
+			if err != nil {
+				return stormrpc.NewErrorResponse(req.Reply, err)
+			}
+			resp, err := stormrpc.NewResponse(req.Reply, result)
+			if err != nil {
+				return stormrpc.NewErrorResponse(req.Reply, err)
+			}
+			return resp
+		})
+	}
 
-	go syncWorker(syncInterval, config.Global.F5Config, activeF5Session, rpcClient, instrumentedSyncFunc)
+	var changes <-chan struct{}
+	if debounce := config.Global.Default.ChangeEventDebounce; syncOnChange && debounce > 0 {
+		if changes, err = driver.SubscribeChanges(nc, "f5", time.Duration(debounce)*time.Second); err != nil {
+			return err
+		}
+	}
+	go syncWorker(syncInterval, config.Global.F5Config, activeF5Session, rpcClient, instrumentedSyncFunc, changes, election)
 	go func() {
 		_ = srv.Run()
 	}()
@@ -115,41 +188,151 @@ func ExecuteF5Agent(agentName string, syncInterval time.Duration, syncFn syncFun
 	signal.Notify(done, syscall.SIGINT, syscall.SIGTERM)
 	<-done
 	log.Infof("💀 Shutting down")
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	return srv.Shutdown(ctx)
+	// releases the lease, so that another replica takes over without waiting for it to expire
+	cancel()
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	return srv.Shutdown(shutdownCtx)
 }
 
 func ExecuteF5DeclarationAgent() error {
-	return ExecuteF5Agent("f5-declaration", 5*time.Minute, declarationSync)
+	// Allows syncing specific domains and previewing the declaration via HTTP handlers in Andromeda Server,
+	// see `m31ctl sync` and `m31ctl f5 diff`
+	syncInterval := time.Duration(config.Global.F5Config.SyncInterval) * time.Second
+	return ExecuteF5Agent("f5-declaration", syncInterval, declarationSync, map[string]rpcHandlerFunc{
+		"andromeda.sync.f5": declarationSyncRequest,
+		"andromeda.f5.diff": declarationDryRun,
+	}, true, true, driver.CapabilitySync, driver.CapabilityDiff)
 }
 
 func ExecuteF5StatusAgent() error {
-	return ExecuteF5Agent("f5-status", 5*time.Minute, statusSync)
+	statusInterval := time.Duration(config.Global.F5Config.StatusInterval) * time.Second
+	return ExecuteF5Agent("f5-status", statusInterval, statusSync, nil, false, false, driver.CapabilityMemberStatus)
 }
 
 func ExecuteF5MetricsAgent() error {
 	prometheus.MustRegister(virtualServerPicksCounter)
-	return ExecuteF5Agent("f5-metrics", 5*time.Minute, metricsSync)
+	return ExecuteF5Agent("f5-metrics", 5*time.Minute, metricsSync, nil, false, false, driver.CapabilityMetrics)
 }
 
 func declarationSync(f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient) error {
-	decl, rpcRequest, err := buildAS3Declaration(f5Config, NewAndromedaF5Store(rpc), buildAS3CommonTenant, buildAS3DomainTenant)
+	return declarationSyncDomains(f5Config, session, rpc, nil)
+}
+
+// declarationSyncRequest syncs the domains requested over RPC
+func declarationSyncRequest(f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient, req stormrpc.Request) (any, error) {
+	var domainIDs []string
+	if err := req.Decode(&domainIDs); err != nil {
+		return nil, err
+	}
+	log.WithField("domainIDs", domainIDs).Info("Syncing domains")
+	return nil, declarationSyncDomains(f5Config, session, rpc, domainIDs)
+}
+
+// declarationSyncDomains rebuilds the /Common tenant and the tenants of the given domains, all other
+// tenants are left untouched by AS3 tenant filtering. All domains are synced if none are given.
+func declarationSyncDomains(f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient, domainIDs []string) error {
+	declarationLock.Lock()
+	defer declarationLock.Unlock()
+
+	store := NewAndromedaF5Store(rpc)
+	ctbFunc, dtbFunc, tenantFilter := buildAS3CommonTenant, buildAS3DomainTenant, ""
+	if len(domainIDs) > 0 {
+		if err := checkF5Domains(store, domainIDs); err != nil {
+			return err
+		}
+		ctbFunc, dtbFunc = selectedCommonTenantBuilder(domainIDs), selectedDomainTenantBuilder(domainIDs, buildAS3DomainTenant)
+		tenantFilter = as3TenantFilter(domainIDs)
+	}
+	decl, rpcRequest, err := buildAS3Declaration(f5Config, store, ctbFunc, dtbFunc)
 	if err != nil {
 		return err
 	}
 	log.Debugf("RPC provisioning status updates: %v", rpcRequest.ProvisioningStatus)
-	if err := postAS3Declaration(decl, session, sanityCheckAS3Declaration); err != nil {
+	if err := postAS3Declaration(decl, session, sanityCheckAS3Declaration, tenantFilter); err != nil {
+		// transient failures leave the entities pending for the next sync
+		var rejection *as3RejectionError
+		if errors.As(err, &rejection) {
+			reportDeclarationError(store, rpc, rpcRequest, domainIDs, err)
+		}
 		return err
 	}
 	log.Debugf("Posted AS3 declaration successfully")
-	if _, err := rpc.UpdateProvisioningStatus(context.Background(), rpcRequest); err != nil {
+	res, err := rpc.UpdateProvisioningStatus(context.Background(), rpcRequest)
+	if err != nil {
 		return err
 	}
+	driver.LogRejectedStatusResults(res.GetProvisioningStatusResult())
 	log.Debugf("Posted RPC provisioning status updates successfully")
 	return nil
 }
 
+// checkF5Domains fails if any of the given domains is unknown or not provisioned by F5. Their tenants would
+// be deleted by AS3 tenant filtering otherwise.
+func checkF5Domains(s AndromedaF5Store, domainIDs []string) error {
+	domains, err := s.GetDomains()
+	if err != nil {
+		return err
+	}
+	for _, domainID := range domainIDs {
+		if !slices.ContainsFunc(domains, func(d *rpcmodels.Domain) bool { return d.Id == domainID }) {
+			return fmt.Errorf("domain %s not found for provider f5", domainID)
+		}
+	}
+	return nil
+}
+
+// reportDeclarationError sets the pending entities of a rejected declaration to ERROR with the cause. Active
+// entities keep their status, the device still serves their last successful declaration. For a targeted sync,
+// only the entities of the given domains and the geomaps are reported.
+func reportDeclarationError(s AndromedaF5Store, rpc server.RPCServerClient, rpcRequest *server.ProvisioningStatusRequest,
+	domainIDs []string, cause error) {
+	domains, err := s.GetDomains()
+	if err != nil {
+		log.WithError(err).Error("Failed reporting declaration error")
+		return
+	}
+	geomaps, err := s.GetGeomaps()
+	if err != nil {
+		log.WithError(err).Error("Failed reporting declaration error")
+		return
+	}
+
+	pending := map[string]bool{}
+	isPending := func(status string) bool {
+		return strings.HasPrefix(status, "PENDING_")
+	}
+	for _, domain := range domains {
+		if len(domainIDs) > 0 && !slices.Contains(domainIDs, domain.Id) {
+			continue
+		}
+		pending[domain.Id] = isPending(domain.ProvisioningStatus)
+		for _, pool := range domain.Pools {
+			pending[pool.Id] = isPending(pool.ProvisioningStatus)
+			for _, member := range pool.Members {
+				pending[member.Id] = isPending(member.ProvisioningStatus)
+			}
+			for _, monitor := range pool.Monitors {
+				pending[monitor.Id] = isPending(monitor.ProvisioningStatus)
+			}
+		}
+	}
+	for _, geomap := range geomaps {
+		pending[geomap.Id] = isPending(geomap.ProvisioningStatus)
+	}
+
+	var errorRequests []*server.ProvisioningStatusRequest_ProvisioningStatus
+	for _, update := range rpcRequest.GetProvisioningStatus() {
+		if pending[update.GetId()] {
+			errorRequests = append(errorRequests,
+				driver.GetProvisioningErrorRequest(update.GetId(), update.GetModel().String(), cause))
+		}
+	}
+	if len(errorRequests) > 0 {
+		driver.UpdateProvisioningStatus(rpc, errorRequests)
+	}
+}
+
 func statusSync(f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient) error {
 	req, err := buildMemberStatusUpdateRequest(session, NewAndromedaF5Store(rpc))
 	if err != nil {
@@ -6,6 +6,7 @@ package f5
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/f5devcentral/go-bigip"
@@ -49,6 +50,7 @@ func TestDeclarationSync(t *testing.T) {
 				{Id: "member1-uuid", Address: "200.10.0.1", Port: 80, DatacenterId: "dc1-uuid"},
 			},
 		}, nil)
+		rpc.On("GetGeomaps", mock.Anything, mock.Anything, mock.Anything).Return(&server.GeomapsResponse{}, nil)
 
 		t.Run("... if it cannot post the AS3 declaration request", func(t *testing.T) {
 			session := new(mockedBigIPSession)
@@ -103,6 +105,7 @@ func TestDeclarationSync(t *testing.T) {
 				{Id: "member2-uuid", Address: "200.10.0.2", Port: 80, DatacenterId: "dc2-uuid"},
 			},
 		}, nil)
+		rpc.On("GetGeomaps", mock.Anything, mock.Anything, mock.Anything).Return(&server.GeomapsResponse{}, nil)
 		expectedDomainsSearchRequest := &server.SearchRequest{Provider: "f5", ResultPerPage: 1000, FullyPopulated: true}
 		rpc.On("GetDomains", mock.Anything, expectedDomainsSearchRequest, mock.Anything).Return(&server.DomainsResponse{
 			Response: []*rpcmodels.Domain{
@@ -151,6 +154,137 @@ func TestDeclarationSync(t *testing.T) {
 	})
 }
 
+func TestDeclarationSyncError(t *testing.T) {
+	newRPC := func() *mockedRPCClient {
+		rpc := new(mockedRPCClient)
+		rpc.On("GetDatacenters", mock.Anything, mock.Anything, mock.Anything).Return(&server.DatacentersResponse{
+			Response: []*rpcmodels.Datacenter{{Id: "dc1-uuid", Name: "dc1"}},
+		}, nil)
+		member := &rpcmodels.Member{Id: "member1-uuid", Address: "200.10.0.1", Port: 80, DatacenterId: "dc1-uuid",
+			PoolId: "pool1-uuid", ProvisioningStatus: "PENDING_CREATE"}
+		rpc.On("GetMembers", mock.Anything, mock.Anything, mock.Anything).Return(&server.MembersResponse{
+			Response: []*rpcmodels.Member{member},
+		}, nil)
+		rpc.On("GetGeomaps", mock.Anything, mock.Anything, mock.Anything).Return(&server.GeomapsResponse{}, nil)
+		rpc.On("GetDomains", mock.Anything, mock.Anything, mock.Anything).Return(&server.DomainsResponse{
+			Response: []*rpcmodels.Domain{
+				{
+					Id: "dom1-uuid", Fqdn: "one", RecordType: "A", ProvisioningStatus: "PENDING_UPDATE",
+					Pools: []*rpcmodels.Pool{{Id: "pool1-uuid", ProvisioningStatus: "ACTIVE", Members: []*rpcmodels.Member{member}}},
+				},
+				{Id: "dom2-uuid", Fqdn: "two", RecordType: "A", ProvisioningStatus: "ACTIVE"},
+				{Id: "dom3-uuid", Fqdn: "three", RecordType: "A", ProvisioningStatus: "PENDING_UPDATE"},
+			},
+		}, nil)
+		rpc.On("UpdateProvisioningStatus", mock.Anything, mock.Anything, mock.Anything).Return(&server.ProvisioningStatusResponse{}, nil)
+		return rpc
+	}
+	newSession := func(err error) *mockedBigIPSession {
+		session := new(mockedBigIPSession)
+		session.On("PostAs3Bigip", mock.Anything, mock.Anything, mock.Anything).Return(err, "", "")
+		return session
+	}
+	rejection := errors.New("Error :[map[dataPath:/domain_dom1-uuid message:should NOT have additional properties]]")
+
+	t.Run("Rejected declarations set the pending entities to ERROR", func(t *testing.T) {
+		rpc := newRPC()
+		err := declarationSync(config.F5Config{}, newSession(rejection), rpc)
+		assert.ErrorContains(t, err, "should NOT have additional properties")
+
+		// only pending entities are set to ERROR, the active pool and domain are still served
+		rpc.AssertCalled(t, "UpdateProvisioningStatus", mock.Anything, &server.ProvisioningStatusRequest{
+			ProvisioningStatus: []*server.ProvisioningStatusRequest_ProvisioningStatus{
+				{Id: "member1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ERROR, Error: err.Error()},
+				{Id: "dom1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ERROR, Error: err.Error()},
+				{Id: "dom3-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ERROR, Error: err.Error()},
+			},
+		}, mock.Anything)
+	})
+
+	t.Run("Rejected targeted syncs set the pending entities of the selected domains to ERROR", func(t *testing.T) {
+		rpc := newRPC()
+		err := declarationSyncDomains(config.F5Config{}, newSession(rejection), rpc, []string{"dom3-uuid"})
+		assert.Error(t, err)
+		rpc.AssertCalled(t, "UpdateProvisioningStatus", mock.Anything, &server.ProvisioningStatusRequest{
+			ProvisioningStatus: []*server.ProvisioningStatusRequest_ProvisioningStatus{
+				{Id: "dom3-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ERROR, Error: err.Error()},
+			},
+		}, mock.Anything)
+	})
+
+	t.Run("Transient failures leave the entities pending", func(t *testing.T) {
+		for _, cause := range []error{
+			errors.New("context deadline exceeded"),
+			errors.New(`Tenant Creation failed. Response: [{"code": 503, "message": "Configuration operation in progress on device"}]`),
+		} {
+			rpc := newRPC()
+			err := declarationSync(config.F5Config{}, newSession(cause), rpc)
+			assert.ErrorIs(t, err, cause)
+			rpc.AssertNotCalled(t, "UpdateProvisioningStatus", mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+}
+
+func TestDeclarationSyncDomains(t *testing.T) {
+	assert := assert.New(t)
+
+	rpc := new(mockedRPCClient)
+	rpc.On("GetDatacenters", mock.Anything, mock.Anything, mock.Anything).Return(&server.DatacentersResponse{
+		Response: []*rpcmodels.Datacenter{{Id: "dc1-uuid", Name: "dc1"}},
+	}, nil)
+	member1 := &rpcmodels.Member{Id: "member1-uuid", Address: "200.10.0.1", Port: 80, DatacenterId: "dc1-uuid",
+		PoolId: "pool1-uuid", ProvisioningStatus: "PENDING_CREATE"}
+	member2 := &rpcmodels.Member{Id: "member2-uuid", Address: "200.10.0.2", Port: 80, DatacenterId: "dc1-uuid",
+		PoolId: "pool2-uuid", ProvisioningStatus: "PENDING_CREATE"}
+	member3 := &rpcmodels.Member{Id: "member3-uuid", Address: "200.10.0.3", Port: 80, DatacenterId: "dc1-uuid",
+		PoolId: "pool2-uuid", ProvisioningStatus: "PENDING_DELETE"}
+	rpc.On("GetMembers", mock.Anything, mock.Anything, mock.Anything).Return(&server.MembersResponse{
+		Response: []*rpcmodels.Member{member1, member2, member3},
+	}, nil)
+	rpc.On("GetGeomaps", mock.Anything, mock.Anything, mock.Anything).Return(&server.GeomapsResponse{}, nil)
+	rpc.On("GetDomains", mock.Anything, mock.Anything, mock.Anything).Return(&server.DomainsResponse{
+		Response: []*rpcmodels.Domain{
+			{Id: "dom1-uuid", Fqdn: "one", RecordType: "A", Pools: []*rpcmodels.Pool{
+				{Id: "pool1-uuid", ProvisioningStatus: "ACTIVE", Members: []*rpcmodels.Member{member1}},
+			}},
+			{Id: "dom2-uuid", Fqdn: "two", RecordType: "A", Pools: []*rpcmodels.Pool{
+				{Id: "pool2-uuid", ProvisioningStatus: "ACTIVE", Members: []*rpcmodels.Member{member2, member3}},
+			}},
+			{Id: "dom3-uuid", Fqdn: "three", ProvisioningStatus: server.ProvisioningStatusRequest_ProvisioningStatus_PENDING_DELETE.String()},
+		},
+	}, nil)
+	rpc.On("UpdateProvisioningStatus", mock.Anything, mock.Anything, mock.Anything).Return(&server.ProvisioningStatusResponse{}, nil)
+	session := new(mockedBigIPSession)
+	session.On("PostAs3Bigip", mock.Anything, mock.Anything, mock.Anything).Return(nil, "", "")
+
+	err := declarationSyncDomains(config.F5Config{}, session, rpc, []string{"dom1-uuid", "dom3-uuid"})
+	assert.NoError(err)
+
+	// only the selected tenants are filtered, dom3 is deleted by AS3 as it is filtered but not declared.
+	// the server of member3 is still referenced by the untouched tenant of dom2 and stays declared.
+	session.AssertCalled(t, "PostAs3Bigip", mock.MatchedBy(func(decl string) bool {
+		return strings.Contains(decl, `"domain_dom1-uuid"`) &&
+			!strings.Contains(decl, `"domain_dom2-uuid"`) &&
+			!strings.Contains(decl, `"domain_dom3-uuid"`) &&
+			strings.Contains(decl, `"200.10.0.3"`)
+	}), "Common,domain_dom1-uuid,domain_dom3-uuid", "")
+	// members of pools of dom2 are neither added to nor removed from its pool, they are left pending
+	rpc.AssertCalled(t, "UpdateProvisioningStatus", mock.Anything, &server.ProvisioningStatusRequest{
+		ProvisioningStatus: []*server.ProvisioningStatusRequest_ProvisioningStatus{
+			{Id: "member1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+			{Id: "pool1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_POOL, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+			{Id: "dom1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+			{Id: "dom3-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN, Status: server.ProvisioningStatusRequest_ProvisioningStatus_DELETED},
+		},
+	}, mock.Anything)
+
+	// unknown domains would be deleted by AS3 tenant filtering, the sync is refused instead
+	unknownSession := new(mockedBigIPSession)
+	err = declarationSyncDomains(config.F5Config{}, unknownSession, rpc, []string{"dom1-uuid", "dom4-uuid"})
+	assert.ErrorContains(err, "domain dom4-uuid not found for provider f5")
+	unknownSession.AssertNotCalled(t, "PostAs3Bigip", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestStatusSync(t *testing.T) {
 	assert := assert.New(t)
 
@@ -164,6 +164,7 @@ type GSLBPool struct {
 	Members            []GSLBPoolMember     `json:"members,omitempty"`
 	Monitors           []PointerGSLBMonitor `json:"monitors,omitempty"`
 	TTL                int                  `json:"ttl,omitempty"`
+	MaxAnswersReturned int                  `json:"maxAnswersReturned,omitempty"`
 	LBModePreferred    string               `json:"lbModePreferred,omitempty"`
 	LBModeAlternate    string               `json:"lbModeAlternate,omitempty"`
 	LBModeFallback     string               `json:"lbModeFallback,omitempty"`
@@ -173,10 +174,11 @@ type GSLBPoolMember struct {
 	Label         string            `json:"label,omitempty"`
 	Remark        string            `json:"remark,omitempty"`
 	DependsOn     string            `json:"depends_on,omitempty"`
-	Ratio         int               `json:"ratio,omitempty"`
-	Server        PointerGSLBServer `json:"server,omitempty"`
+	Ratio         *int              `json:"ratio,omitempty"`
+	Server        PointerGSLBServer `json:"server,omitzero"`
 	VirtualServer string            `json:"virtualServer,omitempty"`
 	DomainName    string            `json:"domainName,omitempty"`
+	Priority      *int              `json:"priority,omitempty"`
 }
 
 type GSLBPoolMemberA GSLBPoolMember
@@ -229,6 +231,34 @@ type GSLBVirtualServer struct {
 	Port     uint32               `json:"port,omitempty"`
 }
 
+// GSLBTopologyRecords is the single set of topology records of the device, it must be declared in /Common/Shared
+type GSLBTopologyRecords struct {
+	Class   string               `json:"class"`
+	Label   string               `json:"label,omitempty"`
+	Remark  string               `json:"remark,omitempty"`
+	Records []GSLBTopologyRecord `json:"records"`
+}
+
+type GSLBTopologyRecord struct {
+	Source      GSLBTopologyMatch `json:"source"`
+	Destination GSLBTopologyMatch `json:"destination"`
+	Weight      int               `json:"weight"`
+}
+
+// GSLBTopologyMatch matches a value of the given type, e.g. a country code or a pointer to a datacenter or region
+type GSLBTopologyMatch struct {
+	MatchType     string `json:"matchType"`
+	MatchOperator string `json:"matchOperator,omitempty"`
+	MatchValue    any    `json:"matchValue"`
+}
+
+type GSLBTopologyRegion struct {
+	Class         string              `json:"class"`
+	Label         string              `json:"label,omitempty"`
+	Remark        string              `json:"remark,omitempty"`
+	RegionMembers []GSLBTopologyMatch `json:"regionMembers"`
+}
+
 type Pointer struct {
 	Use   string `json:"use,omitempty"`
 	BigIP string `json:"bigip,omitempty"`
@@ -5,20 +5,37 @@
 package f5
 
 import (
+	"cmp"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/apex/log"
 	"github.com/sapcc/andromeda/internal/config"
 	"github.com/sapcc/andromeda/internal/driver/f5/as3"
 	"github.com/sapcc/andromeda/internal/rpc/server"
 	"github.com/sapcc/andromeda/internal/rpcmodels"
+	"github.com/sapcc/andromeda/internal/utils"
 	"github.com/sapcc/andromeda/models"
 )
 
+// the topology records of all geomaps, there can only be one instance per device
+const as3DeclarationGSLBTopologyRecordsKey = "cc_andromeda_topology"
+
+// MX pool members are handed out with the same preference, the pool load balancing mode picks among them
+const as3MXPoolMemberPriority = 10
+
+// topology records of assigned countries take precedence over the default datacenter of a geomap
+const (
+	as3TopologyAssignmentWeight = 100
+	as3TopologyDefaultWeight    = 10
+)
+
 var errEntityPendingDeletion = errors.New("this entity has been marked as either PENDING_DELETE or DELETED and therefore must be excluded from the AS3 declaration")
+var errDomainNotSelected = errors.New("this domain has not been selected for a targeted sync and therefore must be left untouched")
 
 type as3CommonTenantBuilderFunc func(s AndromedaF5Store, datacenters []*rpcmodels.Datacenter, domains []*rpcmodels.Domain) (
 	as3.Tenant, []*server.ProvisioningStatusRequest_ProvisioningStatus, error)
@@ -53,6 +70,10 @@ func buildAS3Declaration(f5Config config.F5Config, s AndromedaF5Store, ctbFunc a
 	// build all /domain_{domainID} keys
 	for _, domain := range domains {
 		domainTenant, domainTenantRPCUpdates, err := dtbFunc(f5Config, datacentersByID, domain)
+		// domains not selected for a targeted sync are neither declared nor updated
+		if errors.Is(err, errDomainNotSelected) {
+			continue
+		}
 		// not a soft error: the declaration cannot be built
 		if err != nil && !errors.Is(err, errEntityPendingDeletion) {
 			return adc, rpcRequest, err
@@ -98,8 +119,14 @@ func buildAS3DomainTenant(
 		return tenant, rpcUpdates, errEntityPendingDeletion
 	}
 	application := as3.Application{}
-	as3PoolReferences := []as3.PointerGSLBPool{}
-	for _, p := range domain.Pools {
+	recordTypes := as3DeclarationDomainRecordTypes(domain)
+	as3PoolReferences := map[string][]as3.PointerGSLBPool{}
+	// global availability picks the first pool of the wide IP with available members
+	pools := slices.Clone(domain.Pools)
+	slices.SortStableFunc(pools, func(a, b *rpcmodels.Pool) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+	for _, p := range pools {
 		switch p.ProvisioningStatus {
 		case server.ProvisioningStatusRequest_ProvisioningStatus_PENDING_DELETE.String():
 			rpcUpdates = append(rpcUpdates, &server.ProvisioningStatusRequest_ProvisioningStatus{
@@ -117,52 +144,106 @@ func buildAS3DomainTenant(
 			Model:  server.ProvisioningStatusRequest_ProvisioningStatus_POOL,
 			Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE,
 		})
-		as3PoolReferences = append(as3PoolReferences, as3.PointerGSLBPool{Use: "pool_" + p.Id})
-		as3PoolMembers := []as3.GSLBPoolMember{}
-		for _, m := range p.Members {
-			switch m.ProvisioningStatus {
-			case server.ProvisioningStatusRequest_ProvisioningStatus_PENDING_DELETE.String(),
-				server.ProvisioningStatusRequest_ProvisioningStatus_DELETED.String():
-				// by excluding the entity from the AS3 declaration the API will delete it from the F5 device.
-				// the respective pool member RPC update is covered by buildAS3CommonTenant().
-				continue
-			}
-			if _, exists := datacentersByID[m.DatacenterId]; !exists {
-				return tenant, rpcUpdates, fmt.Errorf("invalid datacenter ID for member [datacenter ID = %s, member ID = %s]", m.DatacenterId, m.Id)
-			}
-			if datacentersByID[m.DatacenterId] == nil {
-				return tenant, rpcUpdates, fmt.Errorf("nil datacenter for member [member ID = %s]", m.Id)
+		// a pool is declared once per record type, each holding the members of its address family
+		for _, recordType := range recordTypes {
+			poolKey := as3DeclarationGSLBPoolKey(p.Id, recordType)
+			as3PoolReferences[recordType] = append(as3PoolReferences[recordType], as3.PointerGSLBPool{Use: poolKey})
+			as3PoolMembers := []as3.GSLBPoolMember{}
+			for _, m := range p.Members {
+				switch m.ProvisioningStatus {
+				case server.ProvisioningStatusRequest_ProvisioningStatus_PENDING_DELETE.String(),
+					server.ProvisioningStatusRequest_ProvisioningStatus_DELETED.String():
+					// by excluding the entity from the AS3 declaration the API will delete it from the F5 device.
+					// the respective pool member RPC update is covered by buildAS3CommonTenant().
+					continue
+				}
+				var as3PoolMember as3.GSLBPoolMember
+				if isAddressRecordType(recordType) {
+					if utils.AddressRecordType(m.Address) != recordType {
+						continue
+					}
+					if _, exists := datacentersByID[m.DatacenterId]; !exists {
+						return tenant, rpcUpdates, fmt.Errorf("invalid datacenter ID for member [datacenter ID = %s, member ID = %s]", m.DatacenterId, m.Id)
+					}
+					if datacentersByID[m.DatacenterId] == nil {
+						return tenant, rpcUpdates, fmt.Errorf("nil datacenter for member [member ID = %s]", m.Id)
+					}
+					as3PoolMember = as3.GSLBPoolMember{
+						Server: as3.PointerGSLBServer{
+							Use: "/Common/Shared/" + as3DeclarationGSLBServerKey(m.Address, datacentersByID[m.DatacenterId].Name),
+						},
+						VirtualServer: as3DeclarationGSLBVirtualServerName(m.Address, m.Port),
+					}
+				} else {
+					// CNAME and MX pools hand out the hostnames of their members, which have no GSLB server
+					// in the Common tenant, so their RPC update is covered here.
+					if utils.AddressRecordType(m.Address) != "" {
+						continue
+					}
+					as3PoolMember = as3.GSLBPoolMember{DomainName: m.Address}
+					if recordType == models.DomainRecordTypeMX {
+						priority := as3MXPoolMemberPriority
+						as3PoolMember.Priority = &priority
+					}
+					rpcUpdates = append(rpcUpdates, &server.ProvisioningStatusRequest_ProvisioningStatus{
+						Id:     m.Id,
+						Model:  server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER,
+						Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE,
+					})
+				}
+				if domain.Mode == models.DomainModeWEIGHTED {
+					// a ratio of zero is valid and disables the pool member for load balancing
+					ratio := int(m.Weight)
+					as3PoolMember.Ratio = &ratio
+				}
+				as3PoolMembers = append(as3PoolMembers, as3PoolMember)
 			}
-			as3PoolMember := as3.GSLBPoolMember{
-				Server: as3.PointerGSLBServer{
-					Use: "/Common/Shared/" + as3DeclarationGSLBServerKey(m.Address, datacentersByID[m.DatacenterId].Name),
-				},
-				VirtualServer: as3DeclarationGSLBVirtualServerName(m.Address, m.Port),
+			application.SetEntity(poolKey, as3.GSLBPool{
+				Class:              "GSLB_Pool",
+				LBModePreferred:    as3DeclarationPoolMemberLBMode(domain.Mode),
+				LBModeAlternate:    "none",
+				LBModeFallback:     "none",
+				Members:            as3PoolMembers,
+				ResourceRecordType: recordType,
+				TTL:                int(domain.Ttl),
+				MaxAnswersReturned: int(domain.HandoutLimit),
+			})
+		}
+		if isAddressRecordType(recordTypes[0]) {
+			// hostname members are handed out by the CNAME or MX domains of the pool, address domains don't
+			// declare them and report them as provisioned
+			for _, m := range p.Members {
+				switch m.ProvisioningStatus {
+				case server.ProvisioningStatusRequest_ProvisioningStatus_PENDING_DELETE.String(),
+					server.ProvisioningStatusRequest_ProvisioningStatus_DELETED.String():
+					continue
+				}
+				if utils.AddressRecordType(m.Address) == "" {
+					rpcUpdates = append(rpcUpdates, &server.ProvisioningStatusRequest_ProvisioningStatus{
+						Id:     m.Id,
+						Model:  server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER,
+						Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE,
+					})
+				}
 			}
-			as3PoolMembers = append(as3PoolMembers, as3PoolMember)
 		}
-		application.SetEntity("pool_"+p.Id, as3.GSLBPool{
-			Class:              "GSLB_Pool",
-			LBModePreferred:    as3DeclarationPoolMemberLBMode(domain.Mode),
-			LBModeAlternate:    "none",
-			LBModeFallback:     "none",
-			Members:            as3PoolMembers,
-			ResourceRecordType: "A",
-		})
 	}
 	rpcUpdates = append(rpcUpdates, &server.ProvisioningStatusRequest_ProvisioningStatus{
 		Id:     domain.Id,
 		Model:  server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN,
 		Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE,
 	})
-	as3Domain := as3.GSLBDomain{
-		Class:              "GSLB_Domain",
-		DomainName:         domain.Fqdn + f5Config.DomainSuffix,
-		ResourceRecordType: domain.RecordType,
-		PoolLbMode:         as3DeclarationDomainPoolLBMode(),
-		Pools:              as3PoolReferences,
-	}
-	application.SetEntity("wideip", as3Domain)
+	// a wide IP only answers queries of its record type, dual-stack domains need one per address family
+	for _, recordType := range recordTypes {
+		application.SetEntity(as3DeclarationGSLBDomainKey(recordType), as3.GSLBDomain{
+			Class:              "GSLB_Domain",
+			DomainName:         domain.Fqdn + f5Config.DomainSuffix,
+			Aliases:            as3DeclarationDomainAliases(f5Config, domain.Aliases),
+			ResourceRecordType: recordType,
+			PoolLbMode:         as3DeclarationDomainPoolLBMode(domain.Mode),
+			Pools:              as3PoolReferences[recordType],
+		})
+	}
 	tenant.AddApplication("application", application)
 	return tenant, rpcUpdates, nil
 }
@@ -171,6 +252,25 @@ func buildAS3CommonTenant(
 	s AndromedaF5Store,
 	datacenters []*rpcmodels.Datacenter,
 	domains []*rpcmodels.Domain) (as3.Tenant, []*server.ProvisioningStatusRequest_ProvisioningStatus, error) {
+	return buildAS3CommonTenantForDomains(s, datacenters, domains, nil)
+}
+
+// selectedCommonTenantBuilder builds the /Common tenant for a targeted sync of the given domains
+func selectedCommonTenantBuilder(domainIDs []string) as3CommonTenantBuilderFunc {
+	return func(s AndromedaF5Store, datacenters []*rpcmodels.Datacenter, domains []*rpcmodels.Domain) (
+		as3.Tenant, []*server.ProvisioningStatusRequest_ProvisioningStatus, error) {
+		return buildAS3CommonTenantForDomains(s, datacenters, domains, domainIDs)
+	}
+}
+
+// buildAS3CommonTenantForDomains builds the /Common tenant. If domain IDs are given, the tenants of all other
+// domains are left untouched on the device: the servers of their members pending deletion stay declared, as
+// these tenants still reference them, and only the members of pools of the given domains are reported.
+func buildAS3CommonTenantForDomains(
+	s AndromedaF5Store,
+	datacenters []*rpcmodels.Datacenter,
+	domains []*rpcmodels.Domain,
+	domainIDs []string) (as3.Tenant, []*server.ProvisioningStatusRequest_ProvisioningStatus, error) {
 	tenant := as3.Tenant{}
 	rpcUpdates := []*server.ProvisioningStatusRequest_ProvisioningStatus{}
 	application := as3.Application{Template: "shared"}
@@ -210,6 +310,20 @@ func buildAS3CommonTenant(
 			}
 		}
 	}
+	// pools of the domains selected for a targeted sync and of the domains left untouched
+	selectedPools, untouchedPools := map[string]bool{}, map[string]bool{}
+	for _, domain := range domains {
+		for _, pool := range domain.Pools {
+			if slices.Contains(domainIDs, domain.Id) {
+				selectedPools[pool.Id] = true
+			} else {
+				untouchedPools[pool.Id] = true
+			}
+		}
+	}
+	reportMember := func(member *rpcmodels.Member) bool {
+		return len(domainIDs) == 0 || selectedPools[member.PoolId]
+	}
 	// add all servers under /Common/Shared
 	for _, datacenter := range datacenters {
 		members, err := s.GetMembers(datacenter.Id)
@@ -219,16 +333,26 @@ func buildAS3CommonTenant(
 		for _, member := range members {
 			switch member.ProvisioningStatus {
 			case server.ProvisioningStatusRequest_ProvisioningStatus_PENDING_DELETE.String():
-				rpcUpdates = append(rpcUpdates, &server.ProvisioningStatusRequest_ProvisioningStatus{
-					Id:     member.Id,
-					Model:  server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER,
-					Status: server.ProvisioningStatusRequest_ProvisioningStatus_DELETED,
-				})
-				fallthrough
+				if len(domainIDs) > 0 && untouchedPools[member.PoolId] {
+					// still referenced by the tenants left untouched, it is deleted by their next sync
+					break
+				}
+				if reportMember(member) {
+					rpcUpdates = append(rpcUpdates, &server.ProvisioningStatusRequest_ProvisioningStatus{
+						Id:     member.Id,
+						Model:  server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER,
+						Status: server.ProvisioningStatusRequest_ProvisioningStatus_DELETED,
+					})
+				}
+				continue
 			case server.ProvisioningStatusRequest_ProvisioningStatus_DELETED.String():
 				// by excluding the entity from the AS3 declaration the API will delete it from the F5 device
 				continue
 			}
+			if utils.AddressRecordType(member.Address) == "" {
+				// hostname members of CNAME and MX pools are declared by their domain tenant
+				continue
+			}
 			monitorPointers := []as3.PointerGSLBMonitor{}
 			if monitors, ok := monitorsByPoolID[member.PoolId]; ok {
 				for _, monitor := range monitors {
@@ -279,22 +403,145 @@ func buildAS3CommonTenant(
 					application.SetEntity(memberKey, gslbServer)
 				}
 			}
-			rpcUpdates = append(rpcUpdates, &server.ProvisioningStatusRequest_ProvisioningStatus{
-				Id:     member.GetId(),
-				Model:  server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER,
-				Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE,
-			})
+			if member.ProvisioningStatus != server.ProvisioningStatusRequest_ProvisioningStatus_PENDING_DELETE.String() &&
+				reportMember(member) {
+				rpcUpdates = append(rpcUpdates, &server.ProvisioningStatusRequest_ProvisioningStatus{
+					Id:     member.GetId(),
+					Model:  server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER,
+					Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE,
+				})
+			}
 		}
 	}
+	// add the topology of all geomaps under /Common/Shared
+	geomaps, err := s.GetGeomaps()
+	if err != nil {
+		return tenant, rpcUpdates, err
+	}
+	rpcUpdates = append(rpcUpdates, addAS3Topology(&application, datacenters, geomaps)...)
 	tenant.AddApplication("Shared", application)
 	return tenant, rpcUpdates, nil
 }
 
+// addAS3Topology translates geomaps into topology records: clients of assigned countries are directed to the
+// assigned datacenter, all other clients to the default datacenter. The assigned countries of a geomap form a
+// topology region, so that the default record matches clients outside this region only.
+//
+// Topology records are global to the device, so geomaps must not assign the same countries differently.
+// Geomaps referencing unknown datacenters are excluded and reported as ERROR.
+func addAS3Topology(application *as3.Application, datacenters []*rpcmodels.Datacenter, geomaps []*rpcmodels.Geomap) []*server.ProvisioningStatusRequest_ProvisioningStatus {
+	rpcUpdates := []*server.ProvisioningStatusRequest_ProvisioningStatus{}
+	datacenterNames := make(map[string]string, len(datacenters))
+	for _, dc := range datacenters {
+		datacenterNames[dc.Id] = dc.Name
+	}
+	datacenterMatch := func(datacenterID string) as3.GSLBTopologyMatch {
+		return as3.GSLBTopologyMatch{
+			MatchType:  "datacenter",
+			MatchValue: as3.PointerGSLBDataCenter{BigIP: "/Common/" + datacenterNames[datacenterID]},
+		}
+	}
+
+	records := []as3.GSLBTopologyRecord{}
+	for _, geomap := range geomaps {
+		switch geomap.ProvisioningStatus {
+		case server.ProvisioningStatusRequest_ProvisioningStatus_PENDING_DELETE.String():
+			rpcUpdates = append(rpcUpdates, &server.ProvisioningStatusRequest_ProvisioningStatus{
+				Id:     geomap.Id,
+				Model:  server.ProvisioningStatusRequest_ProvisioningStatus_GEOGRAPHIC_MAP,
+				Status: server.ProvisioningStatusRequest_ProvisioningStatus_DELETED,
+			})
+			fallthrough
+		case server.ProvisioningStatusRequest_ProvisioningStatus_DELETED.String():
+			// by excluding the entity from the AS3 declaration the API will delete it from the F5 device
+			continue
+		}
+		if err := validateAS3Topology(geomap, datacenterNames); err != nil {
+			rpcUpdates = append(rpcUpdates, &server.ProvisioningStatusRequest_ProvisioningStatus{
+				Id:     geomap.Id,
+				Model:  server.ProvisioningStatusRequest_ProvisioningStatus_GEOGRAPHIC_MAP,
+				Status: server.ProvisioningStatusRequest_ProvisioningStatus_ERROR,
+				Error:  err.Error(),
+			})
+			continue
+		}
+
+		regionMembers := []as3.GSLBTopologyMatch{}
+		for _, assignment := range geomap.Assignment {
+			for _, country := range assignment.Countries {
+				source := as3.GSLBTopologyMatch{MatchType: "country", MatchOperator: "equals", MatchValue: country}
+				regionMembers = append(regionMembers, source)
+				records = append(records, as3.GSLBTopologyRecord{
+					Source:      source,
+					Destination: datacenterMatch(assignment.Datacenter),
+					Weight:      as3TopologyAssignmentWeight,
+				})
+			}
+		}
+		defaultSource := as3.GSLBTopologyMatch{MatchType: "continent", MatchOperator: "not-equals", MatchValue: "--"}
+		if len(regionMembers) > 0 {
+			regionKey := as3DeclarationGSLBTopologyRegionKey(geomap.Id)
+			application.SetEntity(regionKey, as3.GSLBTopologyRegion{
+				Class:         "GSLB_Topology_Region",
+				RegionMembers: regionMembers,
+			})
+			defaultSource = as3.GSLBTopologyMatch{
+				MatchType:     "region",
+				MatchOperator: "not-equals",
+				MatchValue:    as3.PointerGSLBTopologyRegion{Use: regionKey},
+			}
+		}
+		records = append(records, as3.GSLBTopologyRecord{
+			Source:      defaultSource,
+			Destination: datacenterMatch(geomap.DefaultDatacenter),
+			Weight:      as3TopologyDefaultWeight,
+		})
+		rpcUpdates = append(rpcUpdates, &server.ProvisioningStatusRequest_ProvisioningStatus{
+			Id:     geomap.Id,
+			Model:  server.ProvisioningStatusRequest_ProvisioningStatus_GEOGRAPHIC_MAP,
+			Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE,
+		})
+	}
+	if len(records) > 0 {
+		application.SetEntity(as3DeclarationGSLBTopologyRecordsKey, as3.GSLBTopologyRecords{
+			Class:   "GSLB_Topology_Records",
+			Records: records,
+		})
+	}
+	return rpcUpdates
+}
+
+// validateAS3Topology checks that all datacenters referenced by the geomap are F5 datacenters
+func validateAS3Topology(geomap *rpcmodels.Geomap, datacenterNames map[string]string) error {
+	if _, ok := datacenterNames[geomap.DefaultDatacenter]; !ok {
+		return fmt.Errorf("unknown default datacenter %s", geomap.DefaultDatacenter)
+	}
+	for _, assignment := range geomap.Assignment {
+		if _, ok := datacenterNames[assignment.Datacenter]; !ok {
+			return fmt.Errorf("unknown datacenter %s assigned to %s", assignment.Datacenter,
+				strings.Join(assignment.Countries, ","))
+		}
+	}
+	return nil
+}
+
 func as3DeclarationGSLBDomainTenantKey(domainID string) string {
 	return "domain_" + domainID
 }
 
-func as3DeclarationGSLBPoolKey(poolID string) string {
+// as3DeclarationGSLBDomainKey is the key of the wide IP of a record type, AAAA wide IPs of
+// dual-stack domains are declared next to the A wide IP.
+func as3DeclarationGSLBDomainKey(recordType string) string {
+	if recordType == models.DomainRecordTypeAAAA {
+		return "wideip_aaaa"
+	}
+	return "wideip"
+}
+
+func as3DeclarationGSLBPoolKey(poolID, recordType string) string {
+	if recordType == models.DomainRecordTypeAAAA {
+		return "pool_" + poolID + "_aaaa"
+	}
 	return "pool_" + poolID
 }
 
@@ -302,16 +549,78 @@ func as3DeclarationGSLBMonitorKey(monitorID string) string {
 	return fmt.Sprintf("cc_andromeda_monitor_%s", monitorID)
 }
 
+// as3DeclarationGSLBServerKey replaces the colons of IPv6 addresses, which are not allowed in AS3 names.
 func as3DeclarationGSLBServerKey(memberAddress, datacenterName string) string {
-	return fmt.Sprintf("cc_andromeda_srv_%s_%s", memberAddress, datacenterName)
+	return fmt.Sprintf("cc_andromeda_srv_%s_%s", strings.ReplaceAll(memberAddress, ":", "-"), datacenterName)
+}
+
+func as3DeclarationGSLBTopologyRegionKey(geomapID string) string {
+	return fmt.Sprintf("cc_andromeda_geomap_%s", geomapID)
 }
 
+// as3DeclarationGSLBVirtualServerName follows the BigIP notation of destinations, which separates
+// the port of IPv6 addresses by a dot.
 func as3DeclarationGSLBVirtualServerName(memberAddress string, memberPort uint32) string {
+	if utils.AddressRecordType(memberAddress) == models.DomainRecordTypeAAAA {
+		return memberAddress + "." + strconv.FormatUint(uint64(memberPort), 10)
+	}
 	return memberAddress + ":" + strconv.FormatUint(uint64(memberPort), 10)
 }
 
+// as3DeclarationDomainRecordTypes returns the record types a domain is declared for. Address domains
+// are dual-stack: besides the record type of the domain, the record type of the other address family
+// is declared if the domain has members of that family.
+func as3DeclarationDomainRecordTypes(domain *rpcmodels.Domain) []string {
+	domainRecordType := domain.RecordType
+	if domainRecordType == "" {
+		// the API defaults to A records
+		domainRecordType = models.DomainRecordTypeA
+	}
+	if !isAddressRecordType(domainRecordType) {
+		return []string{domainRecordType}
+	}
+	families := map[string]bool{domainRecordType: true}
+	for _, p := range domain.Pools {
+		for _, m := range p.Members {
+			if recordType := utils.AddressRecordType(m.Address); recordType != "" {
+				families[recordType] = true
+			}
+		}
+	}
+	recordTypes := []string{}
+	for _, recordType := range []string{models.DomainRecordTypeA, models.DomainRecordTypeAAAA} {
+		if families[recordType] {
+			recordTypes = append(recordTypes, recordType)
+		}
+	}
+	return recordTypes
+}
+
+func isAddressRecordType(recordType string) bool {
+	return recordType == models.DomainRecordTypeA || recordType == models.DomainRecordTypeAAAA
+}
+
+// as3DeclarationDomainAliases applies the domain suffix to aliases the same way as to the domain name
+func as3DeclarationDomainAliases(f5Config config.F5Config, aliases []string) []string {
+	if len(aliases) == 0 {
+		return nil
+	}
+	suffixed := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		suffixed = append(suffixed, alias+f5Config.DomainSuffix)
+	}
+	return suffixed
+}
+
 // as3DeclarationDomainPoolLBMode refers to valid values for GSLB_Domain.poolLbMode.
-func as3DeclarationDomainPoolLBMode() string {
+//
+// Pools of geographic domains are picked by the topology records of the datacenters of
+// their members, all others by global availability: the first pool with available members
+// in order of the pool priorities is picked, the following pools are its fallbacks.
+func as3DeclarationDomainPoolLBMode(domainMode string) string {
+	if domainMode == models.DomainModeGEOGRAPHIC {
+		return "topology"
+	}
 	return "global-availability"
 }
 
@@ -329,10 +638,21 @@ func as3DeclarationDomainPoolLBMode() string {
 //   - round-robin: DNS resolution pick is both circular and sequential among
 //     GSLB_Pool.Members[]. Over time each virtual server in a pool is picked
 //     an equal amount of times compared to the other pool members.
+//
+//   - ratio: DNS resolution pick is distributed among GSLB_Pool.Members[]
+//     proportionally to each pool member's ratio (i.e. the member weight).
+//
+//   - topology: DNS resolution pick is the virtual server in the datacenter
+//     that the topology record with the highest weight matching the client
+//     directs to (see addAS3Topology).
 func as3DeclarationPoolMemberLBMode(memberMode string) string {
 	switch memberMode {
+	case models.DomainModeGEOGRAPHIC:
+		return "topology"
 	case models.DomainModeROUNDROBIN:
 		return "round-robin"
+	case models.DomainModeWEIGHTED:
+		return "ratio"
 	case models.DomainModeAVAILABILITY:
 		return "global-availability"
 	default:
@@ -368,7 +688,30 @@ type as3Client interface {
 	PostAs3Bigip(as3NewJson, tenantFilter, queryParam string) (error, string, string)
 }
 
-func postAS3Declaration(decl as3.ADC, client as3Client, declChecker func(as3.ADC) error) error {
+// selectedDomainTenantBuilder wraps dtbFunc to only build the tenants of the given domains
+func selectedDomainTenantBuilder(domainIDs []string, dtbFunc as3DomainTenantBuilderFunc) as3DomainTenantBuilderFunc {
+	return func(f5Config config.F5Config, datacentersByID map[string]*rpcmodels.Datacenter, domain *rpcmodels.Domain) (
+		as3.Tenant, []*server.ProvisioningStatusRequest_ProvisioningStatus, error) {
+		if !slices.Contains(domainIDs, domain.Id) {
+			return as3.Tenant{}, nil, errDomainNotSelected
+		}
+		return dtbFunc(f5Config, datacentersByID, domain)
+	}
+}
+
+// as3TenantFilter lists /Common and the tenants of the given domains. Listed tenants missing in the
+// declaration (i.e. domains pending deletion) are deleted by AS3.
+func as3TenantFilter(domainIDs []string) string {
+	tenants := []string{"Common"}
+	for _, domainID := range domainIDs {
+		tenants = append(tenants, as3DeclarationGSLBDomainTenantKey(domainID))
+	}
+	return strings.Join(tenants, ",")
+}
+
+// postAS3Declaration posts the declaration, AS3 leaves tenants untouched which are not part of the
+// (comma separated) tenant filter. An empty filter applies to all tenants.
+func postAS3Declaration(decl as3.ADC, client as3Client, declChecker func(as3.ADC) error, tenantFilter string) error {
 	if err := declChecker(decl); err != nil {
 		return err
 	}
@@ -377,12 +720,55 @@ func postAS3Declaration(decl as3.ADC, client as3Client, declChecker func(as3.ADC
 		return err
 	}
 	log.Debugf("AS3 declaration: %s", string(jsonDecl))
-	if err, _, _ := client.PostAs3Bigip(string(jsonDecl), "", ""); err != nil {
+	if err, _, _ := client.PostAs3Bigip(string(jsonDecl), tenantFilter, ""); err != nil {
+		if isAS3Rejection(err) {
+			err = &as3RejectionError{err}
+		}
 		return fmt.Errorf("failed to post AS3 declaration: %w", err)
 	}
 	return nil
 }
 
+// as3RejectionError is returned if AS3 refused to apply the declaration, posting it again fails the same way
+type as3RejectionError struct {
+	err error
+}
+
+func (e *as3RejectionError) Error() string {
+	return e.err.Error()
+}
+
+func (e *as3RejectionError) Unwrap() error {
+	return e.err
+}
+
+// isAS3Rejection reports whether AS3 rejected the declaration with a client error, e.g. 422 for an invalid
+// declaration. go-bigip only returns the AS3 task results as part of the error message. Other errors (timeouts,
+// 503 while another declaration is in progress, authentication) are transient.
+func isAS3Rejection(err error) bool {
+	msg := err.Error()
+	// the errors of a declaration rejected as invalid
+	if strings.HasPrefix(msg, "Error :") {
+		return true
+	}
+	start := strings.Index(msg, "[")
+	if start < 0 {
+		return false
+	}
+	var results []struct {
+		Code int `json:"code"`
+	}
+	if err := json.Unmarshal([]byte(msg[start:]), &results); err != nil {
+		return false
+	}
+	for _, result := range results {
+		if result.Code >= 400 && result.Code < 500 {
+			return true
+		}
+	}
+	return false
+}
+
 var errUnexpectedADCSchemaVersion = errors.New("unexpected AS3 ADC.SchemaVersion")
 var errUnexpectedADCUpdateMode = errors.New("unexpected AS3 ADC.UpdateMode")
 var errMissingCommonTenant = errors.New("missing required tenant /Common")
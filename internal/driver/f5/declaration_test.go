@@ -5,6 +5,7 @@
 package f5
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/sapcc/andromeda/internal/driver/f5/as3"
 	"github.com/sapcc/andromeda/internal/rpc/server"
 	"github.com/sapcc/andromeda/internal/rpcmodels"
+	"github.com/sapcc/andromeda/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -172,6 +174,8 @@ func TestBuildAS3Declaration(t *testing.T) {
 			},
 			nil)
 
+		store.On("GetGeomaps").Return([]*rpcmodels.Geomap{}, nil)
+
 		declaration, req, err := buildAS3Declaration(config.F5Config{}, store, buildAS3CommonTenant, buildAS3DomainTenant)
 		assert.Nil(err)
 
@@ -221,7 +225,8 @@ func TestBuildAS3Declaration(t *testing.T) {
 				Pools: []as3.PointerGSLBPool{
 					{Use: "pool_pool1-uuid"},
 				},
-				PoolLbMode: "global-availability",
+				PoolLbMode:         "global-availability",
+				ResourceRecordType: "A",
 			})
 			domainApp.SetEntity("pool_pool1-uuid", as3.GSLBPool{
 				Class:           "GSLB_Pool",
@@ -457,6 +462,329 @@ func TestBuildAS3DomainTenant(t *testing.T) {
 			assert.Equal(expectedRPCUpdates, req)
 		})
 	})
+
+	t.Run("Maps member weights to pool member ratios if the domain mode is WEIGHTED", func(t *testing.T) {
+		datacentersByID := map[string]*rpcmodels.Datacenter{
+			"dc1-uuid": {Id: "dc1-uuid", Name: "dc1"},
+		}
+		domain := &rpcmodels.Domain{
+			Id:         "dom1-uuid",
+			Fqdn:       "test1",
+			Mode:       "WEIGHTED",
+			RecordType: "A",
+			Pools: []*rpcmodels.Pool{
+				{
+					Id: "pool1-uuid",
+					Members: []*rpcmodels.Member{
+						{Id: "member1", Address: "200.10.0.1", Port: 80, Weight: 3, DatacenterId: "dc1-uuid"},
+						{Id: "member2", Address: "200.10.0.2", Port: 80, Weight: 1, DatacenterId: "dc1-uuid"},
+						{Id: "member3", Address: "200.10.0.3", Port: 80, Weight: 0, DatacenterId: "dc1-uuid"},
+					},
+				},
+			},
+		}
+		tenant, _, err := buildAS3DomainTenant(config.F5Config{}, datacentersByID, domain)
+		assert.Nil(err)
+
+		application, err := tenant.GetApplication("application")
+		assert.Nil(err)
+		pool, ok := application.GetEntity("pool_pool1-uuid").(as3.GSLBPool)
+		assert.True(ok)
+		assert.Equal("ratio", pool.LBModePreferred)
+		ratios := []int{}
+		for _, m := range pool.Members {
+			if assert.NotNil(m.Ratio) {
+				ratios = append(ratios, *m.Ratio)
+			}
+		}
+		assert.Equal([]int{3, 1, 0}, ratios)
+	})
+
+	t.Run("Declares a pool and wide IP per address family for dual-stack domains", func(t *testing.T) {
+		datacentersByID := map[string]*rpcmodels.Datacenter{
+			"dc1-uuid": {Id: "dc1-uuid", Name: "dc1"},
+		}
+		domain := &rpcmodels.Domain{
+			Id:         "dom1-uuid",
+			Fqdn:       "test1",
+			Mode:       models.DomainModeAVAILABILITY,
+			RecordType: models.DomainRecordTypeA,
+			Pools: []*rpcmodels.Pool{
+				{
+					Id: "pool1-uuid",
+					Members: []*rpcmodels.Member{
+						{Id: "member1", Address: "200.10.0.1", Port: 80, DatacenterId: "dc1-uuid"},
+						{Id: "member2", Address: "2001:db8::1", Port: 443, DatacenterId: "dc1-uuid"},
+					},
+				},
+			},
+		}
+		tenant, _, err := buildAS3DomainTenant(config.F5Config{}, datacentersByID, domain)
+		assert.Nil(err)
+
+		expectedApplication := as3.Application{}
+		expectedApplication.SetEntity("pool_pool1-uuid", as3.GSLBPool{
+			Class:              "GSLB_Pool",
+			ResourceRecordType: "A",
+			Members: []as3.GSLBPoolMember{{
+				Server:        as3.PointerGSLBServer{Use: "/Common/Shared/cc_andromeda_srv_200.10.0.1_dc1"},
+				VirtualServer: "200.10.0.1:80",
+			}},
+			LBModePreferred: "global-availability",
+			LBModeAlternate: "none",
+			LBModeFallback:  "none",
+		})
+		expectedApplication.SetEntity("pool_pool1-uuid_aaaa", as3.GSLBPool{
+			Class:              "GSLB_Pool",
+			ResourceRecordType: "AAAA",
+			Members: []as3.GSLBPoolMember{{
+				Server:        as3.PointerGSLBServer{Use: "/Common/Shared/cc_andromeda_srv_2001-db8--1_dc1"},
+				VirtualServer: "2001:db8::1.443",
+			}},
+			LBModePreferred: "global-availability",
+			LBModeAlternate: "none",
+			LBModeFallback:  "none",
+		})
+		expectedApplication.SetEntity("wideip", as3.GSLBDomain{
+			Class:              "GSLB_Domain",
+			DomainName:         "test1",
+			ResourceRecordType: "A",
+			PoolLbMode:         "global-availability",
+			Pools:              []as3.PointerGSLBPool{{Use: "pool_pool1-uuid"}},
+		})
+		expectedApplication.SetEntity("wideip_aaaa", as3.GSLBDomain{
+			Class:              "GSLB_Domain",
+			DomainName:         "test1",
+			ResourceRecordType: "AAAA",
+			PoolLbMode:         "global-availability",
+			Pools:              []as3.PointerGSLBPool{{Use: "pool_pool1-uuid_aaaa"}},
+		})
+		application, err := tenant.GetApplication("application")
+		assert.Nil(err)
+		assert.Equal(expectedApplication, application)
+	})
+
+	t.Run("Declares only the AAAA wide IP for AAAA domains without IPv4 members", func(t *testing.T) {
+		datacentersByID := map[string]*rpcmodels.Datacenter{
+			"dc1-uuid": {Id: "dc1-uuid", Name: "dc1"},
+		}
+		domain := &rpcmodels.Domain{
+			Id:         "dom1-uuid",
+			Fqdn:       "test1",
+			RecordType: models.DomainRecordTypeAAAA,
+			Pools: []*rpcmodels.Pool{
+				{
+					Id: "pool1-uuid",
+					Members: []*rpcmodels.Member{
+						{Id: "member1", Address: "2001:db8::1", Port: 80, DatacenterId: "dc1-uuid"},
+					},
+				},
+			},
+		}
+		tenant, _, err := buildAS3DomainTenant(config.F5Config{}, datacentersByID, domain)
+		assert.Nil(err)
+
+		application, err := tenant.GetApplication("application")
+		assert.Nil(err)
+		assert.Nil(application.GetEntity("wideip"))
+		assert.Nil(application.GetEntity("pool_pool1-uuid"))
+		wideIP, ok := application.GetEntity("wideip_aaaa").(as3.GSLBDomain)
+		assert.True(ok)
+		assert.Equal("AAAA", wideIP.ResourceRecordType)
+		assert.Equal([]as3.PointerGSLBPool{{Use: "pool_pool1-uuid_aaaa"}}, wideIP.Pools)
+	})
+
+	t.Run("Declares CNAME pools with hostname members and wide IP aliases", func(t *testing.T) {
+		domain := &rpcmodels.Domain{
+			Id:         "dom1-uuid",
+			Fqdn:       "test1",
+			Aliases:    []string{"alias1", "*.alias2"},
+			Mode:       models.DomainModeROUNDROBIN,
+			RecordType: models.DomainRecordTypeCNAME,
+			Pools: []*rpcmodels.Pool{
+				{
+					Id: "pool1-uuid",
+					Members: []*rpcmodels.Member{
+						{Id: "member1", Address: "target.example.com", Port: 80},
+						{Id: "member2", Address: "200.10.0.1", Port: 80, DatacenterId: "dc1-uuid"},
+					},
+				},
+			},
+		}
+		tenant, req, err := buildAS3DomainTenant(config.F5Config{DomainSuffix: ".local"}, map[string]*rpcmodels.Datacenter{}, domain)
+		assert.Nil(err)
+
+		expectedApplication := as3.Application{}
+		expectedApplication.SetEntity("pool_pool1-uuid", as3.GSLBPool{
+			Class:              "GSLB_Pool",
+			ResourceRecordType: "CNAME",
+			Members:            []as3.GSLBPoolMember{{DomainName: "target.example.com"}},
+			LBModePreferred:    "round-robin",
+			LBModeAlternate:    "none",
+			LBModeFallback:     "none",
+		})
+		expectedApplication.SetEntity("wideip", as3.GSLBDomain{
+			Class:              "GSLB_Domain",
+			DomainName:         "test1.local",
+			Aliases:            []string{"alias1.local", "*.alias2.local"},
+			ResourceRecordType: "CNAME",
+			PoolLbMode:         "global-availability",
+			Pools:              []as3.PointerGSLBPool{{Use: "pool_pool1-uuid"}},
+		})
+		application, err := tenant.GetApplication("application")
+		assert.Nil(err)
+		assert.Equal(expectedApplication, application)
+		assert.Equal([]*server.ProvisioningStatusRequest_ProvisioningStatus{
+			{Id: "pool1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_POOL, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+			{Id: "member1", Model: server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+			{Id: "dom1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+		}, req)
+	})
+
+	t.Run("Reports hostname members of address domains provisioned without declaring them", func(t *testing.T) {
+		datacentersByID := map[string]*rpcmodels.Datacenter{
+			"dc1-uuid": {Id: "dc1-uuid", Name: "dc1"},
+		}
+		domain := &rpcmodels.Domain{
+			Id:         "dom1-uuid",
+			Fqdn:       "test1",
+			RecordType: models.DomainRecordTypeA,
+			Pools: []*rpcmodels.Pool{
+				{
+					Id: "pool1-uuid",
+					Members: []*rpcmodels.Member{
+						{Id: "member1", Address: "200.10.0.1", Port: 80, DatacenterId: "dc1-uuid"},
+						{Id: "member2", Address: "target.example.com", Port: 80},
+					},
+				},
+			},
+		}
+		tenant, req, err := buildAS3DomainTenant(config.F5Config{}, datacentersByID, domain)
+		assert.Nil(err)
+
+		application, err := tenant.GetApplication("application")
+		assert.Nil(err)
+		pool, ok := application.GetEntity("pool_pool1-uuid").(as3.GSLBPool)
+		assert.True(ok)
+		assert.Len(pool.Members, 1)
+		assert.Equal([]*server.ProvisioningStatusRequest_ProvisioningStatus{
+			{Id: "pool1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_POOL, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+			{Id: "member2", Model: server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+			{Id: "dom1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+		}, req)
+	})
+
+	t.Run("Declares MX pool members with their priority", func(t *testing.T) {
+		domain := &rpcmodels.Domain{
+			Id:         "dom1-uuid",
+			Fqdn:       "test1",
+			RecordType: models.DomainRecordTypeMX,
+			Pools: []*rpcmodels.Pool{
+				{
+					Id:      "pool1-uuid",
+					Members: []*rpcmodels.Member{{Id: "member1", Address: "mail.example.com", Port: 25}},
+				},
+			},
+		}
+		tenant, _, err := buildAS3DomainTenant(config.F5Config{}, map[string]*rpcmodels.Datacenter{}, domain)
+		assert.Nil(err)
+
+		application, err := tenant.GetApplication("application")
+		assert.Nil(err)
+		pool, ok := application.GetEntity("pool_pool1-uuid").(as3.GSLBPool)
+		assert.True(ok)
+		assert.Equal("MX", pool.ResourceRecordType)
+		priority := 10
+		assert.Equal([]as3.GSLBPoolMember{{DomainName: "mail.example.com", Priority: &priority}}, pool.Members)
+
+		// hostname members have no server, which must not be declared as empty pointer
+		data, err := json.Marshal(pool.Members[0])
+		assert.Nil(err)
+		assert.JSONEq(`{"domainName":"mail.example.com","priority":10}`, string(data))
+	})
+
+	t.Run("Maps the domain TTL and handout limit to the pools", func(t *testing.T) {
+		domain := &rpcmodels.Domain{
+			Id:           "dom1-uuid",
+			Fqdn:         "test1",
+			RecordType:   models.DomainRecordTypeMX,
+			Ttl:          300,
+			HandoutLimit: 2,
+			Pools: []*rpcmodels.Pool{
+				{
+					Id:      "pool1-uuid",
+					Members: []*rpcmodels.Member{{Id: "member1", Address: "mail.example.com", Port: 25}},
+				},
+			},
+		}
+		tenant, _, err := buildAS3DomainTenant(config.F5Config{}, map[string]*rpcmodels.Datacenter{}, domain)
+		assert.Nil(err)
+
+		application, err := tenant.GetApplication("application")
+		assert.Nil(err)
+		pool, ok := application.GetEntity("pool_pool1-uuid").(as3.GSLBPool)
+		assert.True(ok)
+		assert.Equal(300, pool.TTL)
+		assert.Equal(2, pool.MaxAnswersReturned)
+	})
+
+	t.Run("Declares the wide IP pools in order of their priority", func(t *testing.T) {
+		domain := &rpcmodels.Domain{
+			Id:         "dom1-uuid",
+			Fqdn:       "test1",
+			Mode:       models.DomainModeAVAILABILITY,
+			RecordType: models.DomainRecordTypeA,
+			Pools: []*rpcmodels.Pool{
+				{Id: "pool2-uuid", Priority: 1},
+				{Id: "pool3-uuid", Priority: 2},
+				{Id: "pool1-uuid", Priority: 0},
+			},
+		}
+		tenant, _, err := buildAS3DomainTenant(config.F5Config{}, map[string]*rpcmodels.Datacenter{}, domain)
+		assert.Nil(err)
+
+		application, err := tenant.GetApplication("application")
+		assert.Nil(err)
+		wideIP, ok := application.GetEntity("wideip").(as3.GSLBDomain)
+		assert.True(ok)
+		assert.Equal("global-availability", wideIP.PoolLbMode)
+		assert.Equal([]as3.PointerGSLBPool{
+			{Use: "pool_pool1-uuid"},
+			{Use: "pool_pool2-uuid"},
+			{Use: "pool_pool3-uuid"},
+		}, wideIP.Pools)
+	})
+
+	t.Run("Picks pools and members by topology if the domain mode is GEOGRAPHIC", func(t *testing.T) {
+		datacentersByID := map[string]*rpcmodels.Datacenter{
+			"dc1-uuid": {Id: "dc1-uuid", Name: "dc1"},
+		}
+		domain := &rpcmodels.Domain{
+			Id:         "dom1-uuid",
+			Fqdn:       "test1",
+			Mode:       models.DomainModeGEOGRAPHIC,
+			RecordType: "A",
+			Pools: []*rpcmodels.Pool{
+				{
+					Id: "pool1-uuid",
+					Members: []*rpcmodels.Member{
+						{Id: "member1", Address: "200.10.0.1", Port: 80, DatacenterId: "dc1-uuid"},
+					},
+				},
+			},
+		}
+		tenant, _, err := buildAS3DomainTenant(config.F5Config{}, datacentersByID, domain)
+		assert.Nil(err)
+
+		application, err := tenant.GetApplication("application")
+		assert.Nil(err)
+		wideIP, ok := application.GetEntity("wideip").(as3.GSLBDomain)
+		assert.True(ok)
+		assert.Equal("topology", wideIP.PoolLbMode)
+		pool, ok := application.GetEntity("pool_pool1-uuid").(as3.GSLBPool)
+		assert.True(ok)
+		assert.Equal("topology", pool.LBModePreferred)
+	})
 }
 
 func TestBuildAS3CommonTenant(t *testing.T) {
@@ -492,6 +820,7 @@ func TestBuildAS3CommonTenant(t *testing.T) {
 			{Id: "member4", Address: "200.10.0.4", Port: 80},
 			{Id: "member5", Address: "200.10.0.5", Port: 80},
 		}, nil)
+		store.On("GetGeomaps").Return([]*rpcmodels.Geomap{}, nil)
 		tenant, req, err := buildAS3CommonTenant(store, datacenters, domains)
 		expectedTenant := as3.Tenant{}
 		application := as3.Application{Template: "shared"}
@@ -574,6 +903,7 @@ func TestBuildAS3CommonTenant(t *testing.T) {
 			{Id: "member1", Address: "200.10.0.1", Port: 80, PoolId: "pool1-uuid"},
 			{Id: "member2", Address: "200.10.0.2", Port: 80, PoolId: "pool1-uuid"},
 		}, nil)
+		store.On("GetGeomaps").Return([]*rpcmodels.Geomap{}, nil)
 		tenant, req, err := buildAS3CommonTenant(store, datacenters, domains)
 		expectedTenant := as3.Tenant{}
 		application := as3.Application{Template: "shared"}
@@ -614,6 +944,71 @@ func TestBuildAS3CommonTenant(t *testing.T) {
 	})
 }
 
+func TestAddAS3Topology(t *testing.T) {
+	assert := assert.New(t)
+
+	datacenters := []*rpcmodels.Datacenter{
+		{Id: "dc1-uuid", Name: "dc1"},
+		{Id: "dc2-uuid", Name: "dc2"},
+	}
+	geomaps := []*rpcmodels.Geomap{
+		{
+			Id:                "geomap1-uuid",
+			DefaultDatacenter: "dc1-uuid",
+			Assignment: []*rpcmodels.GeomapAssignment{
+				{Datacenter: "dc2-uuid", Countries: []string{"DE", "FR"}},
+			},
+		},
+		{
+			Id:                 "geomap2-uuid",
+			DefaultDatacenter:  "dc1-uuid",
+			ProvisioningStatus: server.ProvisioningStatusRequest_ProvisioningStatus_PENDING_DELETE.String(),
+		},
+		{
+			Id:                "geomap3-uuid",
+			DefaultDatacenter: "dc1-uuid",
+			Assignment: []*rpcmodels.GeomapAssignment{
+				{Datacenter: "dc3-uuid", Countries: []string{"US"}},
+			},
+		},
+	}
+	application := as3.Application{Template: "shared"}
+	req := addAS3Topology(&application, datacenters, geomaps)
+
+	expectedApplication := as3.Application{Template: "shared"}
+	expectedApplication.SetEntity("cc_andromeda_geomap_geomap1-uuid", as3.GSLBTopologyRegion{
+		Class: "GSLB_Topology_Region",
+		RegionMembers: []as3.GSLBTopologyMatch{
+			{MatchType: "country", MatchOperator: "equals", MatchValue: "DE"},
+			{MatchType: "country", MatchOperator: "equals", MatchValue: "FR"},
+		},
+	})
+	dc1 := as3.GSLBTopologyMatch{MatchType: "datacenter", MatchValue: as3.PointerGSLBDataCenter{BigIP: "/Common/dc1"}}
+	dc2 := as3.GSLBTopologyMatch{MatchType: "datacenter", MatchValue: as3.PointerGSLBDataCenter{BigIP: "/Common/dc2"}}
+	expectedApplication.SetEntity("cc_andromeda_topology", as3.GSLBTopologyRecords{
+		Class: "GSLB_Topology_Records",
+		Records: []as3.GSLBTopologyRecord{
+			{Source: as3.GSLBTopologyMatch{MatchType: "country", MatchOperator: "equals", MatchValue: "DE"}, Destination: dc2, Weight: 100},
+			{Source: as3.GSLBTopologyMatch{MatchType: "country", MatchOperator: "equals", MatchValue: "FR"}, Destination: dc2, Weight: 100},
+			{
+				Source: as3.GSLBTopologyMatch{
+					MatchType:     "region",
+					MatchOperator: "not-equals",
+					MatchValue:    as3.PointerGSLBTopologyRegion{Use: "cc_andromeda_geomap_geomap1-uuid"},
+				},
+				Destination: dc1,
+				Weight:      10,
+			},
+		},
+	})
+	assert.Equal(expectedApplication, application)
+	assert.Equal([]*server.ProvisioningStatusRequest_ProvisioningStatus{
+		{Id: "geomap1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_GEOGRAPHIC_MAP, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+		{Id: "geomap2-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_GEOGRAPHIC_MAP, Status: server.ProvisioningStatusRequest_ProvisioningStatus_DELETED},
+		{Id: "geomap3-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_GEOGRAPHIC_MAP, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ERROR, Error: "unknown datacenter dc3-uuid assigned to US"},
+	}, req)
+}
+
 func TestPostAS3Declaration(t *testing.T) {
 	assert := assert.New(t)
 
@@ -624,7 +1019,7 @@ func TestPostAS3Declaration(t *testing.T) {
 			assert.Equal(d.Label, decl.Label)
 			return errors.New("nope")
 		}
-		err := postAS3Declaration(decl, client, declChecker)
+		err := postAS3Declaration(decl, client, declChecker, "")
 		assert.NotNil(err, "it should have failed")
 		client.AssertNotCalled(t, "APICall")
 	})
@@ -641,7 +1036,7 @@ func TestPostAS3Declaration(t *testing.T) {
 			client := new(mockedAS3Client)
 			client.On("PostAs3Bigip", mock.Anything, "", "").Return(nil, "", "")
 			declChecker := func(d as3.ADC) error { return nil }
-			err := postAS3Declaration(decl, client, declChecker)
+			err := postAS3Declaration(decl, client, declChecker, "")
 			assert.Nil(err, "it should have succeeded")
 			client.AssertCalled(t, "PostAs3Bigip", expectedJSONDecl, "", "")
 		})
@@ -650,16 +1045,34 @@ func TestPostAS3Declaration(t *testing.T) {
 			client := new(mockedAS3Client)
 			client.On("PostAs3Bigip", mock.Anything, "", "").Return(errors.New("it failed, please let the caller now"), "", "")
 			declChecker := func(d as3.ADC) error { return nil }
-			err := postAS3Declaration(decl, client, declChecker)
+			err := postAS3Declaration(decl, client, declChecker, "")
 			assert.NotNil(err, "it should have failed")
 			client.AssertCalled(t, "PostAs3Bigip", mock.Anything, "", "")
 		})
 
+		t.Run("it should only fail as rejected if AS3 refused the declaration with a client error", func(t *testing.T) {
+			for cause, rejected := range map[string]bool{
+				"Error :[map[dataPath:/Common message:should have required property 'class']]":                           true,
+				`Tenant Creation failed. Response: [{"code": 422, "message": "declaration failed", "tenant": "Common"}]`: true,
+				`as3 config post error response [{"code": 200, "tenant": "Common"}, {"code": 404, "tenant": "t"}]`:       true,
+				`Tenant Creation failed. Response: [{"code": 500, "message": "internal error"}]`:                         false,
+				"HTTP 502 :: Bad Gateway": false,
+				"Post \"https://bigip/mgmt/shared/appsvcs/declare\": context deadline exceeded": false,
+			} {
+				client := new(mockedAS3Client)
+				client.On("PostAs3Bigip", mock.Anything, "", "").Return(errors.New(cause), "", "")
+				declChecker := func(d as3.ADC) error { return nil }
+				err := postAS3Declaration(decl, client, declChecker, "")
+				var rejection *as3RejectionError
+				assert.Equal(rejected, errors.As(err, &rejection), cause)
+			}
+		})
+
 		t.Run("it should succeed if posting succeeds (checking PostAs3Bigip() error return value is enough)", func(t *testing.T) {
 			client := new(mockedAS3Client)
 			client.On("PostAs3Bigip", mock.Anything, "", "").Return(nil, "", "")
 			declChecker := func(d as3.ADC) error { return nil }
-			err := postAS3Declaration(decl, client, declChecker)
+			err := postAS3Declaration(decl, client, declChecker, "")
 			assert.Nil(err, "it should have succeeded")
 			client.AssertCalled(t, "PostAs3Bigip", mock.Anything, "", "")
 		})
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package f5
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/actatum/stormrpc"
+	"github.com/f5devcentral/go-bigip"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver/f5/as3"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+)
+
+const (
+	diffActionCreate = "create"
+	diffActionUpdate = "update"
+	diffActionDelete = "delete"
+)
+
+// declarationDiff is the structured difference between the deployed and the desired AS3 declaration
+type declarationDiff struct {
+	Tenants []tenantDiff `json:"tenants"`
+}
+
+type tenantDiff struct {
+	Tenant       string            `json:"tenant"`
+	Action       string            `json:"action"`
+	Applications []applicationDiff `json:"applications"`
+}
+
+type applicationDiff struct {
+	Application string              `json:"application"`
+	Action      string              `json:"action"`
+	Changes     []declarationChange `json:"changes,omitempty"`
+}
+
+type declarationChange struct {
+	Path     string `json:"path"`
+	Deployed any    `json:"deployed,omitempty"`
+	Desired  any    `json:"desired,omitempty"`
+}
+
+// declarationDryRun builds the AS3 declaration like declarationSync, but instead of posting it
+// returns its difference to the declaration currently deployed on the device.
+func declarationDryRun(f5Config config.F5Config, session bigIPSession, rpc server.RPCServerClient, _ stormrpc.Request) (any, error) {
+	decl, _, err := buildAS3Declaration(f5Config, NewAndromedaF5Store(rpc), buildAS3CommonTenant, buildAS3DomainTenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := sanityCheckAS3Declaration(decl); err != nil {
+		return nil, err
+	}
+	deployed, err := fetchAS3Declaration(session)
+	if err != nil {
+		return nil, err
+	}
+	return diffAS3Declaration(decl, deployed)
+}
+
+// fetchAS3Declaration returns the declaration deployed on the device, which is empty if AS3 has none.
+func fetchAS3Declaration(session bigIPSession) (map[string]any, error) {
+	resp, err := session.APICall(&bigip.APIRequest{
+		Method:      "get",
+		URL:         "mgmt/shared/appsvcs/declare",
+		ContentType: "application/json",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch deployed AS3 declaration: %w", err)
+	}
+
+	deployed := map[string]any{}
+	if len(resp) == 0 {
+		return deployed, nil
+	}
+	if err := json.Unmarshal(resp, &deployed); err != nil {
+		return nil, fmt.Errorf("could not unmarshal deployed AS3 declaration: %w", err)
+	}
+	return deployed, nil
+}
+
+// diffAS3Declaration diffs the tenants and applications of both declarations, properties outside
+// of applications (e.g. tenant labels) are not considered.
+func diffAS3Declaration(desired as3.ADC, deployed map[string]any) (declarationDiff, error) {
+	diff := declarationDiff{Tenants: []tenantDiff{}}
+
+	jsonDecl, err := json.Marshal(desired)
+	if err != nil {
+		return diff, err
+	}
+	var desiredDecl map[string]any
+	if err := json.Unmarshal(jsonDecl, &desiredDecl); err != nil {
+		return diff, err
+	}
+
+	desiredTenants := childrenOfClass(desiredDecl, "Tenant")
+	deployedTenants := childrenOfClass(deployed, "Tenant")
+	for _, name := range sortedKeys(desiredTenants, deployedTenants) {
+		desiredTenant, inDesired := desiredTenants[name]
+		deployedTenant, inDeployed := deployedTenants[name]
+
+		tenant := tenantDiff{Tenant: name, Action: diffActionUpdate, Applications: []applicationDiff{}}
+		switch {
+		case !inDeployed:
+			tenant.Action = diffActionCreate
+		case !inDesired:
+			tenant.Action = diffActionDelete
+		}
+
+		desiredApps := childrenOfClass(desiredTenant, "Application")
+		deployedApps := childrenOfClass(deployedTenant, "Application")
+		for _, appName := range sortedKeys(desiredApps, deployedApps) {
+			desiredApp, appInDesired := desiredApps[appName]
+			deployedApp, appInDeployed := deployedApps[appName]
+
+			switch {
+			case !appInDeployed:
+				tenant.Applications = append(tenant.Applications,
+					applicationDiff{Application: appName, Action: diffActionCreate})
+			case !appInDesired:
+				tenant.Applications = append(tenant.Applications,
+					applicationDiff{Application: appName, Action: diffActionDelete})
+			default:
+				if changes := diffValues("/"+name+"/"+appName, deployedApp, desiredApp); len(changes) > 0 {
+					tenant.Applications = append(tenant.Applications,
+						applicationDiff{Application: appName, Action: diffActionUpdate, Changes: changes})
+				}
+			}
+		}
+
+		if tenant.Action != diffActionUpdate || len(tenant.Applications) > 0 {
+			diff.Tenants = append(diff.Tenants, tenant)
+		}
+	}
+	return diff, nil
+}
+
+// childrenOfClass returns all properties of an AS3 object, which are objects of the given AS3 class
+func childrenOfClass(object map[string]any, class string) map[string]map[string]any {
+	children := make(map[string]map[string]any)
+	for key, value := range object {
+		if child, ok := value.(map[string]any); ok && child["class"] == class {
+			children[key] = child
+		}
+	}
+	return children
+}
+
+func sortedKeys[V any](a, b map[string]V) []string {
+	keys := slices.Collect(maps.Keys(a))
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// diffValues recursively compares JSON values, returning the changed leaf values by JSON pointer path
+func diffValues(path string, deployed, desired any) []declarationChange {
+	deployedObject, deployedIsObject := deployed.(map[string]any)
+	desiredObject, desiredIsObject := desired.(map[string]any)
+	if deployedIsObject && desiredIsObject {
+		var changes []declarationChange
+		for _, key := range sortedKeys(desiredObject, deployedObject) {
+			changes = append(changes, diffValues(path+"/"+key, deployedObject[key], desiredObject[key])...)
+		}
+		return changes
+	}
+
+	if reflect.DeepEqual(deployed, desired) {
+		return nil
+	}
+	return []declarationChange{{Path: path, Deployed: deployed, Desired: desired}}
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package f5
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/sapcc/andromeda/internal/driver/f5/as3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testADC(poolLbMode string, tenants ...string) as3.ADC {
+	adc := as3.NewADC()
+	for _, name := range tenants {
+		app := as3.Application{Template: "shared"}
+		app.SetEntity("domain", as3.GSLBDomain{Class: "GSLB_Domain", DomainName: name + ".example.com", PoolLbMode: poolLbMode})
+		tenant := as3.Tenant{}
+		tenant.AddApplication("application", app)
+		adc.AddTenant(name, tenant)
+	}
+	return adc
+}
+
+func deployedDeclaration(t *testing.T, adc as3.ADC) map[string]any {
+	data, err := json.Marshal(adc)
+	require.NoError(t, err)
+	var deployed map[string]any
+	require.NoError(t, json.Unmarshal(data, &deployed))
+	return deployed
+}
+
+func TestDiffAS3Declaration(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("Returns no tenants if the declarations are equal", func(t *testing.T) {
+		diff, err := diffAS3Declaration(testADC("round-robin", "domain_a"),
+			deployedDeclaration(t, testADC("round-robin", "domain_a")))
+		assert.NoError(err)
+		assert.Empty(diff.Tenants)
+	})
+
+	t.Run("Returns created and deleted tenants", func(t *testing.T) {
+		diff, err := diffAS3Declaration(testADC("round-robin", "domain_a", "domain_b"),
+			deployedDeclaration(t, testADC("round-robin", "domain_a", "domain_c")))
+		assert.NoError(err)
+		assert.Equal([]tenantDiff{
+			{Tenant: "domain_b", Action: diffActionCreate, Applications: []applicationDiff{
+				{Application: "application", Action: diffActionCreate},
+			}},
+			{Tenant: "domain_c", Action: diffActionDelete, Applications: []applicationDiff{
+				{Application: "application", Action: diffActionDelete},
+			}},
+		}, diff.Tenants)
+	})
+
+	t.Run("Returns changed application properties by path", func(t *testing.T) {
+		diff, err := diffAS3Declaration(testADC("ratio", "domain_a"),
+			deployedDeclaration(t, testADC("round-robin", "domain_a")))
+		assert.NoError(err)
+		assert.Equal([]tenantDiff{
+			{Tenant: "domain_a", Action: diffActionUpdate, Applications: []applicationDiff{
+				{Application: "application", Action: diffActionUpdate, Changes: []declarationChange{
+					{Path: "/domain_a/application/domain/poolLbMode", Deployed: "round-robin", Desired: "ratio"},
+				}},
+			}},
+		}, diff.Tenants)
+	})
+}
+
+func TestFetchAS3Declaration(t *testing.T) {
+	assert := assert.New(t)
+	request := &bigip.APIRequest{Method: "get", URL: "mgmt/shared/appsvcs/declare", ContentType: "application/json"}
+
+	t.Run("Returns an empty declaration if none is deployed", func(t *testing.T) {
+		session := new(mockedBigIPSession)
+		session.On("APICall", request).Return([]byte{}, nil)
+		deployed, err := fetchAS3Declaration(session)
+		assert.NoError(err)
+		assert.Empty(deployed)
+	})
+
+	t.Run("Fails if the API call fails", func(t *testing.T) {
+		session := new(mockedBigIPSession)
+		session.On("APICall", request).Return([]byte{}, errors.New("API call failed"))
+		_, err := fetchAS3Declaration(session)
+		assert.ErrorContains(err, "API call failed")
+	})
+
+	t.Run("Returns the deployed declaration", func(t *testing.T) {
+		session := new(mockedBigIPSession)
+		session.On("APICall", request).Return([]byte(`{"class": "ADC", "Common": {"class": "Tenant"}}`), nil)
+		deployed, err := fetchAS3Declaration(session)
+		assert.NoError(err)
+		assert.Contains(deployed, "Common")
+	})
+}
@@ -7,12 +7,14 @@ package f5
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/apex/log"
 	"github.com/f5devcentral/go-bigip"
 
 	"github.com/sapcc/andromeda/internal/rpc/server"
 	"github.com/sapcc/andromeda/internal/rpcmodels"
+	"github.com/sapcc/andromeda/internal/utils"
 )
 
 type MembersCollectionStats struct {
@@ -70,15 +72,22 @@ func buildMemberStatusUpdateRequest(session bigIPSession, store AndromedaF5Store
 					log.Warnf("nil datacenter for member [member ID = %s]", m.Id)
 					continue
 				}
-				urlPath := poolTypeAMemberStatsURL(
+				// members are part of the pool of their address family
+				recordType := utils.AddressRecordType(m.Address)
+				if recordType == "" {
+					// hostname members of CNAME and MX pools have no GSLB server to report on
+					continue
+				}
+				urlPath := poolMemberStatsURL(
+					recordType,
 					as3DeclarationGSLBDomainTenantKey(d.Id),
-					as3DeclarationGSLBPoolKey(p.Id),
+					as3DeclarationGSLBPoolKey(p.Id, recordType),
 					as3DeclarationGSLBServerKey(m.Address, datacentersByID[m.DatacenterId].Name),
 					as3DeclarationGSLBVirtualServerName(m.Address, m.Port),
 				)
 				membersStats, err := fetchPoolTypeAMemberStats(session, urlPath)
 				if err != nil {
-					log.Warnf("failed to determine GSLB_Pool_Member_A status [BigIP URL path = %s]: %s", urlPath, err)
+					log.Warnf("failed to determine GSLB_Pool_Member_%s status [BigIP URL path = %s]: %s", recordType, urlPath, err)
 				}
 				updates = append(updates, &server.MemberStatusRequest_MemberStatus{
 					Id:     m.Id,
@@ -90,8 +99,8 @@ func buildMemberStatusUpdateRequest(session bigIPSession, store AndromedaF5Store
 	return &server.MemberStatusRequest{MemberStatus: updates}, nil
 }
 
-func poolTypeAMemberStatsURL(gslbDomainTenantKey, gslbPoolKey, gslbServerKey, gslbVirtualServerName string) string {
-	return fmt.Sprintf("gtm/pool/a/~%s~application~%s/members/~Common~%s:%s/stats", gslbDomainTenantKey, gslbPoolKey, gslbServerKey, gslbVirtualServerName)
+func poolMemberStatsURL(recordType, gslbDomainTenantKey, gslbPoolKey, gslbServerKey, gslbVirtualServerName string) string {
+	return fmt.Sprintf("gtm/pool/%s/~%s~application~%s/members/~Common~%s:%s/stats", strings.ToLower(recordType), gslbDomainTenantKey, gslbPoolKey, gslbServerKey, gslbVirtualServerName)
 }
 
 func serverStatsURL(gslbServerKey string) string {
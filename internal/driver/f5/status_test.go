@@ -79,6 +79,23 @@ func TestBuildMemberStatusUpdateRequest(t *testing.T) {
 			{Id: "member4-uuid", Status: server.MemberStatusRequest_MemberStatus_UNKNOWN}}}
 		assert.Equal(expectedReq, req)
 	})
+
+	t.Run("Fetches the status of IPv6 members from the AAAA pool", func(t *testing.T) {
+		expectedURLPath := "gtm/pool/aaaa/~domain_dom1-uuid~application~pool_pool1-uuid_aaaa/members/~Common~cc_andromeda_srv_2001-db8--11_dc1-name:2001:db8::11.80/stats"
+		session := new(mockedBigIPSession)
+		session.
+			On("APICall", &bigip.APIRequest{Method: "get", ContentType: "application/json", URL: expectedURLPath}).
+			Return([]byte(`{"entries": {"theKey": {"nestedStats": {"entries": {"status.availabilityState": {"description": "available"}}}}}}`), nil)
+		store := new(mockedStore)
+		store.On("GetDatacenters").Return([]*rpcmodels.Datacenter{{Id: "dc1-uuid", Name: "dc1-name"}}, nil)
+		store.On("GetDomains").Return([]*rpcmodels.Domain{{Id: "dom1-uuid", Pools: []*rpcmodels.Pool{
+			{Id: "pool1-uuid", Members: []*rpcmodels.Member{
+				{Id: "member1-uuid", Address: "2001:db8::11", Port: 80, DatacenterId: "dc1-uuid"}}}}}}, nil)
+		req, err := buildMemberStatusUpdateRequest(session, store)
+		assert.Nil(err)
+		assert.Equal(&server.MemberStatusRequest{MemberStatus: []*server.MemberStatusRequest_MemberStatus{
+			{Id: "member1-uuid", Status: server.MemberStatusRequest_MemberStatus_ONLINE}}}, req)
+	})
 }
 
 func TestFetchPoolTypeAMemberStats(t *testing.T) {
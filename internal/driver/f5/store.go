@@ -16,6 +16,7 @@ type AndromedaF5Store interface {
 	GetDatacenters() ([]*rpcmodels.Datacenter, error)
 	GetDomains() ([]*rpcmodels.Domain, error)
 	GetMembers(datacenterID string) ([]*rpcmodels.Member, error)
+	GetGeomaps() ([]*rpcmodels.Geomap, error)
 }
 
 type andromedaF5Store struct {
@@ -75,3 +76,20 @@ func (s *andromedaF5Store) GetMembers(datacenterId string) ([]*rpcmodels.Member,
 	}
 	return res.GetResponse(), nil
 }
+
+func (s *andromedaF5Store) GetGeomaps() ([]*rpcmodels.Geomap, error) {
+	// topology records are global, the AS3 POST /declare payload must include *all* geomaps
+	res, err := s.rpc.GetGeomaps(context.Background(), &server.SearchRequest{
+		Provider:       "f5",
+		PageNumber:     0,
+		ResultPerPage:  1000,
+		FullyPopulated: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rpc.GetGeomaps failed: %s", err)
+	}
+	if res == nil {
+		return nil, fmt.Errorf("rpc.GetGeomaps response is nil")
+	}
+	return res.GetResponse(), nil
+}
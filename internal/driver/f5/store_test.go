@@ -92,3 +92,43 @@ func TestGetMembers(t *testing.T) {
 		assert.Equal(expected, datacenters)
 	})
 }
+
+func TestGetGeomaps(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("When RPC call fails", func(t *testing.T) {
+		client := new(mockedRPCClient)
+		client.
+			On("GetGeomaps",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+			Return(&server.GeomapsResponse{},
+				errors.New("RPC failed"))
+		store := andromedaF5Store{rpc: client}
+		_, err := store.GetGeomaps()
+		assert.NotNil(err, "Expected store.GetGeomaps() to have returned an error")
+	})
+
+	t.Run("When RPC call returns F5 geomaps", func(t *testing.T) {
+		client := new(mockedRPCClient)
+		client.
+			On("GetGeomaps",
+				mock.Anything,
+				mock.MatchedBy(func(req *server.SearchRequest) bool { return req.Provider == "f5" }),
+				mock.Anything,
+			).
+			Return(&server.GeomapsResponse{
+				Response: []*rpcmodels.Geomap{
+					{Id: "geomap1"},
+				},
+			}, nil)
+		store := andromedaF5Store{rpc: client}
+		expected := []*rpcmodels.Geomap{
+			{Id: "geomap1"},
+		}
+		geomaps, err := store.GetGeomaps()
+		assert.Nil(err, "Expected store.GetGeomaps() to not have returned an error")
+		assert.Equal(expected, geomaps)
+	})
+}
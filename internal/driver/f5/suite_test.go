@@ -69,6 +69,11 @@ func (s *mockedStore) GetMembers(datacenterId string) ([]*rpcmodels.Member, erro
 	return args.Get(0).([]*rpcmodels.Member), args.Error(1)
 }
 
+func (s *mockedStore) GetGeomaps() ([]*rpcmodels.Geomap, error) {
+	args := s.Called()
+	return args.Get(0).([]*rpcmodels.Geomap), args.Error(1)
+}
+
 type mockedRPCClient struct {
 	mock.Mock
 }
@@ -117,3 +122,13 @@ func (c *mockedRPCClient) UpdateDatacenterMeta(ctx context.Context, in *server.D
 	args := c.Called(ctx, in, opts)
 	return args.Get(0).(*rpcmodels.Datacenter), args.Error(1)
 }
+
+func (c *mockedRPCClient) AcquireLeadership(ctx context.Context, in *server.LeadershipRequest, opts ...stormrpc.CallOption) (*server.LeadershipResponse, error) {
+	args := c.Called(ctx, in, opts)
+	return args.Get(0).(*server.LeadershipResponse), args.Error(1)
+}
+
+func (c *mockedRPCClient) Heartbeat(ctx context.Context, in *server.HeartbeatRequest, opts ...stormrpc.CallOption) (*server.HeartbeatResponse, error) {
+	args := c.Called(ctx, in, opts)
+	return args.Get(0).(*server.HeartbeatResponse), args.Error(1)
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/sapcc/go-api-declarations/bininfo"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+)
+
+// Agent capabilities announced to the agent registry
+const (
+	// CapabilitySync provisions domains on the provider, the API requires a live agent with this capability
+	CapabilitySync         = "sync"
+	CapabilityMemberStatus = "member_status"
+	CapabilityHealthCheck  = "health_check"
+	CapabilityMetrics      = "metrics"
+	CapabilityDiff         = "diff"
+	CapabilityDriftCheck   = "drift_check"
+	CapabilityCIDRBlocks   = "cidr_blocks"
+)
+
+// ErrAgentDisabled is returned for requests to agents disabled in the agent registry
+var ErrAgentDisabled = errors.New("agent has been disabled in the agent registry")
+
+// Heartbeat registers the agent in the agent registry of Andromeda Server and keeps it alive
+type Heartbeat struct {
+	rpc      server.RPCServerClient
+	request  *server.HeartbeatRequest
+	lastSync atomic.Int64
+	disabled atomic.Bool
+}
+
+func NewHeartbeat(rpc server.RPCServerClient, agentType string, providers, capabilities []string) *Heartbeat {
+	return &Heartbeat{
+		rpc: rpc,
+		request: &server.HeartbeatRequest{
+			Host:         Hostname(),
+			Type:         agentType,
+			Version:      bininfo.VersionOr("unknown"),
+			Providers:    providers,
+			Capabilities: capabilities,
+		},
+	}
+}
+
+// Hostname returns the configured host, else the hostname reported by the kernel
+func Hostname() string {
+	if config.Global.Default.Host != "" {
+		return config.Global.Default.Host
+	}
+	host, _ := os.Hostname()
+	return host
+}
+
+// Synced records a successful sync, reported with the next heartbeat
+func (h *Heartbeat) Synced() {
+	if h != nil {
+		h.lastSync.Store(time.Now().Unix())
+	}
+}
+
+// Disabled returns true if the agent has been disabled in the agent registry, disabled agents skip
+// their work until they are enabled again
+func (h *Heartbeat) Disabled() bool {
+	return h != nil && h.disabled.Load()
+}
+
+// Run sends heartbeats until ctx is done
+func (h *Heartbeat) Run(ctx context.Context, interval time.Duration) {
+	if interval == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		h.send(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Heartbeat) send(ctx context.Context) {
+	h.request.LastSync = h.lastSync.Load()
+	resp, err := h.rpc.Heartbeat(ctx, h.request)
+	if err != nil {
+		log.WithError(err).Warn("Failed sending heartbeat")
+		return
+	}
+	if disabled := !resp.GetAdminStateUp(); h.disabled.Swap(disabled) != disabled && disabled {
+		log.Warnf("Agent %s on %s has been disabled in the agent registry", h.request.Type, h.request.Host)
+	}
+}
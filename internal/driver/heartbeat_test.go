@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/actatum/stormrpc"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sapcc/andromeda/internal/rpc/server"
+)
+
+type heartbeatRPCClient struct {
+	server.RPCServerClient
+	requests []*server.HeartbeatRequest
+	disabled bool
+}
+
+func (c *heartbeatRPCClient) Heartbeat(_ context.Context, in *server.HeartbeatRequest, _ ...stormrpc.CallOption) (*server.HeartbeatResponse, error) {
+	c.requests = append(c.requests, &server.HeartbeatRequest{LastSync: in.LastSync, Type: in.Type, Providers: in.Providers})
+	return &server.HeartbeatResponse{AdminStateUp: !c.disabled}, nil
+}
+
+func TestHeartbeat(t *testing.T) {
+	rpc := &heartbeatRPCClient{}
+	h := NewHeartbeat(rpc, AgentNoop, []string{"noop"}, []string{CapabilitySync})
+
+	h.send(context.Background())
+	h.Synced()
+	h.send(context.Background())
+
+	if assert.Len(t, rpc.requests, 2) {
+		assert.Equal(t, AgentNoop, rpc.requests[0].Type)
+		assert.Equal(t, []string{"noop"}, rpc.requests[0].Providers)
+		assert.Zero(t, rpc.requests[0].LastSync, "no sync yet")
+		assert.InDelta(t, time.Now().Unix(), rpc.requests[1].LastSync, 1)
+	}
+
+	// the admin state of the agent registry is applied with the next heartbeat
+	assert.False(t, h.Disabled())
+	rpc.disabled = true
+	h.send(context.Background())
+	assert.True(t, h.Disabled())
+	rpc.disabled = false
+	h.send(context.Background())
+	assert.False(t, h.Disabled())
+
+	// a nil heartbeat is ignored
+	var disabled *Heartbeat
+	disabled.Synced()
+	assert.False(t, disabled.Disabled())
+}
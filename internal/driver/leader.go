@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/actatum/stormrpc"
+	"github.com/apex/log"
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sapcc/andromeda/internal/rpc/server"
+)
+
+// forwardTimeout bounds requests forwarded to the leader if the caller set no deadline
+const forwardTimeout = 5 * time.Minute
+
+// LeaderSubjectPrefix prefixes the RPC subjects unique to each replica, used for forwarding requests
+// to the leader: andromeda.leader.<election>.<holder>.<subject>
+const LeaderSubjectPrefix = "andromeda.leader."
+
+var (
+	leaderGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "andromeda_leader",
+			Help: "1 if this replica is the leader of the election, else 0.",
+		},
+		[]string{"election", "holder"},
+	)
+	leaderTransitionsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "andromeda_leader_transitions_total",
+			Help: "Number of leadership changes observed by this replica.",
+		},
+		[]string{"election"},
+	)
+
+	errNoLeader = errors.New("no leader elected yet, retry later")
+
+	invalidSubjectChars = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+)
+
+func init() {
+	prometheus.MustRegister(leaderGauge, leaderTransitionsCounter)
+}
+
+// LeaderElection elects one leader among the replicas of an agent via a lease renewed over RPC.
+// Only the leader may write to the provider, followers take over once the lease expires.
+type LeaderElection struct {
+	rpc           server.RPCServerClient
+	name          string
+	holder        string
+	leaseDuration time.Duration
+
+	mu      sync.RWMutex
+	leader  string
+	elected chan struct{}
+}
+
+// NewLeaderElection creates an election identified by name. With a lease duration of 0, leader
+// election is disabled and this replica always leads.
+func NewLeaderElection(rpc server.RPCServerClient, name string, leaseDuration time.Duration) *LeaderElection {
+	holder := invalidSubjectChars.ReplaceAllString(fmt.Sprintf("%s-%d", Hostname(), os.Getpid()), "_")
+
+	e := &LeaderElection{
+		rpc:           rpc,
+		name:          name,
+		holder:        holder,
+		leaseDuration: leaseDuration,
+		elected:       make(chan struct{}, 1),
+	}
+	leaderGauge.WithLabelValues(name, holder).Set(0)
+	return e
+}
+
+// IsLeader returns true if this replica holds the lease
+func (e *LeaderElection) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leader == e.holder
+}
+
+// Elected signals whenever this replica becomes leader, e.g. to run a full sync after taking over.
+// Returns nil for a nil election.
+func (e *LeaderElection) Elected() <-chan struct{} {
+	if e == nil {
+		return nil
+	}
+	return e.elected
+}
+
+func (e *LeaderElection) Leader() string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leader
+}
+
+// Run renews the lease until ctx is done, then releases it
+func (e *LeaderElection) Run(ctx context.Context) {
+	if e.leaseDuration == 0 {
+		e.setLeader(e.holder)
+		return
+	}
+
+	// renew well before the lease expires
+	ticker := time.NewTicker(e.leaseDuration / 3)
+	defer ticker.Stop()
+	for {
+		e.renew(false)
+		select {
+		case <-ctx.Done():
+			e.renew(true)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *LeaderElection) renew(release bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.leaseDuration/3)
+	defer cancel()
+
+	leader := ""
+	resp, err := e.rpc.AcquireLeadership(ctx, &server.LeadershipRequest{
+		Name:          e.name,
+		Holder:        e.holder,
+		LeaseDuration: int32(e.leaseDuration.Seconds()),
+		Release:       release,
+	})
+	if err != nil {
+		// the lease can't be confirmed, step down before another replica may take over
+		log.WithError(err).WithField("election", e.name).Error("Failed renewing leadership")
+	} else {
+		leader = resp.GetHolder()
+	}
+	e.setLeader(leader)
+}
+
+func (e *LeaderElection) setLeader(leader string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if leader == e.leader {
+		return
+	}
+	leaderTransitionsCounter.WithLabelValues(e.name).Inc()
+	switch {
+	case leader == e.holder:
+		log.WithField("election", e.name).Infof("Became leader as %s", e.holder)
+		leaderGauge.WithLabelValues(e.name, e.holder).Set(1)
+		select {
+		case e.elected <- struct{}{}:
+		default:
+		}
+	case e.leader == e.holder:
+		log.WithField("election", e.name).Warnf("Lost leadership to %q", leader)
+		leaderGauge.WithLabelValues(e.name, e.holder).Set(0)
+	default:
+		log.WithField("election", e.name).Infof("Following leader %q", leader)
+	}
+	e.leader = leader
+}
+
+// Handle registers fn on the subject, requests received by followers are forwarded to the leader.
+// All replicas receive forwarded requests on their own leader subject.
+func (e *LeaderElection) Handle(srv *stormrpc.Server, nc *nats.Conn, subject string, fn stormrpc.HandlerFunc) {
+	srv.Handle(e.leaderSubject(e.holder, subject), fn)
+	srv.Handle(subject, func(ctx context.Context, req stormrpc.Request) stormrpc.Response {
+		if e.IsLeader() {
+			return fn(ctx, req)
+		}
+		leader := e.Leader()
+		if leader == "" {
+			return stormrpc.NewErrorResponse(req.Reply, errNoLeader)
+		}
+
+		forwardCtx, cancel := forwardContext(ctx, req)
+		defer cancel()
+		msg, err := nc.RequestMsgWithContext(forwardCtx, &nats.Msg{
+			Subject: e.leaderSubject(leader, subject),
+			Header:  req.Header,
+			Data:    req.Data,
+		})
+		if err != nil {
+			return stormrpc.NewErrorResponse(req.Reply, fmt.Errorf("forwarding to leader %s failed: %w", leader, err))
+		}
+		return stormrpc.Response{Msg: msg}
+	})
+}
+
+// forwardContext returns the context for forwarding the request to the leader. Without deadline set by
+// the caller, the handler's context expires after the default server timeout of stormrpc, while the leader
+// only replies once the requested sync finished.
+func forwardContext(ctx context.Context, req stormrpc.Request) (context.Context, context.CancelFunc) {
+	if req.Header.Get("stormrpc-deadline") != "" {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(context.WithoutCancel(ctx), forwardTimeout)
+}
+
+func (e *LeaderElection) leaderSubject(holder, subject string) string {
+	return LeaderSubjectPrefix + strings.Join([]string{e.name, holder, subject}, ".")
+}
+
+// ParseLeaderSubject returns election and holder of a leader subject
+func ParseLeaderSubject(subject string) (election, holder string, ok bool) {
+	parts := strings.SplitN(strings.TrimPrefix(subject, LeaderSubjectPrefix), ".", 3)
+	if !strings.HasPrefix(subject, LeaderSubjectPrefix) || len(parts) < 3 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
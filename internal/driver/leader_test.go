@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/actatum/stormrpc"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sapcc/andromeda/internal/rpc/server"
+)
+
+// leaseRPCClient serves AcquireLeadership from a single in-memory lease
+type leaseRPCClient struct {
+	server.RPCServerClient
+	holder string
+	err    error
+}
+
+func (c *leaseRPCClient) AcquireLeadership(_ context.Context, in *server.LeadershipRequest, _ ...stormrpc.CallOption) (*server.LeadershipResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	switch {
+	case in.Release && c.holder == in.Holder:
+		c.holder = ""
+	case !in.Release && c.holder == "":
+		c.holder = in.Holder
+	}
+	return &server.LeadershipResponse{Leader: c.holder == in.Holder, Holder: c.holder}, nil
+}
+
+func TestLeaderElection(t *testing.T) {
+	t.Run("First replica becomes leader", func(t *testing.T) {
+		rpc := &leaseRPCClient{}
+		e := NewLeaderElection(rpc, "test", 30*time.Second)
+		assert.False(t, e.IsLeader())
+
+		e.renew(false)
+		assert.True(t, e.IsLeader())
+		assert.Equal(t, e.holder, e.Leader())
+		select {
+		case <-e.Elected():
+		default:
+			t.Fatal("becoming leader not signalled")
+		}
+	})
+
+	t.Run("Replica follows the lease holder", func(t *testing.T) {
+		rpc := &leaseRPCClient{holder: "other"}
+		e := NewLeaderElection(rpc, "test", 30*time.Second)
+
+		e.renew(false)
+		assert.False(t, e.IsLeader())
+		assert.Equal(t, "other", e.Leader())
+		assert.Empty(t, e.Elected())
+	})
+
+	t.Run("Leader steps down when the lease can't be renewed", func(t *testing.T) {
+		rpc := &leaseRPCClient{}
+		e := NewLeaderElection(rpc, "test", 30*time.Second)
+		e.renew(false)
+		assert.True(t, e.IsLeader())
+
+		rpc.err = errors.New("no servers available")
+		e.renew(false)
+		assert.False(t, e.IsLeader())
+		assert.Empty(t, e.Leader())
+	})
+
+	t.Run("Leader releases the lease on shutdown", func(t *testing.T) {
+		rpc := &leaseRPCClient{}
+		e := NewLeaderElection(rpc, "test", 30*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		e.Run(ctx)
+		assert.False(t, e.IsLeader())
+		assert.Empty(t, rpc.holder)
+	})
+
+	t.Run("Replica always leads without lease", func(t *testing.T) {
+		e := NewLeaderElection(nil, "test", 0)
+		e.Run(context.Background())
+		assert.True(t, e.IsLeader())
+	})
+}
+
+func TestForwardContext(t *testing.T) {
+	handlerCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	t.Run("Outlives the default handler timeout", func(t *testing.T) {
+		req, err := stormrpc.NewRequest("andromeda.sync", nil)
+		assert.NoError(t, err)
+		ctx, cancel := forwardContext(handlerCtx, req)
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(forwardTimeout), deadline, time.Second)
+	})
+
+	t.Run("Keeps the deadline of the caller", func(t *testing.T) {
+		req, err := stormrpc.NewRequest("andromeda.sync", nil)
+		assert.NoError(t, err)
+		req.Header.Set("stormrpc-deadline", "1")
+		ctx, cancel := forwardContext(handlerCtx, req)
+		defer cancel()
+		deadline, _ := ctx.Deadline()
+		handlerDeadline, _ := handlerCtx.Deadline()
+		assert.Equal(t, handlerDeadline, deadline)
+	})
+}
+
+func TestParseLeaderSubject(t *testing.T) {
+	e := &LeaderElection{name: "f5-declaration"}
+	election, holder, ok := ParseLeaderSubject(e.leaderSubject("host_1-42", "andromeda.sync.f5"))
+	assert.True(t, ok)
+	assert.Equal(t, "f5-declaration", election)
+	assert.Equal(t, "host_1-42", holder)
+
+	_, _, ok = ParseLeaderSubject("andromeda.sync.f5")
+	assert.False(t, ok)
+}
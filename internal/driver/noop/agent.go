@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package noop implements a null GSLB provider which accepts all resources
+// without provisioning them on any vendor system. It is meant for local
+// development and integration tests of the API -> NATS -> agent loop.
+package noop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/actatum/stormrpc"
+	"github.com/apex/log"
+	"github.com/nats-io/nats.go"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
+	"github.com/sapcc/andromeda/internal/rpc"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/utils"
+	"github.com/sapcc/andromeda/models"
+)
+
+const providerName = "noop"
+
+type NoopAgent struct {
+	rpc       server.RPCServerClient
+	config    config.NoopConfig
+	forceSync chan []string
+	changes   <-chan struct{}
+	heartbeat *driver.Heartbeat
+}
+
+func (a *NoopAgent) Sync(ctx context.Context, req stormrpc.Request) stormrpc.Response {
+	var domainIDs []string
+	if err := req.Decode(&domainIDs); err != nil {
+		return stormrpc.NewErrorResponse(req.Reply, err)
+	}
+	log.WithField("domainIDs", domainIDs).Info("[Sync] Syncing domains")
+
+	a.forceSync <- domainIDs
+	resp, err := stormrpc.NewResponse(req.Reply, nil)
+	if err != nil {
+		return stormrpc.NewErrorResponse(req.Reply, err)
+	}
+	return resp
+}
+
+func ExecuteNoopAgent() error {
+	if err := validateConfig(config.Global.NoopConfig); err != nil {
+		return err
+	}
+
+	nc, err := nats.Connect(config.Global.Default.TransportURL)
+	if err != nil {
+		return err
+	}
+	client, err := stormrpc.NewClient("", stormrpc.WithNatsConn(nc))
+	if err != nil {
+		return err
+	}
+
+	rpcClient := server.NewRPCServerClient(client)
+	agent := &NoopAgent{
+		rpc:       rpcClient,
+		config:    config.Global.NoopConfig,
+		forceSync: make(chan []string),
+		heartbeat: driver.NewHeartbeat(rpcClient, driver.AgentNoop, []string{providerName},
+			[]string{driver.CapabilitySync, driver.CapabilityMemberStatus}),
+	}
+
+	if debounce := config.Global.Default.ChangeEventDebounce; debounce > 0 {
+		if agent.changes, err = driver.SubscribeChanges(nc, providerName, time.Duration(debounce)*time.Second); err != nil {
+			return err
+		}
+	}
+
+	srv := rpc.NewServer(driver.AgentNoop, stormrpc.WithNatsConn(nc))
+	srv.Handle("andromeda.sync", agent.Sync)
+	srv.Handle("andromeda.sync.noop", agent.Sync)
+
+	go func() {
+		_ = srv.Run()
+	}()
+	go agent.WorkerThread()
+	heartbeatCtx, heartbeatCancel := context.WithCancel(context.Background())
+	defer heartbeatCancel()
+	go agent.heartbeat.Run(heartbeatCtx, time.Duration(config.Global.Default.HeartbeatInterval)*time.Second)
+	if config.Global.Default.Prometheus {
+		go utils.PrometheusListen()
+	}
+	log.WithField("subjects", srv.Subjects()).Info("Subscribed")
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, syscall.SIGINT, syscall.SIGTERM)
+
+	// full sync immediately
+	agent.forceSync <- nil
+	<-done
+	log.Info("Shutting down")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return srv.Shutdown(ctx)
+}
+
+func (a *NoopAgent) WorkerThread() {
+	syncTicker := time.NewTicker(time.Duration(a.config.SyncInterval) * time.Second)
+	memberStatusTicker := time.NewTicker(time.Duration(a.config.MemberStatusInterval) * time.Second)
+
+	for {
+		select {
+		case domains := <-a.forceSync:
+			log.Debug("Running force sync")
+			a.sync(domains, true)
+			if err := a.memberStatusSync(); err != nil {
+				log.Error(err.Error())
+			}
+		case <-a.changes:
+			log.Debug("Running sync of pending changes")
+			a.sync(nil, false)
+		case <-syncTicker.C:
+			log.Debug("Running periodic sync")
+			a.sync(nil, false)
+		case <-memberStatusTicker.C:
+			if err := a.memberStatusSync(); err != nil {
+				log.Error(err.Error())
+			}
+		}
+	}
+}
+
+func (a *NoopAgent) sync(domains []string, force bool) {
+	if a.heartbeat.Disabled() {
+		log.Debug("Skipping sync, agent disabled")
+		return
+	}
+	if err := a.syncDatacenters(); err != nil {
+		log.Error(err.Error())
+	}
+	if err := a.syncGeomaps(); err != nil {
+		log.Error(err.Error())
+	}
+	if err := a.syncDomains(domains, force); err != nil {
+		log.Error(err.Error())
+	} else {
+		a.heartbeat.Synced()
+	}
+}
+
+func (a *NoopAgent) syncDatacenters() error {
+	response, err := a.rpc.GetDatacenters(context.Background(), &server.SearchRequest{
+		Provider: providerName,
+		Pending:  true,
+	})
+	if err != nil {
+		return err
+	}
+
+	var provRequests []*server.ProvisioningStatusRequest_ProvisioningStatus
+	for _, datacenter := range response.GetResponse() {
+		if status, ok := driver.NextProvisioningStatus(datacenter.GetProvisioningStatus()); ok {
+			provRequests = append(provRequests,
+				driver.GetProvisioningStatusRequest(datacenter.GetId(), "DATACENTER", status))
+		}
+	}
+	return a.updateProvisioningStatus(provRequests)
+}
+
+func (a *NoopAgent) syncGeomaps() error {
+	response, err := a.rpc.GetGeomaps(context.Background(), &server.SearchRequest{
+		Provider: providerName,
+		Pending:  true,
+	})
+	if err != nil {
+		return err
+	}
+
+	var provRequests []*server.ProvisioningStatusRequest_ProvisioningStatus
+	for _, geomap := range response.GetResponse() {
+		if status, ok := driver.NextProvisioningStatus(geomap.GetProvisioningStatus()); ok {
+			provRequests = append(provRequests,
+				driver.GetProvisioningStatusRequest(geomap.GetId(), "GEOGRAPHIC_MAP", status))
+		}
+	}
+	return a.updateProvisioningStatus(provRequests)
+}
+
+func (a *NoopAgent) syncDomains(domains []string, force bool) error {
+	log.Debugf("Running syncDomains(domains=%+v, force=%t)", domains, force)
+	response, err := a.rpc.GetDomains(context.Background(), &server.SearchRequest{
+		Provider:       providerName,
+		FullyPopulated: true,
+		Pending:        domains == nil && !force,
+		Ids:            domains,
+	})
+	if err != nil {
+		return err
+	}
+
+	var provRequests []*server.ProvisioningStatusRequest_ProvisioningStatus
+	for _, domain := range response.GetResponse() {
+		provRequests = append(provRequests, driver.DomainProvisioningRequests(domain)...)
+	}
+	return a.updateProvisioningStatus(provRequests)
+}
+
+func (a *NoopAgent) updateProvisioningStatus(provRequests []*server.ProvisioningStatusRequest_ProvisioningStatus) error {
+	if len(provRequests) == 0 {
+		return nil
+	}
+
+	log.Debugf("RPC provisioning status updates: %v", provRequests)
+	res, err := a.rpc.UpdateProvisioningStatus(context.Background(),
+		&server.ProvisioningStatusRequest{ProvisioningStatus: provRequests})
+	if err != nil {
+		return err
+	}
+	driver.LogRejectedStatusResults(res.GetProvisioningStatusResult())
+	return nil
+}
+
+func (a *NoopAgent) memberStatusSync() error {
+	if a.heartbeat.Disabled() {
+		return nil
+	}
+	log.Debug("Running member status sync")
+	response, err := a.rpc.GetDomains(context.Background(), &server.SearchRequest{
+		Provider:       providerName,
+		FullyPopulated: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	var memberStatusRequests []*server.MemberStatusRequest_MemberStatus
+	for _, domain := range response.GetResponse() {
+		for _, pool := range domain.GetPools() {
+			for _, member := range pool.GetMembers() {
+				if member.GetProvisioningStatus() == models.MemberProvisioningStatusPENDINGDELETE {
+					continue
+				}
+				memberStatusRequests = append(memberStatusRequests,
+					driver.GetMemberStatusRequest(member.GetId(), simulatedMemberStatus(a.config, member)))
+			}
+		}
+	}
+
+	if len(memberStatusRequests) == 0 {
+		return nil
+	}
+	log.Debugf("RPC member status updates: %v", memberStatusRequests)
+	driver.UpdateMemberStatus(a.rpc, memberStatusRequests)
+	return nil
+}
+
+func validateConfig(c config.NoopConfig) error {
+	if c.SyncInterval <= 0 || c.MemberStatusInterval <= 0 {
+		return errors.New("noop: sync_interval and member_status_interval must be positive")
+	}
+	if _, ok := server.MemberStatusRequest_MemberStatus_StatusType_value[c.MemberStatus]; !ok {
+		return fmt.Errorf("noop: invalid member_status %q", c.MemberStatus)
+	}
+	for key, status := range c.MemberStatusOverrides {
+		if _, ok := server.MemberStatusRequest_MemberStatus_StatusType_value[status]; !ok {
+			return fmt.Errorf("noop: invalid member_status_overrides status %q for %s", status, key)
+		}
+	}
+	return nil
+}
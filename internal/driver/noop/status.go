@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package noop
+
+import (
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+)
+
+// simulatedMemberStatus returns the configured member status, overrides are matched
+// by member ID first and member address second. Disabled members are always OFFLINE.
+func simulatedMemberStatus(c config.NoopConfig, member *rpcmodels.Member) server.MemberStatusRequest_MemberStatus_StatusType {
+	if !member.GetAdminStateUp() {
+		return server.MemberStatusRequest_MemberStatus_OFFLINE
+	}
+
+	status := c.MemberStatus
+	if override, ok := c.MemberStatusOverrides[member.GetId()]; ok {
+		status = override
+	} else if override, ok := c.MemberStatusOverrides[member.GetAddress()]; ok {
+		status = override
+	}
+
+	if value, ok := server.MemberStatusRequest_MemberStatus_StatusType_value[status]; ok {
+		return server.MemberStatusRequest_MemberStatus_StatusType(value)
+	}
+	return server.MemberStatusRequest_MemberStatus_UNKNOWN
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package noop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+)
+
+func TestSimulatedMemberStatus(t *testing.T) {
+	assert := assert.New(t)
+	c := config.NoopConfig{
+		MemberStatus: "ONLINE",
+		MemberStatusOverrides: map[string]string{
+			"member2":  "NO_MONITOR",
+			"10.0.0.3": "OFFLINE",
+		},
+	}
+
+	t.Run("Reports the configured default status", func(t *testing.T) {
+		member := &rpcmodels.Member{Id: "member1", Address: "10.0.0.1", AdminStateUp: true}
+		assert.Equal(server.MemberStatusRequest_MemberStatus_ONLINE, simulatedMemberStatus(c, member))
+	})
+
+	t.Run("Prefers overrides by member ID and address", func(t *testing.T) {
+		member := &rpcmodels.Member{Id: "member2", Address: "10.0.0.3", AdminStateUp: true}
+		assert.Equal(server.MemberStatusRequest_MemberStatus_NO_MONITOR, simulatedMemberStatus(c, member))
+		member = &rpcmodels.Member{Id: "member3", Address: "10.0.0.3", AdminStateUp: true}
+		assert.Equal(server.MemberStatusRequest_MemberStatus_OFFLINE, simulatedMemberStatus(c, member))
+	})
+
+	t.Run("Reports disabled members as OFFLINE", func(t *testing.T) {
+		member := &rpcmodels.Member{Id: "member1", Address: "10.0.0.1", AdminStateUp: false}
+		assert.Equal(server.MemberStatusRequest_MemberStatus_OFFLINE, simulatedMemberStatus(c, member))
+	})
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driver
+
+import (
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+	"github.com/sapcc/andromeda/models"
+)
+
+// NextProvisioningStatus returns the provisioning status a resource reaches once the
+// (simulated) backend has applied it, or false if the resource is not pending.
+func NextProvisioningStatus(status string) (string, bool) {
+	switch status {
+	case models.DomainProvisioningStatusPENDINGCREATE, models.DomainProvisioningStatusPENDINGUPDATE:
+		return models.DomainProvisioningStatusACTIVE, true
+	case models.DomainProvisioningStatusPENDINGDELETE:
+		return "DELETED", true
+	default:
+		return "", false
+	}
+}
+
+// DomainProvisioningRequests walks the domain and all its populated pools, members and
+// monitors and returns the provisioning status updates for every pending entity.
+// Children are ordered before their parents, so that deletions don't cascade
+// before the child entities have been reported.
+func DomainProvisioningRequests(domain *rpcmodels.Domain) []*server.ProvisioningStatusRequest_ProvisioningStatus {
+	var provRequests []*server.ProvisioningStatusRequest_ProvisioningStatus
+
+	if domain.GetProvisioningStatus() == models.DomainProvisioningStatusPENDINGDELETE {
+		// Pools may be shared with other domains, only delete the domain itself
+		return append(provRequests, GetProvisioningStatusRequest(domain.GetId(), "DOMAIN", "DELETED"))
+	}
+
+	for _, pool := range domain.GetPools() {
+		for _, member := range pool.GetMembers() {
+			if status, ok := NextProvisioningStatus(member.GetProvisioningStatus()); ok {
+				provRequests = append(provRequests,
+					GetProvisioningStatusRequest(member.GetId(), "MEMBER", status))
+			}
+		}
+		for _, monitor := range pool.GetMonitors() {
+			if status, ok := NextProvisioningStatus(monitor.GetProvisioningStatus()); ok {
+				provRequests = append(provRequests,
+					GetProvisioningStatusRequest(monitor.GetId(), "MONITOR", status))
+			}
+		}
+		if status, ok := NextProvisioningStatus(pool.GetProvisioningStatus()); ok {
+			provRequests = append(provRequests,
+				GetProvisioningStatusRequest(pool.GetId(), "POOL", status))
+		}
+	}
+
+	if status, ok := NextProvisioningStatus(domain.GetProvisioningStatus()); ok {
+		provRequests = append(provRequests,
+			GetProvisioningStatusRequest(domain.GetId(), "DOMAIN", status))
+	}
+	return provRequests
+}
+
+// DeletedDomainProvisioningRequests returns the provisioning status updates once the domain has been
+// removed from the backend. Pools, members and monitors pending deletion are deleted along with it,
+// other pending ones are activated. Entities in ERROR are left for the next sync to retry, and
+// datacenters, which are synced independently of domains, are not touched.
+func DeletedDomainProvisioningRequests(domain *rpcmodels.Domain) []*server.ProvisioningStatusRequest_ProvisioningStatus {
+	var provRequests []*server.ProvisioningStatusRequest_ProvisioningStatus
+	for _, pool := range domain.GetPools() {
+		for _, member := range pool.GetMembers() {
+			if status, ok := NextProvisioningStatus(member.GetProvisioningStatus()); ok {
+				provRequests = append(provRequests,
+					GetProvisioningStatusRequest(member.GetId(), "MEMBER", status))
+			}
+		}
+		for _, monitor := range pool.GetMonitors() {
+			if status, ok := NextProvisioningStatus(monitor.GetProvisioningStatus()); ok {
+				provRequests = append(provRequests,
+					GetProvisioningStatusRequest(monitor.GetId(), "MONITOR", status))
+			}
+		}
+		if status, ok := NextProvisioningStatus(pool.GetProvisioningStatus()); ok {
+			provRequests = append(provRequests,
+				GetProvisioningStatusRequest(pool.GetId(), "POOL", status))
+		}
+	}
+	return append(provRequests, GetProvisioningStatusRequest(domain.GetId(), "DOMAIN", "DELETED"))
+}
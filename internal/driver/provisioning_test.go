@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+)
+
+func TestDomainProvisioningRequests(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("Only deletes the domain itself if marked PENDING_DELETE", func(t *testing.T) {
+		domain := &rpcmodels.Domain{
+			Id:                 "dom1-uuid",
+			ProvisioningStatus: "PENDING_DELETE",
+			Pools:              []*rpcmodels.Pool{{Id: "pool1-uuid", ProvisioningStatus: "PENDING_UPDATE"}},
+		}
+		assert.Equal([]*server.ProvisioningStatusRequest_ProvisioningStatus{
+			{Id: "dom1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN, Status: server.ProvisioningStatusRequest_ProvisioningStatus_DELETED},
+		}, DomainProvisioningRequests(domain))
+	})
+
+	t.Run("Walks pending entities children first and skips active ones", func(t *testing.T) {
+		domain := &rpcmodels.Domain{
+			Id:                 "dom1-uuid",
+			ProvisioningStatus: "PENDING_UPDATE",
+			Pools: []*rpcmodels.Pool{
+				{
+					Id:                 "pool1-uuid",
+					ProvisioningStatus: "PENDING_UPDATE",
+					Members: []*rpcmodels.Member{
+						{Id: "member1", ProvisioningStatus: "PENDING_CREATE"},
+						{Id: "member2", ProvisioningStatus: "ACTIVE"},
+						{Id: "member3", ProvisioningStatus: "PENDING_DELETE"},
+					},
+					Monitors: []*rpcmodels.Monitor{{Id: "monitor1", ProvisioningStatus: "ERROR"}},
+				},
+			},
+		}
+		assert.Equal([]*server.ProvisioningStatusRequest_ProvisioningStatus{
+			{Id: "member1", Model: server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+			{Id: "member3", Model: server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER, Status: server.ProvisioningStatusRequest_ProvisioningStatus_DELETED},
+			{Id: "pool1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_POOL, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+			{Id: "dom1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+		}, DomainProvisioningRequests(domain))
+	})
+}
+
+func TestDeletedDomainProvisioningRequests(t *testing.T) {
+	domain := &rpcmodels.Domain{
+		Id:                 "dom1-uuid",
+		ProvisioningStatus: "PENDING_DELETE",
+		Datacenters:        []*rpcmodels.Datacenter{{Id: "dc1", ProvisioningStatus: "PENDING_DELETE"}},
+		Pools: []*rpcmodels.Pool{
+			{
+				Id:                 "pool1-uuid",
+				ProvisioningStatus: "PENDING_UPDATE",
+				Members: []*rpcmodels.Member{
+					{Id: "member1", ProvisioningStatus: "ACTIVE"},
+					{Id: "member2", ProvisioningStatus: "PENDING_DELETE"},
+					{Id: "member3", ProvisioningStatus: "ERROR"},
+				},
+				Monitors: []*rpcmodels.Monitor{{Id: "monitor1", ProvisioningStatus: "PENDING_CREATE"}},
+			},
+		},
+	}
+	assert.Equal(t, []*server.ProvisioningStatusRequest_ProvisioningStatus{
+		{Id: "member2", Model: server.ProvisioningStatusRequest_ProvisioningStatus_MEMBER, Status: server.ProvisioningStatusRequest_ProvisioningStatus_DELETED},
+		{Id: "monitor1", Model: server.ProvisioningStatusRequest_ProvisioningStatus_MONITOR, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+		{Id: "pool1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_POOL, Status: server.ProvisioningStatusRequest_ProvisioningStatus_ACTIVE},
+		{Id: "dom1-uuid", Model: server.ProvisioningStatusRequest_ProvisioningStatus_DOMAIN, Status: server.ProvisioningStatusRequest_ProvisioningStatus_DELETED},
+	}, DeletedDomainProvisioningRequests(domain))
+}
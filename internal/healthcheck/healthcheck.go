@@ -0,0 +1,341 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package healthcheck implements a standalone health checker, which executes the monitors of
+// pools against their members and reports member status transitions to the Andromeda server.
+// It is meant for providers without native monitoring.
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/actatum/stormrpc"
+	"github.com/apex/log"
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+	"github.com/sapcc/andromeda/internal/utils"
+	"github.com/sapcc/andromeda/models"
+)
+
+const (
+	defaultInterval = 60 * time.Second
+	defaultTimeout  = 10 * time.Second
+)
+
+var checksCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "andromeda_health_checks_total",
+		Help: "Number of executed health checks",
+	},
+	[]string{"type", "result"},
+)
+
+func init() {
+	prometheus.MustRegister(checksCounter)
+}
+
+type checkKey struct {
+	memberID  string
+	monitorID string
+}
+
+// check is a single monitor executed against a single member
+type check struct {
+	member  *rpcmodels.Member
+	monitor *rpcmodels.Monitor
+	host    string
+	nextRun time.Time
+	running bool
+	// result of the last run, nil if not run yet
+	healthy *bool
+}
+
+func (c *check) interval() time.Duration {
+	if c.monitor.GetInterval() <= 0 {
+		return defaultInterval
+	}
+	return time.Duration(c.monitor.GetInterval()) * time.Second
+}
+
+func (c *check) timeout() time.Duration {
+	timeout := defaultTimeout
+	if c.monitor.GetTimeout() > 0 {
+		timeout = time.Duration(c.monitor.GetTimeout()) * time.Second
+	}
+	return min(timeout, c.interval())
+}
+
+type HealthChecker struct {
+	rpc    server.RPCServerClient
+	config config.HealthCheck
+	probe  func(ctx context.Context, monitor *rpcmodels.Monitor, member *rpcmodels.Member, host string) error
+
+	mu           sync.Mutex
+	checks       map[checkKey]*check
+	memberChecks map[string][]checkKey
+	// last known status per member, either read from the server or reported
+	memberStatus map[string]string
+	pending      []*server.MemberStatusRequest_MemberStatus
+	workers      chan struct{}
+	heartbeat    *driver.Heartbeat
+}
+
+func newHealthChecker(rpc server.RPCServerClient, c config.HealthCheck) *HealthChecker {
+	return &HealthChecker{
+		rpc:          rpc,
+		config:       c,
+		probe:        probe,
+		checks:       make(map[checkKey]*check),
+		memberChecks: make(map[string][]checkKey),
+		memberStatus: make(map[string]string),
+		workers:      make(chan struct{}, max(c.Workers, 1)),
+	}
+}
+
+func HealthCheck() error {
+	if len(config.Global.HealthCheck.Providers) == 0 {
+		return errors.New("health check: no providers configured")
+	}
+
+	nc, err := nats.Connect(config.Global.Default.TransportURL)
+	if err != nil {
+		return err
+	}
+	client, err := stormrpc.NewClient("", stormrpc.WithNatsConn(nc))
+	if err != nil {
+		return err
+	}
+
+	rpcClient := server.NewRPCServerClient(client)
+	h := newHealthChecker(rpcClient, config.Global.HealthCheck)
+	h.heartbeat = driver.NewHeartbeat(rpcClient, "andromeda-health-checker", h.config.Providers,
+		[]string{driver.CapabilityHealthCheck})
+	if err := h.refresh(); err != nil {
+		return err
+	}
+	h.heartbeat.Synced()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go h.WorkerThread(ctx)
+	go h.heartbeat.Run(ctx, time.Duration(config.Global.Default.HeartbeatInterval)*time.Second)
+	if config.Global.Default.Prometheus {
+		go utils.PrometheusListen()
+	}
+	log.WithField("providers", h.config.Providers).Info("Running health checks")
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, syscall.SIGINT, syscall.SIGTERM)
+	<-done
+	log.Info("Shutting down")
+	cancel()
+	client.Close()
+	return nil
+}
+
+func (h *HealthChecker) WorkerThread(ctx context.Context) {
+	refreshTicker := time.NewTicker(time.Duration(h.config.RefreshInterval) * time.Second)
+	scheduleTicker := time.NewTicker(time.Second)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-refreshTicker.C:
+			if h.heartbeat.Disabled() {
+				continue
+			}
+			if err := h.refresh(); err != nil {
+				log.Error(err.Error())
+			} else {
+				h.heartbeat.Synced()
+			}
+		case now := <-scheduleTicker.C:
+			if h.heartbeat.Disabled() {
+				continue
+			}
+			h.schedule(ctx, now)
+			h.report()
+		}
+	}
+}
+
+// refresh fetches the members and monitors of all configured providers, keeping the
+// schedule and last result of already known checks.
+func (h *HealthChecker) refresh() error {
+	var domains []*rpcmodels.Domain
+	for _, provider := range h.config.Providers {
+		response, err := h.rpc.GetDomains(context.Background(), &server.SearchRequest{
+			Provider:       provider,
+			FullyPopulated: true,
+		})
+		if err != nil {
+			return err
+		}
+		domains = append(domains, response.GetResponse()...)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	checks := make(map[checkKey]*check)
+	memberChecks := make(map[string][]checkKey)
+	memberStatus := make(map[string]string)
+	now := time.Now()
+	for _, domain := range domains {
+		for _, pool := range domain.GetPools() {
+			for _, member := range pool.GetMembers() {
+				if !member.GetAdminStateUp() ||
+					member.GetProvisioningStatus() == models.MemberProvisioningStatusPENDINGDELETE {
+					continue
+				}
+				if _, ok := memberChecks[member.GetId()]; ok {
+					// pool shared by multiple domains
+					continue
+				}
+				memberChecks[member.GetId()] = []checkKey{}
+				memberStatus[member.GetId()] = member.GetStatus()
+
+				for _, monitor := range pool.GetMonitors() {
+					if !monitor.GetAdminStateUp() ||
+						monitor.GetProvisioningStatus() == models.MonitorProvisioningStatusPENDINGDELETE ||
+						!supportedMonitor(monitor) {
+						continue
+					}
+					host := monitor.GetDomainName()
+					if host == "" {
+						host = domain.GetFqdn()
+					}
+
+					key := checkKey{member.GetId(), monitor.GetId()}
+					if c, ok := h.checks[key]; ok {
+						// keep schedule and last result
+						c.member, c.monitor, c.host = member, monitor, host
+						checks[key] = c
+					} else {
+						checks[key] = &check{member: member, monitor: monitor, host: host, nextRun: now}
+					}
+					memberChecks[member.GetId()] = append(memberChecks[member.GetId()], key)
+				}
+			}
+		}
+	}
+
+	h.checks, h.memberChecks, h.memberStatus = checks, memberChecks, memberStatus
+	for memberID := range memberChecks {
+		h.evaluate(memberID)
+	}
+	log.Debugf("Refreshed %d health checks of %d members", len(checks), len(memberChecks))
+	return nil
+}
+
+// schedule starts all checks which are due, bounded by the configured number of workers
+func (h *HealthChecker) schedule(ctx context.Context, now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, c := range h.checks {
+		if c.running || now.Before(c.nextRun) {
+			continue
+		}
+		c.running = true
+		c.nextRun = now.Add(c.interval())
+
+		// refresh may replace member and monitor while the check is running
+		member, monitor, host, timeout := c.member, c.monitor, c.host, c.timeout()
+		go func() {
+			select {
+			case h.workers <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-h.workers }()
+
+			probeCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			err := h.probe(probeCtx, monitor, member, host)
+			result := "success"
+			if err != nil {
+				result = "failure"
+				log.WithField("member", member.GetId()).
+					WithField("monitor", monitor.GetId()).
+					WithError(err).Debug("Health check failed")
+			}
+			checksCounter.WithLabelValues(monitor.GetType().String(), result).Inc()
+			h.recordResult(c, err == nil)
+		}()
+	}
+}
+
+func (h *HealthChecker) recordResult(c *check, healthy bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	c.running = false
+	c.healthy = &healthy
+	h.evaluate(c.member.GetId())
+}
+
+// evaluate queues a status update if the aggregated status of the member changed, must be called with mu held
+func (h *HealthChecker) evaluate(memberID string) {
+	var checks []*check
+	for _, key := range h.memberChecks[memberID] {
+		// checks of a member replaced by refresh may still report results
+		if c, ok := h.checks[key]; ok {
+			checks = append(checks, c)
+		}
+	}
+
+	status, ok := aggregateStatus(checks)
+	if !ok || h.memberStatus[memberID] == status.String() {
+		return
+	}
+	log.WithField("member", memberID).
+		WithField("from", h.memberStatus[memberID]).
+		WithField("to", status.String()).
+		Info("Member status changed")
+	h.memberStatus[memberID] = status.String()
+	h.pending = append(h.pending, driver.GetMemberStatusRequest(memberID, status))
+}
+
+// report sends all queued member status transitions to the server
+func (h *HealthChecker) report() {
+	h.mu.Lock()
+	pending := h.pending
+	h.pending = nil
+	h.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+	log.Debugf("RPC member status updates: %v", pending)
+	driver.UpdateMemberStatus(h.rpc, pending)
+}
+
+// aggregateStatus returns the status of a member from the results of its checks, a member is
+// ONLINE if all checks succeeded and OFFLINE if any check failed. Returns false if undecided yet.
+func aggregateStatus(checks []*check) (server.MemberStatusRequest_MemberStatus_StatusType, bool) {
+	if len(checks) == 0 {
+		return server.MemberStatusRequest_MemberStatus_NO_MONITOR, true
+	}
+
+	decided := true
+	for _, c := range checks {
+		switch {
+		case c.healthy == nil:
+			decided = false
+		case !*c.healthy:
+			return server.MemberStatusRequest_MemberStatus_OFFLINE, true
+		}
+	}
+	return server.MemberStatusRequest_MemberStatus_ONLINE, decided
+}
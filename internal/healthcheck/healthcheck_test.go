@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/actatum/stormrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+)
+
+type mockedRPCClient struct {
+	server.RPCServerClient
+	mock.Mock
+}
+
+func (c *mockedRPCClient) GetDomains(_ context.Context, in *server.SearchRequest, _ ...stormrpc.CallOption) (*server.DomainsResponse, error) {
+	args := c.Called(in.GetProvider())
+	return args.Get(0).(*server.DomainsResponse), args.Error(1)
+}
+
+func (c *mockedRPCClient) UpdateMemberStatus(_ context.Context, in *server.MemberStatusRequest, _ ...stormrpc.CallOption) (*server.MemberStatusResponse, error) {
+	args := c.Called(in.GetMemberStatus())
+	return &server.MemberStatusResponse{}, args.Error(0)
+}
+
+func testDomains() *server.DomainsResponse {
+	return &server.DomainsResponse{Response: []*rpcmodels.Domain{{
+		Id:   "domain",
+		Fqdn: "www.example.com",
+		Pools: []*rpcmodels.Pool{{
+			Id: "pool",
+			Members: []*rpcmodels.Member{
+				{Id: "up", AdminStateUp: true, Address: "10.0.0.1", Port: 80, Status: "OFFLINE"},
+				{Id: "down", AdminStateUp: true, Address: "10.0.0.2", Port: 80, Status: "ONLINE"},
+				{Id: "disabled", AdminStateUp: false, Address: "10.0.0.3", Port: 80, Status: "ONLINE"},
+			},
+			Monitors: []*rpcmodels.Monitor{
+				{Id: "http", AdminStateUp: true, Type: rpcmodels.Monitor_HTTP, Interval: 10},
+				{Id: "tcp", AdminStateUp: true, Type: rpcmodels.Monitor_TCP, Interval: 10},
+				{Id: "disabled", AdminStateUp: false, Type: rpcmodels.Monitor_TCP, Interval: 10},
+			},
+		}},
+	}}}
+}
+
+func TestHealthChecker(t *testing.T) {
+	t.Run("Reports member status transitions only", func(t *testing.T) {
+		rpc := new(mockedRPCClient)
+		rpc.On("GetDomains", "dns").Return(testDomains(), nil)
+		rpc.On("UpdateMemberStatus", mock.Anything).Return(nil)
+
+		h := newHealthChecker(rpc, config.HealthCheck{Providers: []string{"dns"}, Workers: 2})
+		probed := make(chan string, 10)
+		h.probe = func(_ context.Context, monitor *rpcmodels.Monitor, member *rpcmodels.Member, host string) error {
+			assert.Equal(t, "www.example.com", host)
+			probed <- member.GetId() + "/" + monitor.GetId()
+			if member.GetId() == "down" && monitor.GetId() == "tcp" {
+				return errors.New("connection refused")
+			}
+			return nil
+		}
+		require.NoError(t, h.refresh())
+		assert.Len(t, h.checks, 4)
+
+		now := time.Now()
+		h.schedule(context.Background(), now)
+		var runs []string
+		for range 4 {
+			runs = append(runs, <-probed)
+		}
+		assert.ElementsMatch(t, []string{"up/http", "up/tcp", "down/http", "down/tcp"}, runs)
+		assert.Eventually(t, func() bool {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			return len(h.pending) == 2
+		}, time.Second, 10*time.Millisecond)
+
+		h.report()
+		rpc.AssertCalled(t, "UpdateMemberStatus", mock.MatchedBy(func(req []*server.MemberStatusRequest_MemberStatus) bool {
+			statuses := make(map[string]server.MemberStatusRequest_MemberStatus_StatusType)
+			for _, r := range req {
+				statuses[r.GetId()] = r.GetStatus()
+			}
+			return assert.ObjectsAreEqual(map[string]server.MemberStatusRequest_MemberStatus_StatusType{
+				"up":   server.MemberStatusRequest_MemberStatus_ONLINE,
+				"down": server.MemberStatusRequest_MemberStatus_OFFLINE,
+			}, statuses)
+		}))
+
+		// no checks due before the monitor interval, nothing to report
+		h.schedule(context.Background(), now.Add(5*time.Second))
+		h.report()
+		rpc.AssertNumberOfCalls(t, "UpdateMemberStatus", 1)
+	})
+
+	t.Run("Reports NO_MONITOR for members of pools without monitors", func(t *testing.T) {
+		domains := testDomains()
+		domains.Response[0].Pools[0].Monitors = nil
+		rpc := new(mockedRPCClient)
+		rpc.On("GetDomains", "dns").Return(domains, nil)
+
+		h := newHealthChecker(rpc, config.HealthCheck{Providers: []string{"dns"}, Workers: 1})
+		require.NoError(t, h.refresh())
+		require.Len(t, h.pending, 2)
+		for _, req := range h.pending {
+			assert.Equal(t, server.MemberStatusRequest_MemberStatus_NO_MONITOR, req.GetStatus())
+		}
+	})
+}
+
+func TestAggregateStatus(t *testing.T) {
+	healthy, unhealthy := true, false
+
+	status, ok := aggregateStatus(nil)
+	assert.True(t, ok)
+	assert.Equal(t, server.MemberStatusRequest_MemberStatus_NO_MONITOR, status)
+
+	_, ok = aggregateStatus([]*check{{healthy: &healthy}, {}})
+	assert.False(t, ok, "undecided until all checks ran")
+
+	status, ok = aggregateStatus([]*check{{healthy: &unhealthy}, {}})
+	assert.True(t, ok)
+	assert.Equal(t, server.MemberStatusRequest_MemberStatus_OFFLINE, status)
+
+	status, ok = aggregateStatus([]*check{{healthy: &healthy}, {healthy: &healthy}})
+	assert.True(t, ok)
+	assert.Equal(t, server.MemberStatusRequest_MemberStatus_ONLINE, status)
+}
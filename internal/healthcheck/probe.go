@@ -0,0 +1,249 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+)
+
+// maxResponseSize limits how much of a response is searched for the receive string
+const maxResponseSize = 64 * 1024
+
+var icmpSequence atomic.Uint32
+
+// probe runs a single health check of the monitor against the member and returns an error
+// if the member is considered unhealthy. host is the HTTP Host header for HTTP/S monitors.
+func probe(ctx context.Context, monitor *rpcmodels.Monitor, member *rpcmodels.Member, host string) error {
+	address := net.JoinHostPort(member.GetAddress(), strconv.FormatUint(uint64(member.GetPort()), 10))
+
+	switch monitor.GetType() {
+	case rpcmodels.Monitor_HTTP:
+		return probeHTTP(ctx, "http", monitor, address, host)
+	case rpcmodels.Monitor_HTTPS:
+		return probeHTTP(ctx, "https", monitor, address, host)
+	case rpcmodels.Monitor_TCP:
+		return probeTCP(ctx, monitor, address)
+	case rpcmodels.Monitor_UDP:
+		return probeUDP(ctx, monitor, address)
+	case rpcmodels.Monitor_ICMP:
+		return probeICMP(ctx, member.GetAddress())
+	default:
+		return fmt.Errorf("unsupported monitor type %s", monitor.GetType())
+	}
+}
+
+func supportedMonitor(monitor *rpcmodels.Monitor) bool {
+	switch monitor.GetType() {
+	case rpcmodels.Monitor_HTTP, rpcmodels.Monitor_HTTPS, rpcmodels.Monitor_TCP, rpcmodels.Monitor_UDP,
+		rpcmodels.Monitor_ICMP:
+		return true
+	default:
+		return false
+	}
+}
+
+func probeHTTP(ctx context.Context, scheme string, monitor *rpcmodels.Monitor, address, host string) error {
+	path := monitor.GetSend()
+	if path == "" {
+		path = "/"
+	}
+	req, err := http.NewRequestWithContext(ctx, monitor.GetMethod().String(), scheme+"://"+address+path, http.NoBody)
+	if err != nil {
+		return err
+	}
+	if host != "" {
+		req.Host = host
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			DisableKeepAlives: true,
+			TLSClientConfig: &tls.Config{
+				ServerName: host,
+				// members are usually addressed by IP, only availability is checked
+				InsecureSkipVerify: true, //nolint:gosec
+			},
+		},
+		// redirects are a valid response of the member itself
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	receive := monitor.GetReceive()
+	if receive == "" {
+		if resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("unexpected HTTP status %s", resp.Status)
+		}
+		return nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+	// match the receive string against status line and body, e.g. "HTTP/1." or "200 OK"
+	response := fmt.Sprintf("%s %s\r\n%s", resp.Proto, resp.Status, body)
+	if !strings.Contains(response, receive) {
+		return fmt.Errorf("response does not contain %q", receive)
+	}
+	return nil
+}
+
+func probeTCP(ctx context.Context, monitor *rpcmodels.Monitor, address string) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if send := monitor.GetSend(); send != "" {
+		if _, err := conn.Write([]byte(send)); err != nil {
+			return err
+		}
+	}
+	if receive := monitor.GetReceive(); receive != "" {
+		return receiveContains(conn, receive)
+	}
+	return nil
+}
+
+func probeUDP(ctx context.Context, monitor *rpcmodels.Monitor, address string) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if _, err := conn.Write([]byte(monitor.GetSend())); err != nil {
+		return err
+	}
+	if receive := monitor.GetReceive(); receive != "" {
+		return receiveContains(conn, receive)
+	}
+
+	// Without expected response, the member is up unless the port is reported unreachable
+	buf := make([]byte, maxResponseSize)
+	if _, err := conn.Read(buf); err != nil {
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// receiveContains reads from conn until the receive string was found, the connection got closed
+// or maxResponseSize has been read.
+func receiveContains(conn net.Conn, receive string) error {
+	var response []byte
+	buf := make([]byte, 4096)
+	for len(response) < maxResponseSize {
+		n, err := conn.Read(buf)
+		response = append(response, buf[:n]...)
+		if bytes.Contains(response, []byte(receive)) {
+			return nil
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+	}
+	return fmt.Errorf("response does not contain %q", receive)
+}
+
+// probeICMP sends an ICMP echo request via an unprivileged datagram socket, which
+// requires the group of the process to be allowed by net.ipv4.ping_group_range.
+func probeICMP(ctx context.Context, host string) error {
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return err
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("no address found for %s", host)
+	}
+	ip := addrs[0].Unmap()
+
+	network, listen, protocol := "udp4", "0.0.0.0", 1
+	var echoType, replyType icmp.Type = ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+	if ip.Is6() {
+		network, listen, protocol = "udp6", "::", 58
+		echoType, replyType = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+	}
+
+	conn, err := icmp.ListenPacket(network, listen)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	seq := int(icmpSequence.Add(1) & 0xffff)
+	msg := icmp.Message{
+		Type: echoType,
+		// the kernel replaces the ID of unprivileged echo requests
+		Body: &icmp.Echo{ID: os.Getpid() & 0xffff, Seq: seq, Data: []byte("andromeda")},
+	}
+	b, err := msg.Marshal(nil)
+	if err != nil {
+		return err
+	}
+	if _, err := conn.WriteTo(b, &net.UDPAddr{IP: ip.AsSlice()}); err != nil {
+		if errors.Is(err, syscall.EPERM) {
+			return fmt.Errorf("ICMP not permitted, check net.ipv4.ping_group_range: %w", err)
+		}
+		return err
+	}
+
+	buf := make([]byte, 1500)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+		reply, err := icmp.ParseMessage(protocol, buf[:n])
+		if err != nil || reply.Type != replyType {
+			continue
+		}
+		if echo, ok := reply.Body.(*icmp.Echo); ok && echo.Seq == seq {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sapcc/andromeda/internal/rpcmodels"
+)
+
+func memberFor(t *testing.T, address string) *rpcmodels.Member {
+	host, port, err := net.SplitHostPort(address)
+	require.NoError(t, err)
+	p, err := strconv.ParseUint(port, 10, 32)
+	require.NoError(t, err)
+	return &rpcmodels.Member{Id: "member", Address: host, Port: uint32(p)}
+}
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestProbeHTTP(t *testing.T) {
+	var lastRequest *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastRequest = r
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("status: healthy"))
+	}))
+	defer srv.Close()
+	member := memberFor(t, srv.Listener.Addr().String())
+
+	t.Run("Sends method, path and host header", func(t *testing.T) {
+		monitor := &rpcmodels.Monitor{Type: rpcmodels.Monitor_HTTP, Send: "/status", Method: rpcmodels.Monitor_HEAD}
+		require.NoError(t, probe(testContext(t), monitor, member, "example.org"))
+		assert.Equal(t, http.MethodHead, lastRequest.Method)
+		assert.Equal(t, "/status", lastRequest.URL.Path)
+		assert.Equal(t, "example.org", lastRequest.Host)
+	})
+
+	t.Run("Fails on error status without receive string", func(t *testing.T) {
+		monitor := &rpcmodels.Monitor{Type: rpcmodels.Monitor_HTTP, Send: "/broken"}
+		assert.ErrorContains(t, probe(testContext(t), monitor, member, ""), "503")
+	})
+
+	t.Run("Matches the receive string against status line and body", func(t *testing.T) {
+		for receive, ok := range map[string]bool{"HTTP/1.": true, "200 OK": true, "healthy": true, "degraded": false} {
+			monitor := &rpcmodels.Monitor{Type: rpcmodels.Monitor_HTTP, Receive: receive}
+			err := probe(testContext(t), monitor, member, "")
+			if ok {
+				assert.NoError(t, err, receive)
+			} else {
+				assert.Error(t, err, receive)
+			}
+		}
+	})
+
+	t.Run("Probes HTTPS members without verifying certificates", func(t *testing.T) {
+		tlsSrv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer tlsSrv.Close()
+		monitor := &rpcmodels.Monitor{Type: rpcmodels.Monitor_HTTPS}
+		assert.NoError(t, probe(testContext(t), monitor, memberFor(t, tlsSrv.Listener.Addr().String()), "example.org"))
+	})
+}
+
+func TestProbeTCP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			buf := make([]byte, 64)
+			n, _ := conn.Read(buf)
+			_, _ = conn.Write(append([]byte("echo "), buf[:n]...))
+			conn.Close()
+		}
+	}()
+	member := memberFor(t, l.Addr().String())
+
+	t.Run("Succeeds if the response contains the receive string", func(t *testing.T) {
+		monitor := &rpcmodels.Monitor{Type: rpcmodels.Monitor_TCP, Send: "ping", Receive: "echo ping"}
+		assert.NoError(t, probe(testContext(t), monitor, member, ""))
+	})
+
+	t.Run("Fails if the response misses the receive string", func(t *testing.T) {
+		monitor := &rpcmodels.Monitor{Type: rpcmodels.Monitor_TCP, Send: "ping", Receive: "pong"}
+		assert.ErrorContains(t, probe(testContext(t), monitor, member, ""), "pong")
+	})
+
+	t.Run("Fails if the port is closed", func(t *testing.T) {
+		closed, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		closedMember := memberFor(t, closed.Addr().String())
+		closed.Close()
+		assert.Error(t, probe(testContext(t), &rpcmodels.Monitor{Type: rpcmodels.Monitor_TCP}, closedMember, ""))
+	})
+}
+
+func TestProbeUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+	go func() {
+		buf := make([]byte, 64)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			_, _ = conn.WriteTo(append([]byte("echo "), buf[:n]...), addr)
+		}
+	}()
+	member := memberFor(t, conn.LocalAddr().String())
+
+	t.Run("Succeeds if the response contains the receive string", func(t *testing.T) {
+		monitor := &rpcmodels.Monitor{Type: rpcmodels.Monitor_UDP, Send: "ping", Receive: "echo ping"}
+		assert.NoError(t, probe(testContext(t), monitor, member, ""))
+	})
+
+	t.Run("Fails if the port is unreachable", func(t *testing.T) {
+		closed, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		closedMember := memberFor(t, closed.LocalAddr().String())
+		closed.Close()
+		assert.Error(t, probe(testContext(t), &rpcmodels.Monitor{Type: rpcmodels.Monitor_UDP, Send: "ping"}, closedMember, ""))
+	})
+}
+
+func TestProbeUnsupported(t *testing.T) {
+	monitor := &rpcmodels.Monitor{Type: rpcmodels.Monitor_SMTP}
+	assert.False(t, supportedMonitor(monitor))
+	assert.ErrorContains(t, probe(testContext(t), monitor, &rpcmodels.Member{Address: "127.0.0.1"}, ""), "unsupported")
+}
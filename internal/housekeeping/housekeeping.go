@@ -6,7 +6,6 @@ package housekeeping
 
 import (
 	"context"
-	"fmt"
 	"os"
 	"os/signal"
 	"strconv"
@@ -16,18 +15,25 @@ import (
 	"github.com/apex/log"
 	"github.com/go-openapi/strfmt"
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/gophercloud/gophercloud/v2"
 	"github.com/iancoleman/strcase"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/jmoiron/sqlx"
+	"github.com/nats-io/nats.go"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sapcc/go-bits/jobloop"
 	"github.com/xo/dburl"
 
 	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+	"github.com/sapcc/andromeda/internal/utils"
 )
 
 type Executor struct {
-	DB *sqlx.DB
+	DB       *sqlx.DB
+	Identity *gophercloud.ServiceClient
+	// NC publishes change events of resources deleted by house keeping, if set
+	NC *nats.Conn
 }
 
 func (e *Executor) findNextPoolToActivate(_ context.Context, tx *sqlx.Tx, _ prometheus.Labels) (*strfmt.UUID, error) {
@@ -69,12 +75,14 @@ func (e *Executor) poolCascadeActive(_ context.Context, tx *sqlx.Tx, poolID *str
 }
 
 func (e *Executor) CleanupDeletedDomains(_ context.Context, labels prometheus.Labels) error {
-	// Delete deleted domains
-	sql := `DELETE FROM
-           		domain 
-			WHERE 
-			    provisioning_status = 'DELETED' AND updated_at < (NOW() - INTERVAL %d SECOND)`
-	res, err := e.DB.Exec(fmt.Sprintf(sql, config.Global.HouseKeeping.DeleteAfter))
+	// Delete deleted domains, soft-deleted domains are purged once their restore window elapsed
+	sql := `
+		DELETE FROM domain
+		WHERE provisioning_status = 'DELETED' AND ((deleted_at IS NULL AND updated_at < ?) OR deleted_at < ?)`
+	now := time.Now().UTC()
+	deleteAfter := now.Add(-time.Duration(config.Global.HouseKeeping.DeleteAfter) * time.Second)
+	restoreWindow := now.Add(-time.Duration(config.Global.ApiSettings.DomainRestoreWindow) * time.Second)
+	res, err := e.DB.Exec(e.DB.Rebind(sql), deleteAfter, restoreWindow)
 	if err != nil {
 		return err
 	}
@@ -87,6 +95,44 @@ func (e *Executor) CleanupDeletedDomains(_ context.Context, labels prometheus.La
 	return nil
 }
 
+func (e *Executor) CleanupMemberStatusHistory(_ context.Context, labels prometheus.Labels) error {
+	labels["count"] = "0"
+	if retention := config.Global.StatusHistory.Retention; retention > 0 {
+		sql := `DELETE FROM member_status_history WHERE created_at < ?`
+		before := time.Now().UTC().Add(-time.Duration(retention) * time.Second)
+		res, err := e.DB.Exec(e.DB.Rebind(sql), before)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		labels["count"] = strconv.FormatInt(rowsAffected, 10)
+		log.Infof("Cleaned up %d member status history entries", rowsAffected)
+	}
+
+	// Members stop flapping once their transitions left the flap window, even without further status updates.
+	// All members stop flapping if flap detection is disabled.
+	sql := `UPDATE member SET flapping = false WHERE flapping`
+	var args []any
+	if threshold := config.Global.StatusHistory.FlapThreshold; threshold > 0 {
+		sql += `
+			AND id NOT IN (
+				SELECT member_id FROM member_status_history
+				WHERE created_at >= ?
+				GROUP BY member_id HAVING COUNT(*) >= ?
+			)`
+		since := time.Now().UTC().Add(-time.Duration(config.Global.StatusHistory.FlapWindow) * time.Second)
+		args = append(args, since, threshold)
+	}
+	if _, err := e.DB.Exec(e.DB.Rebind(sql), args...); err != nil {
+		return err
+	}
+	server.UpdateFlappingGauge(e.DB)
+	return nil
+}
+
 func (e *Executor) EventTranslationJob(registerer prometheus.Registerer) jobloop.Job {
 	return (&jobloop.TxGuardedJob[*sqlx.Tx, *strfmt.UUID]{
 		Metadata: jobloop.JobMetadata{
@@ -113,6 +159,18 @@ func (e *Executor) CleanupDeletedDomainsCronJob(registerer prometheus.Registerer
 	}).Setup(registerer)
 }
 
+func (e *Executor) CleanupMemberStatusHistoryCronJob(registerer prometheus.Registerer) jobloop.Job {
+	return (&jobloop.CronJob{
+		Metadata: jobloop.JobMetadata{
+			ReadableName:  "cleanup member status history",
+			CounterOpts:   prometheus.CounterOpts{Name: "cleanup_member_status_history"},
+			CounterLabels: []string{"count"},
+		},
+		Interval: time.Minute,
+		Task:     e.CleanupMemberStatusHistory,
+	}).Setup(registerer)
+}
+
 func HouseKeeping() error {
 	if !config.Global.HouseKeeping.Enabled {
 		log.Fatal("Housekeeping disabled")
@@ -135,10 +193,33 @@ func HouseKeeping() error {
 	// Mapper function for SQL name mapping, snake_case table names
 	db.MapperFunc(strcase.ToSnake)
 
-	executor := Executor{db}
+	// Prometheus Metrics
+	if config.Global.Default.Prometheus {
+		go utils.PrometheusListen()
+	}
+
+	RegisterMetrics(prometheus.DefaultRegisterer)
+	executor := Executor{DB: db}
 	ctx, cancel := context.WithCancel(context.Background())
 	go executor.EventTranslationJob(prometheus.DefaultRegisterer).Run(ctx)
 	go executor.CleanupDeletedDomainsCronJob(prometheus.DefaultRegisterer).Run(ctx)
+	go executor.CleanupMemberStatusHistoryCronJob(prometheus.DefaultRegisterer).Run(ctx)
+	go executor.CheckStuckResourcesCronJob(prometheus.DefaultRegisterer).Run(ctx)
+	go executor.PurgeDeletedPoolChildrenCronJob(prometheus.DefaultRegisterer).Run(ctx)
+	go executor.CleanupDeletedDatacentersAndGeomapsCronJob(prometheus.DefaultRegisterer).Run(ctx)
+	if config.Global.HouseKeeping.QuotaCleanup {
+		if executor.Identity, err = NewIdentityClient(ctx); err != nil {
+			log.WithError(err).Fatal("Failed to connect to keystone")
+		}
+		go executor.CleanupOrphanedQuotasCronJob(prometheus.DefaultRegisterer).Run(ctx)
+	}
+	if config.Global.KeystoneNotifications.Enabled {
+		if executor.NC, err = nats.Connect(config.Global.Default.TransportURL); err != nil {
+			log.WithError(err).Fatal("Failed to connect to NATS")
+		}
+		defer executor.NC.Close()
+		go executor.KeystoneNotificationListener(ctx)
+	}
 
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package housekeeping
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/jmoiron/sqlx"
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/sapcc/andromeda/db"
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/driver"
+)
+
+const projectDeletedEventType = "identity.project.deleted"
+
+// keystoneNotification is an oslo.messaging notification emitted by Keystone, the project ID is
+// the resource_info of basic notifications or the target of CADF notifications.
+type keystoneNotification struct {
+	EventType string `json:"event_type"`
+	Payload   struct {
+		ResourceInfo string `json:"resource_info"`
+		Target       struct {
+			ID string `json:"id"`
+		} `json:"target"`
+	} `json:"payload"`
+}
+
+// parseProjectDeletion returns the ID of the deleted project, or an empty string for other notifications
+func parseProjectDeletion(body []byte) (string, error) {
+	// oslo.messaging v2 wraps the notification into an envelope
+	var envelope struct {
+		Message *string `json:"oslo.message"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return "", err
+	}
+	if envelope.Message != nil {
+		body = []byte(*envelope.Message)
+	}
+
+	var notification keystoneNotification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return "", err
+	}
+	if notification.EventType != projectDeletedEventType {
+		return "", nil
+	}
+	if notification.Payload.ResourceInfo != "" {
+		return notification.Payload.ResourceInfo, nil
+	}
+	if notification.Payload.Target.ID != "" {
+		return notification.Payload.Target.ID, nil
+	}
+	return "", fmt.Errorf("%s notification without project id", projectDeletedEventType)
+}
+
+// projectResources lists the tables of project resources and their change event model, datacenters in
+// public scope are shared with other projects and thus kept.
+var projectResources = []struct {
+	table, model, columns, condition string
+}{
+	{"domain", "DOMAIN", "id, provider", ""},
+	{"pool", "POOL", "id", ""},
+	{"member", "MEMBER", "id", ""},
+	{"monitor", "MONITOR", "id", ""},
+	{"datacenter", "DATACENTER", "id, provider", " AND scope != 'public'"},
+	{"geographic_map", "GEOMAP", "id, provider", ""},
+}
+
+// DeleteProjectResources cascades all resources of the project to PENDING_DELETE and removes its quota.
+// Pools not related to any domain are deleted right away, as done by the pool API. The returned change
+// events are to be published once the transaction is committed.
+func DeleteProjectResources(tx *sqlx.Tx, projectID string) ([]driver.ChangeEvent, error) {
+	sql := tx.Rebind(`
+		DELETE FROM pool
+		WHERE project_id = ? AND NOT EXISTS (SELECT 1 FROM domain_pool_relation dpr WHERE dpr.pool_id = pool.id)`)
+	if _, err := tx.Exec(sql, projectID); err != nil {
+		return nil, err
+	}
+
+	var events []driver.ChangeEvent
+	for _, resource := range projectResources {
+		where := `project_id = ? AND provisioning_status NOT IN ('PENDING_DELETE', 'DELETED')` + resource.condition
+		var rows []struct {
+			ID       string `db:"id"`
+			Provider string `db:"provider"`
+		}
+		sql = tx.Rebind(fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, resource.columns, resource.table, where))
+		if err := tx.Select(&rows, sql, projectID); err != nil {
+			return nil, err
+		}
+		sql = tx.Rebind(fmt.Sprintf(`
+			UPDATE %s SET provisioning_status = 'PENDING_DELETE', updated_at = NOW()
+			WHERE %s`, resource.table, where))
+		if _, err := tx.Exec(sql, projectID); err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			events = append(events, driver.ChangeEvent{
+				Model: resource.model, ID: row.ID, Provider: row.Provider, Status: "PENDING_DELETE"})
+		}
+	}
+
+	_, err := tx.Exec(tx.Rebind(`DELETE FROM quota WHERE project_id = ?`), projectID)
+	return events, err
+}
+
+func (e *Executor) handleKeystoneNotification(delivery amqp.Delivery) error {
+	projectID, err := parseProjectDeletion(delivery.Body)
+	if err != nil {
+		// malformed notifications are dropped, they would be redelivered forever
+		log.WithError(err).Warn("Ignoring malformed keystone notification")
+		return delivery.Reject(false)
+	}
+	if projectID == "" {
+		return delivery.Ack(false)
+	}
+
+	var events []driver.ChangeEvent
+	if err = db.TxExecute(e.DB, func(tx *sqlx.Tx) (err error) {
+		events, err = DeleteProjectResources(tx, projectID)
+		return err
+	}); err != nil {
+		log.WithError(err).WithField("project_id", projectID).Error("Failed deleting resources of deleted project")
+		return delivery.Nack(false, true)
+	}
+	log.WithField("project_id", projectID).Info("Deleting resources of deleted project")
+
+	// agents sync the pending deletions right away instead of waiting for their next periodic sync
+	if e.NC != nil {
+		for _, event := range events {
+			if err = driver.PublishChange(e.NC, event); err != nil {
+				log.WithError(err).Warn("Failed publishing change event")
+			}
+		}
+	}
+	return delivery.Ack(false)
+}
+
+func (e *Executor) consumeKeystoneNotifications(ctx context.Context) error {
+	cfg := config.Global.KeystoneNotifications
+	conn, err := amqp.Dial(cfg.TransportURL)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	if _, err = ch.QueueDeclare(cfg.QueueName, true, false, false, false, nil); err != nil {
+		return err
+	}
+	if err = ch.QueueBind(cfg.QueueName, cfg.Topic, cfg.Exchange, false, nil); err != nil {
+		return err
+	}
+	deliveries, err := ch.ConsumeWithContext(ctx, cfg.QueueName, "andromeda-house-keeping",
+		false, false, false, false, nil)
+	if err != nil {
+		return err
+	}
+
+	log.WithField("queue", cfg.QueueName).Info("Listening for deleted keystone projects")
+	for delivery := range deliveries {
+		if err = e.handleKeystoneNotification(delivery); err != nil {
+			return err
+		}
+	}
+	return errors.New("keystone notification channel closed")
+}
+
+// KeystoneNotificationListener deletes the resources of projects deleted in Keystone until the context is done,
+// reconnecting to the notification bus on failures.
+func (e *Executor) KeystoneNotificationListener(ctx context.Context) {
+	for {
+		err := e.consumeKeystoneNotifications(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.WithError(err).Error("Keystone notification listener failed, reconnecting")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(10 * time.Second):
+		}
+	}
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package housekeeping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProjectDeletion(t *testing.T) {
+	for _, tc := range []struct {
+		body      string
+		projectID string
+	}{
+		{`{"event_type": "identity.project.deleted", "payload": {"resource_info": "p1"}}`, "p1"},
+		{`{"event_type": "identity.project.deleted", "payload": {"target": {"id": "p2"}}}`, "p2"},
+		{`{"oslo.version": "2.0", "oslo.message": "{\"event_type\": \"identity.project.deleted\", \"payload\": {\"resource_info\": \"p3\"}}"}`, "p3"},
+		{`{"event_type": "identity.project.updated", "payload": {"resource_info": "p4"}}`, ""},
+	} {
+		projectID, err := parseProjectDeletion([]byte(tc.body))
+		assert.NoError(t, err, tc.body)
+		assert.Equal(t, tc.projectID, projectID, tc.body)
+	}
+
+	_, err := parseProjectDeletion([]byte(`{"event_type": "identity.project.deleted", "payload": {}}`))
+	assert.Error(t, err)
+	_, err = parseProjectDeletion([]byte(`not json`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package housekeeping
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/projects"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sapcc/go-bits/jobloop"
+
+	"github.com/sapcc/andromeda/internal/config"
+)
+
+// NewIdentityClient returns a Keystone client authenticated with the service credentials
+func NewIdentityClient(ctx context.Context) (*gophercloud.ServiceClient, error) {
+	authInfo := config.Global.ServiceAuth
+	authOpts, err := clientconfig.AuthOptions(&clientconfig.ClientOpts{AuthInfo: &authInfo})
+	if err != nil {
+		return nil, err
+	}
+	authOpts.AllowReauth = true
+	providerClient, err := openstack.AuthenticatedClient(ctx, *authOpts)
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewIdentityV3(providerClient, gophercloud.EndpointOpts{})
+}
+
+// CleanupOrphanedQuotas deletes the quotas of projects that no longer exist in Keystone
+func (e *Executor) CleanupOrphanedQuotas(ctx context.Context, labels prometheus.Labels) error {
+	var projectIDs []string
+	if err := e.DB.Select(&projectIDs, `SELECT project_id FROM quota`); err != nil {
+		return err
+	}
+
+	var count int64
+	for _, projectID := range projectIDs {
+		err := projects.Get(ctx, e.Identity, projectID).Err
+		if err == nil {
+			continue
+		}
+		if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return err
+		}
+
+		if _, err = e.DB.Exec(e.DB.Rebind(`DELETE FROM quota WHERE project_id = ?`), projectID); err != nil {
+			return err
+		}
+		log.WithField("project_id", projectID).Info("Removed quota of deleted project")
+		count++
+	}
+	labels["count"] = strconv.FormatInt(count, 10)
+	return nil
+}
+
+func (e *Executor) CleanupOrphanedQuotasCronJob(registerer prometheus.Registerer) jobloop.Job {
+	return (&jobloop.CronJob{
+		Metadata: jobloop.JobMetadata{
+			ReadableName:  "cleanup quotas of deleted projects",
+			CounterOpts:   prometheus.CounterOpts{Name: "cleanup_orphaned_quotas"},
+			CounterLabels: []string{"count"},
+		},
+		Interval: time.Second * time.Duration(config.Global.HouseKeeping.QuotaInterval),
+		Task:     e.CleanupOrphanedQuotas,
+	}).Setup(registerer)
+}
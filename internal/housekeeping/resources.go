@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package housekeeping
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sapcc/go-bits/jobloop"
+
+	"github.com/sapcc/andromeda/internal/config"
+	"github.com/sapcc/andromeda/internal/rpc/server"
+)
+
+// stuckResourceTables maps the resource types reported as stuck to their tables
+var stuckResourceTables = map[string]string{
+	"domain":     "domain",
+	"pool":       "pool",
+	"member":     "member",
+	"monitor":    "monitor",
+	"datacenter": "datacenter",
+	"geomap":     "geographic_map",
+}
+
+var stuckResourcesGauge = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "andromeda_stuck_resources",
+		Help: "Number of resources in PENDING_* for longer than the house keeping stuck_timeout.",
+	},
+	[]string{"resource_type", "provisioning_status"},
+)
+
+// RegisterMetrics registers the metrics of the house keeping jobs
+func RegisterMetrics(registerer prometheus.Registerer) {
+	registerer.MustRegister(stuckResourcesGauge)
+	server.RegisterFlappingGauge(registerer)
+}
+
+// CheckStuckResources counts the resources in PENDING_* that were not updated within the stuck timeout
+func (e *Executor) CheckStuckResources(_ context.Context, labels prometheus.Labels) error {
+	before := time.Now().UTC().Add(-time.Duration(config.Global.HouseKeeping.StuckTimeout) * time.Second)
+	sql := `
+		SELECT provisioning_status, COUNT(*) AS count
+		FROM %s
+		WHERE provisioning_status LIKE 'PENDING_%%' AND updated_at < ?
+		GROUP BY provisioning_status`
+
+	var total int64
+	stuckResourcesGauge.Reset()
+	for resourceType, table := range stuckResourceTables {
+		var rows []struct {
+			ProvisioningStatus string `db:"provisioning_status"`
+			Count              int64  `db:"count"`
+		}
+		if err := e.DB.Select(&rows, e.DB.Rebind(fmt.Sprintf(sql, table)), before); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			stuckResourcesGauge.WithLabelValues(resourceType, row.ProvisioningStatus).Set(float64(row.Count))
+			log.WithFields(log.Fields{"resource_type": resourceType, "provisioning_status": row.ProvisioningStatus}).
+				Warnf("%d resources stuck for more than %d seconds", row.Count, config.Global.HouseKeeping.StuckTimeout)
+			total += row.Count
+		}
+	}
+	labels["count"] = strconv.FormatInt(total, 10)
+	return nil
+}
+
+// PurgeDeletedPoolChildren deletes the members and monitors of pools in PENDING_DELETE, they are never
+// provisioned again and are left over until the agents confirm the deletion of the pool.
+func (e *Executor) PurgeDeletedPoolChildren(_ context.Context, labels prometheus.Labels) error {
+	before := time.Now().UTC().Add(-time.Duration(config.Global.HouseKeeping.DeleteAfter) * time.Second)
+	sql := `
+		DELETE FROM %s
+		WHERE pool_id IN (
+			SELECT id FROM pool WHERE provisioning_status = 'PENDING_DELETE' AND updated_at < ?
+		)`
+
+	var total int64
+	for _, table := range []string{"member", "monitor"} {
+		res, err := e.DB.Exec(e.DB.Rebind(fmt.Sprintf(sql, table)), before)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected > 0 {
+			log.Infof("Purged %d %ss of deleted pools", rowsAffected, table)
+		}
+		total += rowsAffected
+	}
+	labels["count"] = strconv.FormatInt(total, 10)
+	return nil
+}
+
+// CleanupDeletedDatacentersAndGeomaps deletes geographic maps and datacenters left in DELETED, e.g. by agents
+// reporting DELETED before deletions were applied by the server. Datacenters still referenced by members or
+// geographic maps are kept.
+func (e *Executor) CleanupDeletedDatacentersAndGeomaps(_ context.Context, labels prometheus.Labels) error {
+	before := time.Now().UTC().Add(-time.Duration(config.Global.HouseKeeping.DeleteAfter) * time.Second)
+	var total int64
+	for _, sql := range []string{`
+		DELETE FROM geographic_map
+		WHERE provisioning_status = 'DELETED' AND updated_at < ?`, `
+		DELETE FROM datacenter
+		WHERE provisioning_status = 'DELETED' AND updated_at < ?
+			AND NOT EXISTS (SELECT 1 FROM member WHERE member.datacenter_id = datacenter.id)
+			AND NOT EXISTS (SELECT 1 FROM geographic_map gm WHERE gm.default_datacenter = datacenter.id)
+			AND NOT EXISTS (SELECT 1 FROM geographic_map_assignment gma WHERE gma.datacenter = datacenter.id)`,
+	} {
+		res, err := e.DB.Exec(e.DB.Rebind(sql), before)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		total += rowsAffected
+	}
+	labels["count"] = strconv.FormatInt(total, 10)
+	if total > 0 {
+		log.Infof("Cleaned up %d datacenters and geographic maps", total)
+	}
+	return nil
+}
+
+func (e *Executor) CheckStuckResourcesCronJob(registerer prometheus.Registerer) jobloop.Job {
+	return (&jobloop.CronJob{
+		Metadata: jobloop.JobMetadata{
+			ReadableName:  "check stuck resources",
+			CounterOpts:   prometheus.CounterOpts{Name: "check_stuck_resources"},
+			CounterLabels: []string{"count"},
+		},
+		Interval: time.Second * time.Duration(config.Global.HouseKeeping.Interval),
+		Task:     e.CheckStuckResources,
+	}).Setup(registerer)
+}
+
+func (e *Executor) PurgeDeletedPoolChildrenCronJob(registerer prometheus.Registerer) jobloop.Job {
+	return (&jobloop.CronJob{
+		Metadata: jobloop.JobMetadata{
+			ReadableName:  "purge members and monitors of deleted pools",
+			CounterOpts:   prometheus.CounterOpts{Name: "purge_deleted_pool_children"},
+			CounterLabels: []string{"count"},
+		},
+		Interval: time.Second * time.Duration(config.Global.HouseKeeping.Interval),
+		Task:     e.PurgeDeletedPoolChildren,
+	}).Setup(registerer)
+}
+
+func (e *Executor) CleanupDeletedDatacentersAndGeomapsCronJob(registerer prometheus.Registerer) jobloop.Job {
+	return (&jobloop.CronJob{
+		Metadata: jobloop.JobMetadata{
+			ReadableName:  "cleanup deleted datacenters and geographic maps",
+			CounterOpts:   prometheus.CounterOpts{Name: "cleanup_deleted_datacenters_and_geomaps"},
+			CounterLabels: []string{"count"},
+		},
+		Interval: time.Second * time.Duration(config.Global.HouseKeeping.Interval),
+		Task:     e.CleanupDeletedDatacentersAndGeomaps,
+	}).Setup(registerer)
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	dbsql "database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/apex/log"
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sapcc/andromeda/internal/config"
+)
+
+var (
+	memberStatusTransitionsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "andromeda_member_status_transitions_total",
+			Help: "Number of member status transitions by new status.",
+		},
+		[]string{"status"},
+	)
+	memberFlapsCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "andromeda_member_flaps_total",
+			Help: "Number of times members started flapping.",
+		},
+	)
+	memberFlappingGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "andromeda_member_flapping",
+			Help: "Number of members flapping, as of the last status update or flap reset.",
+		},
+	)
+)
+
+// RegisterMetrics registers the metrics of the RPC server
+func RegisterMetrics(registerer prometheus.Registerer) {
+	registerer.MustRegister(memberStatusTransitionsCounter, memberFlapsCounter, memberFlappingGauge)
+}
+
+// RegisterFlappingGauge registers the gauge of flapping members only, for house keeping resetting members
+// which stopped flapping
+func RegisterFlappingGauge(registerer prometheus.Registerer) {
+	registerer.MustRegister(memberFlappingGauge)
+}
+
+// recordMemberStatus writes a status history entry if the status of the member changes, and marks members
+// with at least flap_threshold transitions within the flap_window as flapping.
+func recordMemberStatus(tx *sqlx.Tx, memberID, status string) error {
+	var member struct {
+		Status   string `db:"status"`
+		Flapping bool   `db:"flapping"`
+	}
+	sql, args := sq.Select("status", "flapping").
+		From("member").
+		Where("id = ?", memberID).
+		Suffix("FOR UPDATE").
+		MustSql()
+	if err := tx.Get(&member, tx.Rebind(sql), args...); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if member.Status == status && !member.Flapping {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	if member.Status != status {
+		sql, args = sq.Insert("member_status_history").
+			Columns("member_id", "status", "previous_status", "created_at").
+			Values(memberID, status, member.Status, now).
+			MustSql()
+		if _, err := tx.Exec(tx.Rebind(sql), args...); err != nil {
+			return err
+		}
+		memberStatusTransitionsCounter.WithLabelValues(status).Inc()
+	}
+
+	flapping := false
+	if threshold := config.Global.StatusHistory.FlapThreshold; threshold > 0 {
+		since := now.Add(-time.Duration(config.Global.StatusHistory.FlapWindow) * time.Second)
+		sql, args = sq.Select("COUNT(*)").
+			From("member_status_history").
+			Where(sq.And{sq.Eq{"member_id": memberID}, sq.GtOrEq{"created_at": since}}).
+			MustSql()
+		var transitions int64
+		if err := tx.Get(&transitions, tx.Rebind(sql), args...); err != nil {
+			return err
+		}
+		flapping = transitions >= threshold
+	}
+	if flapping == member.Flapping {
+		return nil
+	}
+
+	if flapping {
+		log.WithField("member", memberID).Warn("Member is flapping")
+		memberFlapsCounter.Inc()
+	} else {
+		log.WithField("member", memberID).Info("Member stopped flapping")
+	}
+	sql, args = sq.Update("member").Set("flapping", flapping).Where("id = ?", memberID).MustSql()
+	_, err := tx.Exec(tx.Rebind(sql), args...)
+	return err
+}
+
+// UpdateFlappingGauge sets the gauge to the number of flapping members
+func UpdateFlappingGauge(db *sqlx.DB) {
+	var flapping int
+	if err := db.Get(&flapping, `SELECT COUNT(*) FROM member WHERE flapping`); err != nil {
+		log.WithError(err).Warn("Failed counting flapping members")
+		return
+	}
+	memberFlappingGauge.Set(float64(flapping))
+}
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-openapi/strfmt"
+	"github.com/jmoiron/sqlx"
+)
+
+type provisioningModel = ProvisioningStatusRequest_ProvisioningStatus_Model
+
+// provisioningTables maps the models to their tables, deletion rank orders children before their parents
+var provisioningTables = map[provisioningModel]struct {
+	table        string
+	deletionRank int
+}{
+	ProvisioningStatusRequest_ProvisioningStatus_MEMBER:         {"member", 0},
+	ProvisioningStatusRequest_ProvisioningStatus_MONITOR:        {"monitor", 0},
+	ProvisioningStatusRequest_ProvisioningStatus_GEOGRAPHIC_MAP: {"geographic_map", 0},
+	ProvisioningStatusRequest_ProvisioningStatus_POOL:           {"pool", 1},
+	ProvisioningStatusRequest_ProvisioningStatus_DOMAIN:         {"domain", 2},
+	ProvisioningStatusRequest_ProvisioningStatus_DATACENTER:     {"datacenter", 3},
+}
+
+// provisioningTransitions lists the provisioning status an agent may report for each current provisioning status,
+// reporting the current status again is always allowed.
+var provisioningTransitions = map[string][]string{
+	"PENDING_CREATE": {"ACTIVE", "PENDING_UPDATE", "ERROR"},
+	"PENDING_UPDATE": {"ACTIVE", "ERROR"},
+	"PENDING_DELETE": {"DELETED", "ERROR"},
+	"ACTIVE":         {"PENDING_UPDATE", "ERROR"},
+	"ERROR":          {},
+}
+
+// IsValidProvisioningTransition returns true if the provisioning status may change from current to next
+func IsValidProvisioningTransition(current, next string) bool {
+	return current == next || slices.Contains(provisioningTransitions[current], next)
+}
+
+// provisioningOrder returns the indexes of the requests in the order they are applied: updates first in
+// request order, followed by deletions with children deleted before their parents.
+func provisioningOrder(requests []*ProvisioningStatusRequest_ProvisioningStatus) []int {
+	rank := func(req *ProvisioningStatusRequest_ProvisioningStatus) int {
+		if req.GetStatus() != ProvisioningStatusRequest_ProvisioningStatus_DELETED {
+			return -1
+		}
+		return provisioningTables[req.GetModel()].deletionRank
+	}
+
+	order := make([]int, len(requests))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return rank(requests[a]) - rank(requests[b])
+	})
+	return order
+}
+
+// applyProvisioningStatus validates and applies a single provisioning status update inside the transaction.
+// Validation failures are returned as unsuccessful result, database errors abort the transaction.
+func applyProvisioningStatus(tx *sqlx.Tx, req *ProvisioningStatusRequest_ProvisioningStatus) (*StatusResult, error) {
+	result := &StatusResult{Id: req.GetId()}
+	model, ok := provisioningTables[req.GetModel()]
+	if !ok {
+		return failedResult(result, StatusResult_NOT_FOUND, "unknown model %s", req.GetModel())
+	}
+	next := req.GetStatus().String()
+
+	var current string
+	sql, args := sq.Select("provisioning_status").
+		From(model.table).
+		Where("id = ?", req.GetId()).
+		Suffix("FOR UPDATE").
+		MustSql()
+	if err := tx.Get(&current, tx.Rebind(sql), args...); err != nil {
+		if !errors.Is(err, dbsql.ErrNoRows) {
+			return nil, err
+		}
+		if next == "DELETED" {
+			// already deleted
+			result.Success = true
+			return result, nil
+		}
+		return failedResult(result, StatusResult_NOT_FOUND, "%s %s not found", model.table, req.GetId())
+	}
+
+	if !IsValidProvisioningTransition(current, next) {
+		return failedResult(result, StatusResult_INVALID_TRANSITION,
+			"invalid transition of %s %s from %s to %s", model.table, req.GetId(), current, next)
+	}
+	if current == next && next != "ERROR" {
+		result.Success = true
+		return result, nil
+	}
+
+	if next == "DELETED" {
+		if model.table == "datacenter" {
+			referenced, err := isDatacenterReferenced(tx, req.GetId())
+			if err != nil {
+				return nil, err
+			}
+			if referenced {
+				return failedResult(result, StatusResult_DEPENDENCY,
+					"datacenter %s is still referenced by members or geographic maps", req.GetId())
+			}
+		}
+		softDeleted, err := isSoftDeleted(tx, model.table, req.GetId())
+		if err != nil {
+			return nil, err
+		}
+		if !softDeleted {
+			if err = deleteWithStatus(tx, model.table, req.GetId()); err != nil {
+				return nil, err
+			}
+			result.Success = true
+			return result, nil
+		}
+	}
+
+	update := sq.Update(model.table).
+		Set("provisioning_status", next).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where("id = ?", req.GetId())
+	switch next {
+	case "ERROR":
+		var provisioningError *string
+		if req.GetError() != "" {
+			provisioningError = &req.Error
+		}
+		update = update.Set("provisioning_error", provisioningError).Set("last_error_at", time.Now().UTC())
+	case "ACTIVE":
+		update = update.Set("provisioning_error", nil)
+	}
+	sql, args = update.MustSql()
+	if _, err := tx.Exec(tx.Rebind(sql), args...); err != nil {
+		return nil, err
+	}
+
+	result.Success = true
+	return result, nil
+}
+
+func failedResult(result *StatusResult, reason StatusResult_Reason, format string, a ...any) (*StatusResult, error) {
+	result.Reason = reason
+	result.Message = fmt.Sprintf(format, a...)
+	return result, nil
+}
+
+// isSoftDeleted returns true for domains kept in DELETED after their deletion, until house keeping purges
+// them after the restore window.
+func isSoftDeleted(tx *sqlx.Tx, table, id string) (bool, error) {
+	if table != "domain" {
+		return false, nil
+	}
+	var deletedAt *strfmt.DateTime
+	err := tx.Get(&deletedAt, tx.Rebind(`SELECT deleted_at FROM domain WHERE id = ?`), id)
+	return deletedAt != nil, err
+}
+
+// isDatacenterReferenced returns true if members or geographic maps still reference the datacenter
+func isDatacenterReferenced(tx *sqlx.Tx, datacenterID string) (bool, error) {
+	var referenced bool
+	sql := tx.Rebind(`SELECT
+		EXISTS(SELECT 1 FROM member WHERE datacenter_id = ?) OR
+		EXISTS(SELECT 1 FROM geographic_map WHERE default_datacenter = ?) OR
+		EXISTS(SELECT 1 FROM geographic_map_assignment WHERE datacenter = ?)`)
+	err := tx.Get(&referenced, sql, datacenterID, datacenterID, datacenterID)
+	return referenced, err
+}
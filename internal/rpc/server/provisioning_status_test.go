@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidProvisioningTransition(t *testing.T) {
+	assert.True(t, IsValidProvisioningTransition("PENDING_CREATE", "ACTIVE"))
+	assert.True(t, IsValidProvisioningTransition("PENDING_UPDATE", "ERROR"))
+	assert.True(t, IsValidProvisioningTransition("PENDING_DELETE", "DELETED"))
+	assert.True(t, IsValidProvisioningTransition("ACTIVE", "ACTIVE"))
+	assert.True(t, IsValidProvisioningTransition("ERROR", "ERROR"))
+
+	assert.False(t, IsValidProvisioningTransition("PENDING_DELETE", "ACTIVE"))
+	assert.False(t, IsValidProvisioningTransition("ACTIVE", "DELETED"))
+	assert.False(t, IsValidProvisioningTransition("ERROR", "ACTIVE"))
+	assert.False(t, IsValidProvisioningTransition("PENDING_CREATE", "DELETED"))
+}
+
+func TestProvisioningOrder(t *testing.T) {
+	request := func(model ProvisioningStatusRequest_ProvisioningStatus_Model,
+		status ProvisioningStatusRequest_ProvisioningStatus_StatusType) *ProvisioningStatusRequest_ProvisioningStatus {
+		return &ProvisioningStatusRequest_ProvisioningStatus{Model: model, Status: status}
+	}
+	requests := []*ProvisioningStatusRequest_ProvisioningStatus{
+		request(ProvisioningStatusRequest_ProvisioningStatus_DOMAIN, ProvisioningStatusRequest_ProvisioningStatus_DELETED),
+		request(ProvisioningStatusRequest_ProvisioningStatus_DATACENTER, ProvisioningStatusRequest_ProvisioningStatus_DELETED),
+		request(ProvisioningStatusRequest_ProvisioningStatus_POOL, ProvisioningStatusRequest_ProvisioningStatus_DELETED),
+		request(ProvisioningStatusRequest_ProvisioningStatus_DOMAIN, ProvisioningStatusRequest_ProvisioningStatus_ACTIVE),
+		request(ProvisioningStatusRequest_ProvisioningStatus_MEMBER, ProvisioningStatusRequest_ProvisioningStatus_DELETED),
+		request(ProvisioningStatusRequest_ProvisioningStatus_POOL, ProvisioningStatusRequest_ProvisioningStatus_ACTIVE),
+	}
+	assert.Equal(t, []int{3, 5, 4, 2, 0, 1}, provisioningOrder(requests))
+}
@@ -194,6 +194,61 @@ func (MemberStatusRequest_MemberStatus_StatusType) EnumDescriptor() ([]byte, []i
 	return file_internal_rpc_server_rpc_server_proto_rawDescGZIP(), []int{9, 0, 0}
 }
 
+type StatusResult_Reason int32
+
+const (
+	StatusResult_NONE StatusResult_Reason = 0
+	// the object does not exist (anymore), do not retry
+	StatusResult_NOT_FOUND StatusResult_Reason = 1
+	// the transition is not allowed from the current provisioning status, do not retry
+	StatusResult_INVALID_TRANSITION StatusResult_Reason = 2
+	// the object is still referenced by other objects, retry once those are deleted
+	StatusResult_DEPENDENCY StatusResult_Reason = 3
+)
+
+// Enum value maps for StatusResult_Reason.
+var (
+	StatusResult_Reason_name = map[int32]string{
+		0: "NONE",
+		1: "NOT_FOUND",
+		2: "INVALID_TRANSITION",
+		3: "DEPENDENCY",
+	}
+	StatusResult_Reason_value = map[string]int32{
+		"NONE":               0,
+		"NOT_FOUND":          1,
+		"INVALID_TRANSITION": 2,
+		"DEPENDENCY":         3,
+	}
+)
+
+func (x StatusResult_Reason) Enum() *StatusResult_Reason {
+	p := new(StatusResult_Reason)
+	*p = x
+	return p
+}
+
+func (x StatusResult_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusResult_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_rpc_server_rpc_server_proto_enumTypes[3].Descriptor()
+}
+
+func (StatusResult_Reason) Type() protoreflect.EnumType {
+	return &file_internal_rpc_server_rpc_server_proto_enumTypes[3]
+}
+
+func (x StatusResult_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusResult_Reason.Descriptor instead.
+func (StatusResult_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_internal_rpc_server_rpc_server_proto_rawDescGZIP(), []int{11, 0}
+}
+
 type SearchRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Provider       string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
@@ -730,6 +785,8 @@ type StatusResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Reason        StatusResult_Reason    `protobuf:"varint,3,opt,name=reason,proto3,enum=StatusResult_Reason" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -778,6 +835,20 @@ func (x *StatusResult) GetSuccess() bool {
 	return false
 }
 
+func (x *StatusResult) GetReason() StatusResult_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return StatusResult_NONE
+}
+
+func (x *StatusResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type DatacenterMetaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -830,18 +901,276 @@ func (x *DatacenterMetaRequest) GetMeta() int32 {
 	return 0
 }
 
+type LeadershipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name of the election, e.g. the agent type
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// holder identifies the candidate replica
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// lease_duration in seconds, the lease expires unless renewed within
+	LeaseDuration int32 `protobuf:"varint,3,opt,name=lease_duration,json=leaseDuration,proto3" json:"lease_duration,omitempty"`
+	// release gives up the lease held by holder
+	Release       bool `protobuf:"varint,4,opt,name=release,proto3" json:"release,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeadershipRequest) Reset() {
+	*x = LeadershipRequest{}
+	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeadershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadershipRequest) ProtoMessage() {}
+
+func (x *LeadershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadershipRequest.ProtoReflect.Descriptor instead.
+func (*LeadershipRequest) Descriptor() ([]byte, []int) {
+	return file_internal_rpc_server_rpc_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LeadershipRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LeadershipRequest) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *LeadershipRequest) GetLeaseDuration() int32 {
+	if x != nil {
+		return x.LeaseDuration
+	}
+	return 0
+}
+
+func (x *LeadershipRequest) GetRelease() bool {
+	if x != nil {
+		return x.Release
+	}
+	return false
+}
+
+type LeadershipResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Leader bool                   `protobuf:"varint,1,opt,name=leader,proto3" json:"leader,omitempty"`
+	// holder of the lease, empty if there is no leader
+	Holder        string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeadershipResponse) Reset() {
+	*x = LeadershipResponse{}
+	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeadershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadershipResponse) ProtoMessage() {}
+
+func (x *LeadershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadershipResponse.ProtoReflect.Descriptor instead.
+func (*LeadershipResponse) Descriptor() ([]byte, []int) {
+	return file_internal_rpc_server_rpc_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LeadershipResponse) GetLeader() bool {
+	if x != nil {
+		return x.Leader
+	}
+	return false
+}
+
+func (x *LeadershipResponse) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+type HeartbeatRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Host  string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// type of the agent, e.g. andromeda-akamai-agent
+	Type         string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Version      string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Providers    []string `protobuf:"bytes,4,rep,name=providers,proto3" json:"providers,omitempty"`
+	Capabilities []string `protobuf:"bytes,5,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// last_sync is the unix timestamp of the last successful sync, 0 if there was none yet
+	LastSync      int64 `protobuf:"varint,6,opt,name=last_sync,json=lastSync,proto3" json:"last_sync,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_internal_rpc_server_rpc_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HeartbeatRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *HeartbeatRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HeartbeatRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HeartbeatRequest) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *HeartbeatRequest) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *HeartbeatRequest) GetLastSync() int64 {
+	if x != nil {
+		return x.LastSync
+	}
+	return 0
+}
+
+type HeartbeatResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// admin_state_up is false if the agent has been disabled by an administrator
+	AdminStateUp  bool `protobuf:"varint,1,opt,name=admin_state_up,json=adminStateUp,proto3" json:"admin_state_up,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_internal_rpc_server_rpc_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HeartbeatResponse) GetAdminStateUp() bool {
+	if x != nil {
+		return x.AdminStateUp
+	}
+	return false
+}
+
 type ProvisioningStatusRequest_ProvisioningStatus struct {
-	state         protoimpl.MessageState                                  `protogen:"open.v1"`
-	Id            string                                                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Model         ProvisioningStatusRequest_ProvisioningStatus_Model      `protobuf:"varint,2,opt,name=model,proto3,enum=ProvisioningStatusRequest_ProvisioningStatus_Model" json:"model,omitempty"`
-	Status        ProvisioningStatusRequest_ProvisioningStatus_StatusType `protobuf:"varint,3,opt,name=status,proto3,enum=ProvisioningStatusRequest_ProvisioningStatus_StatusType" json:"status,omitempty"`
+	state  protoimpl.MessageState                                  `protogen:"open.v1"`
+	Id     string                                                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Model  ProvisioningStatusRequest_ProvisioningStatus_Model      `protobuf:"varint,2,opt,name=model,proto3,enum=ProvisioningStatusRequest_ProvisioningStatus_Model" json:"model,omitempty"`
+	Status ProvisioningStatusRequest_ProvisioningStatus_StatusType `protobuf:"varint,3,opt,name=status,proto3,enum=ProvisioningStatusRequest_ProvisioningStatus_StatusType" json:"status,omitempty"`
+	// error describes the provisioning failure if status is ERROR
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProvisioningStatusRequest_ProvisioningStatus) Reset() {
 	*x = ProvisioningStatusRequest_ProvisioningStatus{}
-	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[13]
+	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -853,7 +1182,7 @@ func (x *ProvisioningStatusRequest_ProvisioningStatus) String() string {
 func (*ProvisioningStatusRequest_ProvisioningStatus) ProtoMessage() {}
 
 func (x *ProvisioningStatusRequest_ProvisioningStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[13]
+	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,6 +1219,13 @@ func (x *ProvisioningStatusRequest_ProvisioningStatus) GetStatus() ProvisioningS
 	return ProvisioningStatusRequest_ProvisioningStatus_ACTIVE
 }
 
+func (x *ProvisioningStatusRequest_ProvisioningStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type MemberStatusRequest_MemberStatus struct {
 	state         protoimpl.MessageState                      `protogen:"open.v1"`
 	Id            string                                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -900,7 +1236,7 @@ type MemberStatusRequest_MemberStatus struct {
 
 func (x *MemberStatusRequest_MemberStatus) Reset() {
 	*x = MemberStatusRequest_MemberStatus{}
-	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[14]
+	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -912,7 +1248,7 @@ func (x *MemberStatusRequest_MemberStatus) String() string {
 func (*MemberStatusRequest_MemberStatus) ProtoMessage() {}
 
 func (x *MemberStatusRequest_MemberStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[14]
+	mi := &file_internal_rpc_server_rpc_server_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -967,13 +1303,14 @@ const file_internal_rpc_server_rpc_server_proto_rawDesc = "" +
 	"\x0fMembersResponse\x12#\n" +
 	"\bresponse\x18\x01 \x03(\v2\a.MemberR\bresponse\"6\n" +
 	"\x0fGeomapsResponse\x12#\n" +
-	"\bresponse\x18\x01 \x03(\v2\a.GeomapR\bresponse\"\x89\x04\n" +
+	"\bresponse\x18\x01 \x03(\v2\a.GeomapR\bresponse\"\x9f\x04\n" +
 	"\x19ProvisioningStatusRequest\x12^\n" +
-	"\x13provisioning_status\x18\x01 \x03(\v2-.ProvisioningStatusRequest.ProvisioningStatusR\x12provisioningStatus\x1a\x8b\x03\n" +
+	"\x13provisioning_status\x18\x01 \x03(\v2-.ProvisioningStatusRequest.ProvisioningStatusR\x12provisioningStatus\x1a\xa1\x03\n" +
 	"\x12ProvisioningStatus\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12I\n" +
 	"\x05model\x18\x02 \x01(\x0e23.ProvisioningStatusRequest.ProvisioningStatus.ModelR\x05model\x12P\n" +
-	"\x06status\x18\x03 \x01(\x0e28.ProvisioningStatusRequest.ProvisioningStatus.StatusTypeR\x06status\"Z\n" +
+	"\x06status\x18\x03 \x01(\x0e28.ProvisioningStatusRequest.ProvisioningStatus.StatusTypeR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"Z\n" +
 	"\x05Model\x12\n" +
 	"\n" +
 	"\x06DOMAIN\x10\x00\x12\b\n" +
@@ -1009,13 +1346,38 @@ const file_internal_rpc_server_rpc_server_proto_rawDesc = "" +
 	"NO_MONITOR\x10\x02\x12\v\n" +
 	"\aUNKNOWN\x10\x03\"W\n" +
 	"\x14MemberStatusResponse\x12?\n" +
-	"\x14member_status_result\x18\x01 \x03(\v2\r.StatusResultR\x12memberStatusResult\"8\n" +
+	"\x14member_status_result\x18\x01 \x03(\v2\r.StatusResultR\x12memberStatusResult\"\xcb\x01\n" +
 	"\fStatusResult\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\";\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12,\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x14.StatusResult.ReasonR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"I\n" +
+	"\x06Reason\x12\b\n" +
+	"\x04NONE\x10\x00\x12\r\n" +
+	"\tNOT_FOUND\x10\x01\x12\x16\n" +
+	"\x12INVALID_TRANSITION\x10\x02\x12\x0e\n" +
+	"\n" +
+	"DEPENDENCY\x10\x03\";\n" +
 	"\x15DatacenterMetaRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04meta\x18\x02 \x01(\x05R\x04meta2\x86\x04\n" +
+	"\x04meta\x18\x02 \x01(\x05R\x04meta\"\x80\x01\n" +
+	"\x11LeadershipRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06holder\x18\x02 \x01(\tR\x06holder\x12%\n" +
+	"\x0elease_duration\x18\x03 \x01(\x05R\rleaseDuration\x12\x18\n" +
+	"\arelease\x18\x04 \x01(\bR\arelease\"D\n" +
+	"\x12LeadershipResponse\x12\x16\n" +
+	"\x06leader\x18\x01 \x01(\bR\x06leader\x12\x16\n" +
+	"\x06holder\x18\x02 \x01(\tR\x06holder\"\xb3\x01\n" +
+	"\x10HeartbeatRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1c\n" +
+	"\tproviders\x18\x04 \x03(\tR\tproviders\x12\"\n" +
+	"\fcapabilities\x18\x05 \x03(\tR\fcapabilities\x12\x1b\n" +
+	"\tlast_sync\x18\x06 \x01(\x03R\blastSync\"9\n" +
+	"\x11HeartbeatResponse\x12$\n" +
+	"\x0eadmin_state_up\x18\x01 \x01(\bR\fadminStateUp2\xf8\x04\n" +
 	"\tRPCServer\x12S\n" +
 	"\x18UpdateProvisioningStatus\x12\x1a.ProvisioningStatusRequest\x1a\x1b.ProvisioningStatusResponse\x12A\n" +
 	"\x12UpdateMemberStatus\x12\x14.MemberStatusRequest\x1a\x15.MemberStatusResponse\x12.\n" +
@@ -1028,7 +1390,9 @@ const file_internal_rpc_server_rpc_server_proto_rawDesc = "" +
 	"GetMembers\x12\x0e.SearchRequest\x1a\x10.MembersResponse\x12.\n" +
 	"\n" +
 	"GetGeomaps\x12\x0e.SearchRequest\x1a\x10.GeomapsResponse\x12;\n" +
-	"\x14UpdateDatacenterMeta\x12\x16.DatacenterMetaRequest\x1a\v.DatacenterB0Z.github.com/sapcc/andromeda/internal/rpc/serverb\x06proto3"
+	"\x14UpdateDatacenterMeta\x12\x16.DatacenterMetaRequest\x1a\v.Datacenter\x12<\n" +
+	"\x11AcquireLeadership\x12\x12.LeadershipRequest\x1a\x13.LeadershipResponse\x122\n" +
+	"\tHeartbeat\x12\x11.HeartbeatRequest\x1a\x12.HeartbeatResponseB0Z.github.com/sapcc/andromeda/internal/rpc/serverb\x06proto3"
 
 var (
 	file_internal_rpc_server_rpc_server_proto_rawDescOnce sync.Once
@@ -1042,71 +1406,81 @@ func file_internal_rpc_server_rpc_server_proto_rawDescGZIP() []byte {
 	return file_internal_rpc_server_rpc_server_proto_rawDescData
 }
 
-var file_internal_rpc_server_rpc_server_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_internal_rpc_server_rpc_server_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_internal_rpc_server_rpc_server_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_internal_rpc_server_rpc_server_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_internal_rpc_server_rpc_server_proto_goTypes = []any{
 	(ProvisioningStatusRequest_ProvisioningStatus_Model)(0),      // 0: ProvisioningStatusRequest.ProvisioningStatus.Model
 	(ProvisioningStatusRequest_ProvisioningStatus_StatusType)(0), // 1: ProvisioningStatusRequest.ProvisioningStatus.StatusType
 	(MemberStatusRequest_MemberStatus_StatusType)(0),             // 2: MemberStatusRequest.MemberStatus.StatusType
-	(*SearchRequest)(nil),                                // 3: SearchRequest
-	(*DomainsResponse)(nil),                              // 4: DomainsResponse
-	(*PoolsResponse)(nil),                                // 5: PoolsResponse
-	(*MonitorsResponse)(nil),                             // 6: MonitorsResponse
-	(*DatacentersResponse)(nil),                          // 7: DatacentersResponse
-	(*MembersResponse)(nil),                              // 8: MembersResponse
-	(*GeomapsResponse)(nil),                              // 9: GeomapsResponse
-	(*ProvisioningStatusRequest)(nil),                    // 10: ProvisioningStatusRequest
-	(*ProvisioningStatusResponse)(nil),                   // 11: ProvisioningStatusResponse
-	(*MemberStatusRequest)(nil),                          // 12: MemberStatusRequest
-	(*MemberStatusResponse)(nil),                         // 13: MemberStatusResponse
-	(*StatusResult)(nil),                                 // 14: StatusResult
-	(*DatacenterMetaRequest)(nil),                        // 15: DatacenterMetaRequest
-	(*ProvisioningStatusRequest_ProvisioningStatus)(nil), // 16: ProvisioningStatusRequest.ProvisioningStatus
-	(*MemberStatusRequest_MemberStatus)(nil),             // 17: MemberStatusRequest.MemberStatus
-	(*rpcmodels.Domain)(nil),                             // 18: Domain
-	(*rpcmodels.Pool)(nil),                               // 19: Pool
-	(*rpcmodels.Monitor)(nil),                            // 20: Monitor
-	(*rpcmodels.Datacenter)(nil),                         // 21: Datacenter
-	(*rpcmodels.Member)(nil),                             // 22: Member
-	(*rpcmodels.Geomap)(nil),                             // 23: Geomap
+	(StatusResult_Reason)(0),                                     // 3: StatusResult.Reason
+	(*SearchRequest)(nil),                                        // 4: SearchRequest
+	(*DomainsResponse)(nil),                                      // 5: DomainsResponse
+	(*PoolsResponse)(nil),                                        // 6: PoolsResponse
+	(*MonitorsResponse)(nil),                                     // 7: MonitorsResponse
+	(*DatacentersResponse)(nil),                                  // 8: DatacentersResponse
+	(*MembersResponse)(nil),                                      // 9: MembersResponse
+	(*GeomapsResponse)(nil),                                      // 10: GeomapsResponse
+	(*ProvisioningStatusRequest)(nil),                            // 11: ProvisioningStatusRequest
+	(*ProvisioningStatusResponse)(nil),                           // 12: ProvisioningStatusResponse
+	(*MemberStatusRequest)(nil),                                  // 13: MemberStatusRequest
+	(*MemberStatusResponse)(nil),                                 // 14: MemberStatusResponse
+	(*StatusResult)(nil),                                         // 15: StatusResult
+	(*DatacenterMetaRequest)(nil),                                // 16: DatacenterMetaRequest
+	(*LeadershipRequest)(nil),                                    // 17: LeadershipRequest
+	(*LeadershipResponse)(nil),                                   // 18: LeadershipResponse
+	(*HeartbeatRequest)(nil),                                     // 19: HeartbeatRequest
+	(*HeartbeatResponse)(nil),                                    // 20: HeartbeatResponse
+	(*ProvisioningStatusRequest_ProvisioningStatus)(nil),         // 21: ProvisioningStatusRequest.ProvisioningStatus
+	(*MemberStatusRequest_MemberStatus)(nil),                     // 22: MemberStatusRequest.MemberStatus
+	(*rpcmodels.Domain)(nil),                                     // 23: Domain
+	(*rpcmodels.Pool)(nil),                                       // 24: Pool
+	(*rpcmodels.Monitor)(nil),                                    // 25: Monitor
+	(*rpcmodels.Datacenter)(nil),                                 // 26: Datacenter
+	(*rpcmodels.Member)(nil),                                     // 27: Member
+	(*rpcmodels.Geomap)(nil),                                     // 28: Geomap
 }
 var file_internal_rpc_server_rpc_server_proto_depIdxs = []int32{
-	18, // 0: DomainsResponse.response:type_name -> Domain
-	19, // 1: PoolsResponse.response:type_name -> Pool
-	20, // 2: MonitorsResponse.response:type_name -> Monitor
-	21, // 3: DatacentersResponse.response:type_name -> Datacenter
-	22, // 4: MembersResponse.response:type_name -> Member
-	23, // 5: GeomapsResponse.response:type_name -> Geomap
-	16, // 6: ProvisioningStatusRequest.provisioning_status:type_name -> ProvisioningStatusRequest.ProvisioningStatus
-	14, // 7: ProvisioningStatusResponse.provisioning_status_result:type_name -> StatusResult
-	17, // 8: MemberStatusRequest.member_status:type_name -> MemberStatusRequest.MemberStatus
-	14, // 9: MemberStatusResponse.member_status_result:type_name -> StatusResult
-	0,  // 10: ProvisioningStatusRequest.ProvisioningStatus.model:type_name -> ProvisioningStatusRequest.ProvisioningStatus.Model
-	1,  // 11: ProvisioningStatusRequest.ProvisioningStatus.status:type_name -> ProvisioningStatusRequest.ProvisioningStatus.StatusType
-	2,  // 12: MemberStatusRequest.MemberStatus.status:type_name -> MemberStatusRequest.MemberStatus.StatusType
-	10, // 13: RPCServer.UpdateProvisioningStatus:input_type -> ProvisioningStatusRequest
-	12, // 14: RPCServer.UpdateMemberStatus:input_type -> MemberStatusRequest
-	3,  // 15: RPCServer.GetDomains:input_type -> SearchRequest
-	3,  // 16: RPCServer.GetPools:input_type -> SearchRequest
-	3,  // 17: RPCServer.GetMonitors:input_type -> SearchRequest
-	3,  // 18: RPCServer.GetDatacenters:input_type -> SearchRequest
-	3,  // 19: RPCServer.GetMembers:input_type -> SearchRequest
-	3,  // 20: RPCServer.GetGeomaps:input_type -> SearchRequest
-	15, // 21: RPCServer.UpdateDatacenterMeta:input_type -> DatacenterMetaRequest
-	11, // 22: RPCServer.UpdateProvisioningStatus:output_type -> ProvisioningStatusResponse
-	13, // 23: RPCServer.UpdateMemberStatus:output_type -> MemberStatusResponse
-	4,  // 24: RPCServer.GetDomains:output_type -> DomainsResponse
-	5,  // 25: RPCServer.GetPools:output_type -> PoolsResponse
-	6,  // 26: RPCServer.GetMonitors:output_type -> MonitorsResponse
-	7,  // 27: RPCServer.GetDatacenters:output_type -> DatacentersResponse
-	8,  // 28: RPCServer.GetMembers:output_type -> MembersResponse
-	9,  // 29: RPCServer.GetGeomaps:output_type -> GeomapsResponse
-	21, // 30: RPCServer.UpdateDatacenterMeta:output_type -> Datacenter
-	22, // [22:31] is the sub-list for method output_type
-	13, // [13:22] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	23, // 0: DomainsResponse.response:type_name -> Domain
+	24, // 1: PoolsResponse.response:type_name -> Pool
+	25, // 2: MonitorsResponse.response:type_name -> Monitor
+	26, // 3: DatacentersResponse.response:type_name -> Datacenter
+	27, // 4: MembersResponse.response:type_name -> Member
+	28, // 5: GeomapsResponse.response:type_name -> Geomap
+	21, // 6: ProvisioningStatusRequest.provisioning_status:type_name -> ProvisioningStatusRequest.ProvisioningStatus
+	15, // 7: ProvisioningStatusResponse.provisioning_status_result:type_name -> StatusResult
+	22, // 8: MemberStatusRequest.member_status:type_name -> MemberStatusRequest.MemberStatus
+	15, // 9: MemberStatusResponse.member_status_result:type_name -> StatusResult
+	3,  // 10: StatusResult.reason:type_name -> StatusResult.Reason
+	0,  // 11: ProvisioningStatusRequest.ProvisioningStatus.model:type_name -> ProvisioningStatusRequest.ProvisioningStatus.Model
+	1,  // 12: ProvisioningStatusRequest.ProvisioningStatus.status:type_name -> ProvisioningStatusRequest.ProvisioningStatus.StatusType
+	2,  // 13: MemberStatusRequest.MemberStatus.status:type_name -> MemberStatusRequest.MemberStatus.StatusType
+	11, // 14: RPCServer.UpdateProvisioningStatus:input_type -> ProvisioningStatusRequest
+	13, // 15: RPCServer.UpdateMemberStatus:input_type -> MemberStatusRequest
+	4,  // 16: RPCServer.GetDomains:input_type -> SearchRequest
+	4,  // 17: RPCServer.GetPools:input_type -> SearchRequest
+	4,  // 18: RPCServer.GetMonitors:input_type -> SearchRequest
+	4,  // 19: RPCServer.GetDatacenters:input_type -> SearchRequest
+	4,  // 20: RPCServer.GetMembers:input_type -> SearchRequest
+	4,  // 21: RPCServer.GetGeomaps:input_type -> SearchRequest
+	16, // 22: RPCServer.UpdateDatacenterMeta:input_type -> DatacenterMetaRequest
+	17, // 23: RPCServer.AcquireLeadership:input_type -> LeadershipRequest
+	19, // 24: RPCServer.Heartbeat:input_type -> HeartbeatRequest
+	12, // 25: RPCServer.UpdateProvisioningStatus:output_type -> ProvisioningStatusResponse
+	14, // 26: RPCServer.UpdateMemberStatus:output_type -> MemberStatusResponse
+	5,  // 27: RPCServer.GetDomains:output_type -> DomainsResponse
+	6,  // 28: RPCServer.GetPools:output_type -> PoolsResponse
+	7,  // 29: RPCServer.GetMonitors:output_type -> MonitorsResponse
+	8,  // 30: RPCServer.GetDatacenters:output_type -> DatacentersResponse
+	9,  // 31: RPCServer.GetMembers:output_type -> MembersResponse
+	10, // 32: RPCServer.GetGeomaps:output_type -> GeomapsResponse
+	26, // 33: RPCServer.UpdateDatacenterMeta:output_type -> Datacenter
+	18, // 34: RPCServer.AcquireLeadership:output_type -> LeadershipResponse
+	20, // 35: RPCServer.Heartbeat:output_type -> HeartbeatResponse
+	25, // [25:36] is the sub-list for method output_type
+	14, // [14:25] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_internal_rpc_server_rpc_server_proto_init() }
@@ -1119,8 +1493,8 @@ func file_internal_rpc_server_rpc_server_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_rpc_server_rpc_server_proto_rawDesc), len(file_internal_rpc_server_rpc_server_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   15,
+			NumEnums:      4,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -6,8 +6,11 @@ package server
 
 import (
 	"context"
-	"fmt"
+	dbsql "database/sql"
+	"encoding/json"
+	"errors"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/apex/log"
@@ -70,7 +73,7 @@ func (u *RPCHandler) QueryxWithIds(sql string, request *SearchRequest) (*sqlx.Ro
 
 func (u *RPCHandler) GetMembers(ctx context.Context, request *SearchRequest) (*MembersResponse, error) {
 	var response = &MembersResponse{}
-	sql := u.DB.Rebind(`SELECT id, admin_state_up, address, port, provisioning_status, datacenter_id, project_id, pool_id FROM member`)
+	sql := u.DB.Rebind(`SELECT id, admin_state_up, address, port, weight, status, provisioning_status, datacenter_id, project_id, pool_id FROM member`)
 	rows, err := u.QueryxWithIds(sql, request)
 	if err != nil {
 		return nil, err
@@ -78,7 +81,7 @@ func (u *RPCHandler) GetMembers(ctx context.Context, request *SearchRequest) (*M
 	for rows.Next() {
 		var member rpcmodels.Member
 		if err := rows.Scan(&member.Id, &member.AdminStateUp, &member.Address,
-			&member.Port, &member.ProvisioningStatus, &member.DatacenterId, &member.ProjectId, &member.PoolId); err != nil {
+			&member.Port, &member.Weight, &member.Status, &member.ProvisioningStatus, &member.DatacenterId, &member.ProjectId, &member.PoolId); err != nil {
 			return nil, err
 		}
 		response.Response = append(response.Response, &member)
@@ -88,14 +91,14 @@ func (u *RPCHandler) GetMembers(ctx context.Context, request *SearchRequest) (*M
 
 func (u *RPCHandler) GetPools(ctx context.Context, request *SearchRequest) (*PoolsResponse, error) {
 	var response = &PoolsResponse{}
-	sql := u.DB.Rebind(`SELECT id, admin_state_up FROM pool;`)
+	sql := u.DB.Rebind(`SELECT id, admin_state_up, status FROM pool;`)
 	rows, err := u.QueryxWithIds(sql, request)
 	if err != nil {
 		return nil, err
 	}
 	for rows.Next() {
 		var pool rpcmodels.Pool
-		if err := rows.Scan(&pool.Id, &pool.AdminStateUp); err != nil {
+		if err := rows.Scan(&pool.Id, &pool.AdminStateUp, &pool.Status); err != nil {
 			return nil, err
 		}
 		response.Response = append(response.Response, &pool)
@@ -231,10 +234,11 @@ func (u *RPCHandler) UpdateDatacenterMeta(ctx context.Context, req *DatacenterMe
 }
 
 func populatePools(u *RPCHandler, fullyPopulated bool, domainID string) ([]*rpcmodels.Pool, error) {
-	sql := u.DB.Rebind(`SELECT id, admin_state_up, provisioning_status 
+	sql := u.DB.Rebind(`SELECT id, admin_state_up, provisioning_status, status, dpr.priority
             FROM pool p 
             JOIN domain_pool_relation dpr ON p.id = dpr.pool_id
-            WHERE dpr.domain_id = ?`)
+            WHERE dpr.domain_id = ?
+            ORDER BY dpr.priority, p.id`)
 	rows, err := u.DB.Queryx(sql, domainID)
 	if err != nil {
 		return nil, err
@@ -299,7 +303,7 @@ func populateMonitors(u *RPCHandler, poolID string) ([]*rpcmodels.Monitor, error
 }
 
 func populateMembers(u *RPCHandler, poolID string) ([]*rpcmodels.Member, error) {
-	sql := u.DB.Rebind(`SELECT id, admin_state_up, address, port, COALESCE(datacenter_id, ''),
+	sql := u.DB.Rebind(`SELECT id, admin_state_up, address, port, weight, status, COALESCE(datacenter_id, ''),
        provisioning_status, COALESCE(project_id, '') FROM member WHERE pool_id = ?`)
 	rows, err := u.DB.Queryx(sql, poolID)
 	if err != nil {
@@ -310,7 +314,7 @@ func populateMembers(u *RPCHandler, poolID string) ([]*rpcmodels.Member, error)
 	for rows.Next() {
 		var member rpcmodels.Member
 		if err := rows.Scan(&member.Id, &member.AdminStateUp, &member.Address,
-			&member.Port, &member.DatacenterId, &member.ProvisioningStatus, &member.ProjectId); err != nil {
+			&member.Port, &member.Weight, &member.Status, &member.DatacenterId, &member.ProvisioningStatus, &member.ProjectId); err != nil {
 			log.Error(err.Error())
 			return nil, err
 		}
@@ -322,7 +326,8 @@ func populateMembers(u *RPCHandler, poolID string) ([]*rpcmodels.Member, error)
 }
 
 func (u *RPCHandler) GetDomains(ctx context.Context, request *SearchRequest) (*DomainsResponse, error) {
-	sql := `SELECT id, admin_state_up, fqdn, mode, record_type, provisioning_status
+	sql := `SELECT id, admin_state_up, fqdn, mode, record_type, ttl, failover_delay, failback_delay, handout_limit,
+                      provisioning_status, status
                FROM domain WHERE provisioning_status != 'DELETED'`
 	if request.Pending {
 		sql += ` AND provisioning_status in ('PENDING_CREATE', 'PENDING_UPDATE', 'PENDING_DELETE')`
@@ -340,6 +345,11 @@ func (u *RPCHandler) GetDomains(ctx context.Context, request *SearchRequest) (*D
 			log.Error(err.Error())
 			return nil, err
 		}
+		sql := u.DB.Rebind(`SELECT alias FROM domain_alias WHERE domain_id = ? ORDER BY alias`)
+		if err = u.DB.Select(&domain.Aliases, sql, domain.Id); err != nil {
+			log.Error(err.Error())
+			return nil, err
+		}
 
 		var datacenterIds []string
 		if request.GetFullyPopulated() {
@@ -381,38 +391,34 @@ func (u *RPCHandler) GetDomains(ctx context.Context, request *SearchRequest) (*D
 	return response, nil
 }
 
+// UpdateProvisioningStatus applies the provisioning status updates of the request in a single transaction.
+// Transitions are validated against the provisioning state machine, deletions are applied after all updates with
+// children deleted before their parents. Rejected updates are reported with reason, the results are returned
+// in request order.
 func (u *RPCHandler) UpdateProvisioningStatus(ctx context.Context, req *ProvisioningStatusRequest) (*ProvisioningStatusResponse, error) {
-	var statusResult []*StatusResult
-	for _, provStatusReq := range req.GetProvisioningStatus() {
-		table := strings.ToLower(provStatusReq.GetModel().String())
-		provStatus := provStatusReq.GetStatus().String()
-
-		var sql string
-		var err error
-		if provStatus == "DELETED" {
-			sql = u.DB.Rebind(fmt.Sprintf(`DELETE FROM %s WHERE id = ?`, table))
-			_, err = u.DB.Exec(sql, provStatusReq.GetId())
-		} else {
-			sql = u.DB.Rebind(fmt.Sprintf(`UPDATE %s SET provisioning_status = ?, updated_at = NOW() WHERE id = ?`, table))
-			if provStatus != "ERROR" {
-				// only update non-error prov-status
-				sql += " AND provisioning_status != 'ERROR'"
+	requests := req.GetProvisioningStatus()
+	statusResult := make([]*StatusResult, len(requests))
+	if err := db.TxExecute(u.DB, func(tx *sqlx.Tx) error {
+		for _, i := range provisioningOrder(requests) {
+			result, err := applyProvisioningStatus(tx, requests[i])
+			if err != nil {
+				return err
 			}
-			_, err = u.DB.Exec(sql, provStatus, provStatusReq.GetId())
-		}
-		if err != nil {
-			log.Error(err.Error())
+			if !result.GetSuccess() {
+				log.Warnf("Rejected provisioning status update: %s", result.GetMessage())
+			}
+			statusResult[i] = result
 		}
-
-		statusResult = append(statusResult, &StatusResult{
-			Id:      provStatusReq.GetId(),
-			Success: err == nil,
-		})
+		return nil
+	}); err != nil {
+		log.Error(err.Error())
+		return nil, err
 	}
 	return &ProvisioningStatusResponse{ProvisioningStatusResult: statusResult}, nil
 }
 
-// UpdateMemberStatus Updates member status according to the requests, also updates dependend pool and domain status.
+// UpdateMemberStatus Updates member status according to the requests, also aggregates the status of the related
+// pools and domains.
 func (u *RPCHandler) UpdateMemberStatus(ctx context.Context, req *MemberStatusRequest) (*MemberStatusResponse, error) {
 	var statusResult []*StatusResult
 	tx, err := u.DB.Beginx()
@@ -421,10 +427,25 @@ func (u *RPCHandler) UpdateMemberStatus(ctx context.Context, req *MemberStatusRe
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	var poolIDs []string
 	for _, memberStatusReq := range req.GetMemberStatus() {
+		var poolID string
+		sql, args := sq.Select("pool_id").From("member").Where("id = ?", memberStatusReq.GetId()).MustSql()
+		if err := tx.Get(&poolID, tx.Rebind(sql), args...); err != nil {
+			if !errors.Is(err, dbsql.ErrNoRows) {
+				return nil, err
+			}
+			// member has been deleted in the meantime
+			statusResult = append(statusResult, &StatusResult{Id: memberStatusReq.GetId(), Success: false})
+			continue
+		}
+
 		status := memberStatusReq.GetStatus().String()
-		// Set all related objects to Online
-		sql, args := sq.Update("member").
+		if err := recordMemberStatus(tx, memberStatusReq.GetId(), status); err != nil {
+			return nil, err
+		}
+
+		sql, args = sq.Update("member").
 			Set("status", status).
 			Where("id = ?", memberStatusReq.GetId()).
 			MustSql()
@@ -432,47 +453,125 @@ func (u *RPCHandler) UpdateMemberStatus(ctx context.Context, req *MemberStatusRe
 			return nil, err
 		}
 
-		sql, args = sq.Select("d.id", "p.id").
-			From("member m").
-			LeftJoin("pool p ON m.pool_id = p.id").
-			LeftJoin("domain_pool_relation dpr ON p.id = dpr.pool_id").
-			LeftJoin("domain d ON dpr.domain_id = d.id").
-			Where("m.id = ?", memberStatusReq.GetId()).
-			MustSql()
-		var domainID, poolID strfmt.UUID
-		if err := tx.QueryRowx(tx.Rebind(sql), args...).Scan(&domainID, &poolID); err != nil {
-			return nil, err
+		poolIDs = append(poolIDs, poolID)
+		statusResult = append(statusResult, &StatusResult{Id: memberStatusReq.GetId(), Success: true})
+	}
+
+	// Aggregate status of all related pools and domains
+	if err := UpdatePoolStatus(tx, poolIDs...); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	UpdateFlappingGauge(u.DB)
+	return &MemberStatusResponse{MemberStatusResult: statusResult}, nil
+}
+
+// AcquireLeadership acquires or renews the lease of an election for the holder, unless the lease is held
+// by another holder and not yet expired.
+func (u *RPCHandler) AcquireLeadership(ctx context.Context, req *LeadershipRequest) (*LeadershipResponse, error) {
+	var holder string
+	if err := db.TxExecute(u.DB, func(tx *sqlx.Tx) error {
+		var lease struct {
+			Holder    string          `db:"holder"`
+			ExpiresAt strfmt.DateTime `db:"expires_at"`
+		}
+		sql := tx.Rebind(`SELECT holder, expires_at FROM leader_lease WHERE name = ? FOR UPDATE`)
+		err := tx.Get(&lease, sql, req.GetName())
+		if err != nil && !errors.Is(err, dbsql.ErrNoRows) {
+			return err
 		}
+		now := time.Now().UTC()
+		expiresAt := now.Add(time.Duration(req.GetLeaseDuration()) * time.Second)
 
-		sql, args = sq.Select("COUNT(m2.id)").
-			From("member m").
-			InnerJoin("member m2 ON m2.pool_id = m.pool_id").
-			Where(sq.And{
-				sq.Eq{"m.id": memberStatusReq.GetId()},
-				sq.Expr("m2.id != m.id"),
-				sq.Eq{"m2.status": "ONLINE"},
-			}).MustSql()
-		var membersOnline int
-		if err := tx.Get(&membersOnline, tx.Rebind(sql), args...); err != nil {
+		switch {
+		case errors.Is(err, dbsql.ErrNoRows):
+			if req.GetRelease() {
+				return nil
+			}
+			sql = tx.Rebind(`INSERT INTO leader_lease (name, holder, acquired_at, expires_at) VALUES (?, ?, ?, ?)`)
+			_, err = tx.Exec(sql, req.GetName(), req.GetHolder(), now, expiresAt)
+			holder = req.GetHolder()
+		case req.GetRelease():
+			if lease.Holder != req.GetHolder() {
+				holder = lease.Holder
+				return nil
+			}
+			_, err = tx.Exec(tx.Rebind(`DELETE FROM leader_lease WHERE name = ?`), req.GetName())
+		case lease.Holder == req.GetHolder():
+			sql = tx.Rebind(`UPDATE leader_lease SET expires_at = ? WHERE name = ?`)
+			_, err = tx.Exec(sql, expiresAt, req.GetName())
+			holder = req.GetHolder()
+		case time.Time(lease.ExpiresAt).Before(now):
+			log.WithField("election", req.GetName()).
+				Infof("Lease of %s expired, %s takes over", lease.Holder, req.GetHolder())
+			sql = tx.Rebind(`UPDATE leader_lease SET holder = ?, acquired_at = ?, expires_at = ? WHERE name = ?`)
+			_, err = tx.Exec(sql, req.GetHolder(), now, expiresAt, req.GetName())
+			holder = req.GetHolder()
+		default:
+			holder = lease.Holder
+		}
+		return err
+	}); db.IsDuplicateKey(err) {
+		// another replica created the lease concurrently, it leads
+		sql := u.DB.Rebind(`SELECT holder FROM leader_lease WHERE name = ?`)
+		if err := u.DB.GetContext(ctx, &holder, sql, req.GetName()); err != nil {
 			return nil, err
 		}
+		return &LeadershipResponse{Leader: false, Holder: holder}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &LeadershipResponse{Leader: holder != "" && holder == req.GetHolder(), Holder: holder}, nil
+}
 
-		if status == "ONLINE" || (membersOnline == 0 && status == "OFFLINE") {
-			sql, args = sq.Update("pool").Set("status", status).Where("id = ?", poolID).MustSql()
-			if _, err := tx.Exec(tx.Rebind(sql), args...); err != nil {
-				return nil, err
-			}
+// Heartbeat registers the agent or refreshes its registration
+func (u *RPCHandler) Heartbeat(ctx context.Context, req *HeartbeatRequest) (*HeartbeatResponse, error) {
+	providers, err := json.Marshal(req.GetProviders())
+	if err != nil {
+		return nil, err
+	}
+	capabilities, err := json.Marshal(req.GetCapabilities())
+	if err != nil {
+		return nil, err
+	}
+	var lastSync *time.Time
+	if req.GetLastSync() > 0 {
+		t := time.Unix(req.GetLastSync(), 0).UTC()
+		lastSync = &t
+	}
+	now := time.Now().UTC()
 
-			sql, args = sq.Update("domain").Set("status", status).Where("id = ?", domainID).MustSql()
-			if _, err := tx.Exec(tx.Rebind(sql), args...); err != nil {
-				return nil, err
-			}
+	adminStateUp := true
+	if err := db.TxExecute(u.DB, func(tx *sqlx.Tx) error {
+		sql := tx.Rebind(`SELECT admin_state_up FROM agent WHERE host = ? AND type = ? FOR UPDATE`)
+		err := tx.Get(&adminStateUp, sql, req.GetHost(), req.GetType())
+		if errors.Is(err, dbsql.ErrNoRows) {
+			log.WithField("host", req.GetHost()).Infof("Registering agent %s", req.GetType())
+			sql = tx.Rebind(`
+				INSERT INTO agent (host, type, version, providers, capabilities, heartbeat, last_sync)
+				VALUES (?, ?, ?, ?, ?, ?, ?)`)
+			_, err = tx.Exec(sql, req.GetHost(), req.GetType(), req.GetVersion(), string(providers),
+				string(capabilities), now, lastSync)
+			return err
+		} else if err != nil {
+			return err
 		}
 
-		statusResult = append(statusResult, &StatusResult{
-			Id:      memberStatusReq.GetId(),
-			Success: err == nil,
-		})
+		sql = tx.Rebind(`
+			UPDATE agent SET
+				version = ?,
+				providers = ?,
+				capabilities = ?,
+				heartbeat = ?,
+				last_sync = COALESCE(?, last_sync)
+			WHERE host = ? AND type = ?`)
+		_, err = tx.Exec(sql, req.GetVersion(), string(providers), string(capabilities), now, lastSync,
+			req.GetHost(), req.GetType())
+		return err
+	}); err != nil {
+		return nil, err
 	}
-	return &MemberStatusResponse{MemberStatusResult: statusResult}, tx.Commit()
+	return &HeartbeatResponse{AdminStateUp: adminStateUp}, nil
 }
@@ -24,6 +24,8 @@ type RPCServerClient interface {
 	GetMembers(ctx context.Context, in *SearchRequest, opts ...stormrpc.CallOption) (*MembersResponse, error)
 	GetGeomaps(ctx context.Context, in *SearchRequest, opts ...stormrpc.CallOption) (*GeomapsResponse, error)
 	UpdateDatacenterMeta(ctx context.Context, in *DatacenterMetaRequest, opts ...stormrpc.CallOption) (*rpcmodels.Datacenter, error)
+	AcquireLeadership(ctx context.Context, in *LeadershipRequest, opts ...stormrpc.CallOption) (*LeadershipResponse, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...stormrpc.CallOption) (*HeartbeatResponse, error)
 }
 
 type rPCServerClient struct {
@@ -205,6 +207,44 @@ func (c *rPCServerClient) UpdateDatacenterMeta(ctx context.Context, in *Datacent
 	return &out, nil
 }
 
+func (c *rPCServerClient) AcquireLeadership(ctx context.Context, in *LeadershipRequest, opts ...stormrpc.CallOption) (*LeadershipResponse, error) {
+	var out LeadershipResponse
+	r, err := stormrpc.NewRequest("rpc.RPCServer.AcquireLeadership", in, stormrpc.WithEncodeProto())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := c.c.Do(ctx, r, opts...)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	if err = resp.Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+func (c *rPCServerClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...stormrpc.CallOption) (*HeartbeatResponse, error) {
+	var out HeartbeatResponse
+	r, err := stormrpc.NewRequest("rpc.RPCServer.Heartbeat", in, stormrpc.WithEncodeProto())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := c.c.Do(ctx, r, opts...)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	if err = resp.Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
 // RPCServerServer is the server API for RPCServer service.
 type RPCServerServer interface {
 	UpdateProvisioningStatus(context.Context, *ProvisioningStatusRequest) (*ProvisioningStatusResponse, error)
@@ -216,6 +256,8 @@ type RPCServerServer interface {
 	GetMembers(context.Context, *SearchRequest) (*MembersResponse, error)
 	GetGeomaps(context.Context, *SearchRequest) (*GeomapsResponse, error)
 	UpdateDatacenterMeta(context.Context, *DatacenterMetaRequest) (*rpcmodels.Datacenter, error)
+	AcquireLeadership(context.Context, *LeadershipRequest) (*LeadershipResponse, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
 }
 
 func RegisterRPCServerServer(s *stormrpc.Server, srv RPCServerServer) {
@@ -513,6 +555,70 @@ func (h *_RPCServer_UpdateDatacenterMeta_Handler) SetService(svc interface{}) {
 	h.svc = svc
 }
 
+type _RPCServer_AcquireLeadership_Handler struct {
+	route string
+	svc   interface{}
+}
+
+func (h *_RPCServer_AcquireLeadership_Handler) HandlerFunc() stormrpc.HandlerFunc {
+	return func(ctx context.Context, r stormrpc.Request) stormrpc.Response {
+		var in LeadershipRequest
+		if err := r.Decode(&in); err != nil {
+			return stormrpc.NewErrorResponse(r.Reply, fmt.Errorf("error decoding request"))
+		}
+
+		out, err := h.svc.(RPCServerServer).AcquireLeadership(ctx, &in)
+		if err != nil {
+			return stormrpc.NewErrorResponse(r.Reply, err)
+		}
+
+		resp, err := stormrpc.NewResponse(r.Reply, out, stormrpc.WithEncodeProto())
+		if err != nil {
+			return stormrpc.NewErrorResponse(r.Reply, err)
+		}
+
+		return resp
+	}
+}
+func (h *_RPCServer_AcquireLeadership_Handler) Route() string {
+	return h.route
+}
+func (h *_RPCServer_AcquireLeadership_Handler) SetService(svc interface{}) {
+	h.svc = svc
+}
+
+type _RPCServer_Heartbeat_Handler struct {
+	route string
+	svc   interface{}
+}
+
+func (h *_RPCServer_Heartbeat_Handler) HandlerFunc() stormrpc.HandlerFunc {
+	return func(ctx context.Context, r stormrpc.Request) stormrpc.Response {
+		var in HeartbeatRequest
+		if err := r.Decode(&in); err != nil {
+			return stormrpc.NewErrorResponse(r.Reply, fmt.Errorf("error decoding request"))
+		}
+
+		out, err := h.svc.(RPCServerServer).Heartbeat(ctx, &in)
+		if err != nil {
+			return stormrpc.NewErrorResponse(r.Reply, err)
+		}
+
+		resp, err := stormrpc.NewResponse(r.Reply, out, stormrpc.WithEncodeProto())
+		if err != nil {
+			return stormrpc.NewErrorResponse(r.Reply, err)
+		}
+
+		return resp
+	}
+}
+func (h *_RPCServer_Heartbeat_Handler) Route() string {
+	return h.route
+}
+func (h *_RPCServer_Heartbeat_Handler) SetService(svc interface{}) {
+	h.svc = svc
+}
+
 var rPCServerHandlers = []handler{
 	&_RPCServer_UpdateProvisioningStatus_Handler{route: "rpc.RPCServer.UpdateProvisioningStatus"},
 	&_RPCServer_UpdateMemberStatus_Handler{route: "rpc.RPCServer.UpdateMemberStatus"},
@@ -523,6 +629,8 @@ var rPCServerHandlers = []handler{
 	&_RPCServer_GetMembers_Handler{route: "rpc.RPCServer.GetMembers"},
 	&_RPCServer_GetGeomaps_Handler{route: "rpc.RPCServer.GetGeomaps"},
 	&_RPCServer_UpdateDatacenterMeta_Handler{route: "rpc.RPCServer.UpdateDatacenterMeta"},
+	&_RPCServer_AcquireLeadership_Handler{route: "rpc.RPCServer.AcquireLeadership"},
+	&_RPCServer_Heartbeat_Handler{route: "rpc.RPCServer.Heartbeat"},
 }
 
 type handler interface {
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// Operating status of members, pools and domains
+const (
+	StatusOnline    = "ONLINE"
+	StatusDegraded  = "DEGRADED"
+	StatusOffline   = "OFFLINE"
+	StatusNoMonitor = "NO_MONITOR"
+	StatusUnknown   = "UNKNOWN"
+)
+
+// AggregateStatus returns the operating status of a pool computed from the status of its enabled members,
+// or of a domain computed from the status of its enabled pools:
+//   - OFFLINE if there is nothing enabled, or everything known is OFFLINE
+//   - DEGRADED if anything is DEGRADED, or OFFLINE next to ONLINE or NO_MONITOR
+//   - ONLINE if anything is ONLINE, NO_MONITOR counts as serving
+//   - NO_MONITOR if everything known is NO_MONITOR
+//   - UNKNOWN if no status has been reported yet
+func AggregateStatus(statuses []string) string {
+	if len(statuses) == 0 {
+		return StatusOffline
+	}
+
+	offline := slices.Contains(statuses, StatusOffline)
+	online := slices.Contains(statuses, StatusOnline)
+	noMonitor := slices.Contains(statuses, StatusNoMonitor)
+	switch {
+	case slices.Contains(statuses, StatusDegraded), offline && (online || noMonitor):
+		return StatusDegraded
+	case offline:
+		return StatusOffline
+	case online:
+		return StatusOnline
+	case noMonitor:
+		return StatusNoMonitor
+	default:
+		return StatusUnknown
+	}
+}
+
+// UpdatePoolStatus recomputes the operating status of the pools and of all domains the pools are related to.
+// Members and pools with admin_state_up false are ignored, a disabled pool or domain is OFFLINE.
+func UpdatePoolStatus(tx *sqlx.Tx, poolIDs ...string) error {
+	for _, poolID := range uniqueIDs(poolIDs) {
+		var adminStateUp bool
+		sql, args := sq.Select("admin_state_up").From("pool").Where("id = ?", poolID).MustSql()
+		if err := tx.Get(&adminStateUp, tx.Rebind(sql), args...); err != nil {
+			if errors.Is(err, dbsql.ErrNoRows) {
+				continue
+			}
+			return err
+		}
+
+		status := StatusOffline
+		if adminStateUp {
+			var statuses []string
+			sql, args = sq.Select("status").
+				From("member").
+				Where(sq.Eq{"pool_id": poolID, "admin_state_up": true}).
+				Where("provisioning_status != 'PENDING_DELETE'").
+				MustSql()
+			if err := tx.Select(&statuses, tx.Rebind(sql), args...); err != nil {
+				return err
+			}
+			status = AggregateStatus(statuses)
+		}
+
+		sql, args = sq.Update("pool").Set("status", status).Where("id = ?", poolID).MustSql()
+		if _, err := tx.Exec(tx.Rebind(sql), args...); err != nil {
+			return err
+		}
+	}
+
+	if len(poolIDs) == 0 {
+		return nil
+	}
+	var domainIDs []string
+	sql, args := sq.Select("domain_id").
+		Distinct().
+		From("domain_pool_relation").
+		Where(sq.Eq{"pool_id": poolIDs}).
+		MustSql()
+	if err := tx.Select(&domainIDs, tx.Rebind(sql), args...); err != nil {
+		return err
+	}
+	return UpdateDomainStatus(tx, domainIDs...)
+}
+
+// UpdateDomainStatus recomputes the operating status of the domains from the status of their enabled pools.
+func UpdateDomainStatus(tx *sqlx.Tx, domainIDs ...string) error {
+	for _, domainID := range uniqueIDs(domainIDs) {
+		var adminStateUp bool
+		sql, args := sq.Select("admin_state_up").From("domain").Where("id = ?", domainID).MustSql()
+		if err := tx.Get(&adminStateUp, tx.Rebind(sql), args...); err != nil {
+			if errors.Is(err, dbsql.ErrNoRows) {
+				continue
+			}
+			return err
+		}
+
+		status := StatusOffline
+		if adminStateUp {
+			var statuses []string
+			sql, args = sq.Select("p.status").
+				From("pool p").
+				InnerJoin("domain_pool_relation dpr ON p.id = dpr.pool_id").
+				Where(sq.Eq{"dpr.domain_id": domainID, "p.admin_state_up": true}).
+				Where("p.provisioning_status != 'PENDING_DELETE'").
+				MustSql()
+			if err := tx.Select(&statuses, tx.Rebind(sql), args...); err != nil {
+				return err
+			}
+			status = AggregateStatus(statuses)
+		}
+
+		sql, args = sq.Update("domain").Set("status", status).Where("id = ?", domainID).MustSql()
+		if _, err := tx.Exec(tx.Rebind(sql), args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteWithStatus deletes the object and aggregates the status of the pools and domains it was related to.
+func deleteWithStatus(tx *sqlx.Tx, table, id string) error {
+	var poolIDs, domainIDs []string
+	switch table {
+	case "member":
+		sql := tx.Rebind(`SELECT pool_id FROM member WHERE id = ?`)
+		if err := tx.Select(&poolIDs, sql, id); err != nil {
+			return err
+		}
+	case "pool":
+		sql := tx.Rebind(`SELECT domain_id FROM domain_pool_relation WHERE pool_id = ?`)
+		if err := tx.Select(&domainIDs, sql, id); err != nil {
+			return err
+		}
+	}
+
+	sql := tx.Rebind(fmt.Sprintf(`DELETE FROM %s WHERE id = ?`, table))
+	if _, err := tx.Exec(sql, id); err != nil {
+		return err
+	}
+	if err := UpdatePoolStatus(tx, poolIDs...); err != nil {
+		return err
+	}
+	return UpdateDomainStatus(tx, domainIDs...)
+}
+
+func uniqueIDs(ids []string) []string {
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateStatus(t *testing.T) {
+	for _, tc := range []struct {
+		statuses []string
+		expected string
+	}{
+		{nil, StatusOffline},
+		{[]string{StatusOnline, StatusOnline}, StatusOnline},
+		{[]string{StatusOnline, StatusNoMonitor}, StatusOnline},
+		{[]string{StatusOnline, StatusUnknown}, StatusOnline},
+		{[]string{StatusNoMonitor, StatusNoMonitor}, StatusNoMonitor},
+		{[]string{StatusOnline, StatusOffline}, StatusDegraded},
+		{[]string{StatusNoMonitor, StatusOffline}, StatusDegraded},
+		{[]string{StatusOnline, StatusDegraded}, StatusDegraded},
+		{[]string{StatusOffline, StatusOffline}, StatusOffline},
+		{[]string{StatusOffline, StatusUnknown}, StatusOffline},
+		{[]string{StatusUnknown}, StatusUnknown},
+	} {
+		assert.Equal(t, tc.expected, AggregateStatus(tc.statuses), "statuses %v", tc.statuses)
+	}
+}
@@ -158,6 +158,11 @@ type Domain struct {
 	RecordType         string                 `protobuf:"bytes,7,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
 	Datacenters        []*Datacenter          `protobuf:"bytes,8,rep,name=datacenters,proto3" json:"datacenters,omitempty"`
 	ProvisioningStatus string                 `protobuf:"bytes,9,opt,name=provisioning_status,json=provisioningStatus,proto3" json:"provisioning_status,omitempty"`
+	Status             string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	Ttl                uint32                 `protobuf:"varint,11,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	FailoverDelay      uint32                 `protobuf:"varint,12,opt,name=failover_delay,json=failoverDelay,proto3" json:"failover_delay,omitempty"`
+	FailbackDelay      uint32                 `protobuf:"varint,13,opt,name=failback_delay,json=failbackDelay,proto3" json:"failback_delay,omitempty"`
+	HandoutLimit       uint32                 `protobuf:"varint,14,opt,name=handout_limit,json=handoutLimit,proto3" json:"handout_limit,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -255,6 +260,41 @@ func (x *Domain) GetProvisioningStatus() string {
 	return ""
 }
 
+func (x *Domain) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Domain) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *Domain) GetFailoverDelay() uint32 {
+	if x != nil {
+		return x.FailoverDelay
+	}
+	return 0
+}
+
+func (x *Domain) GetFailbackDelay() uint32 {
+	if x != nil {
+		return x.FailbackDelay
+	}
+	return 0
+}
+
+func (x *Domain) GetHandoutLimit() uint32 {
+	if x != nil {
+		return x.HandoutLimit
+	}
+	return 0
+}
+
 type Pool struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -262,6 +302,8 @@ type Pool struct {
 	Members            []*Member              `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
 	Monitors           []*Monitor             `protobuf:"bytes,4,rep,name=monitors,proto3" json:"monitors,omitempty"`
 	ProvisioningStatus string                 `protobuf:"bytes,5,opt,name=provisioning_status,json=provisioningStatus,proto3" json:"provisioning_status,omitempty"`
+	Status             string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Priority           uint32                 `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -331,6 +373,20 @@ func (x *Pool) GetProvisioningStatus() string {
 	return ""
 }
 
+func (x *Pool) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Pool) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 type Datacenter struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -609,6 +665,8 @@ type Member struct {
 	ProvisioningStatus string                 `protobuf:"bytes,6,opt,name=provisioning_status,json=provisioningStatus,proto3" json:"provisioning_status,omitempty"`
 	ProjectId          string                 `protobuf:"bytes,7,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	PoolId             string                 `protobuf:"bytes,8,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Weight             uint32                 `protobuf:"varint,9,opt,name=weight,proto3" json:"weight,omitempty"`
+	Status             string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -699,6 +757,20 @@ func (x *Member) GetPoolId() string {
 	return ""
 }
 
+func (x *Member) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Member) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type Monitor struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -827,7 +899,7 @@ var File_internal_rpcmodels_rpc_models_proto protoreflect.FileDescriptor
 
 const file_internal_rpcmodels_rpc_models_proto_rawDesc = "" +
 	"\n" +
-	"#internal/rpcmodels/rpc_models.proto\"\x9e\x02\n" +
+	"#internal/rpcmodels/rpc_models.proto\"\xbb\x03\n" +
 	"\x06Domain\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
 	"\x0eadmin_state_up\x18\x02 \x01(\bR\fadminStateUp\x12\x18\n" +
@@ -838,13 +910,21 @@ const file_internal_rpcmodels_rpc_models_proto_rawDesc = "" +
 	"\vrecord_type\x18\a \x01(\tR\n" +
 	"recordType\x12-\n" +
 	"\vdatacenters\x18\b \x03(\v2\v.DatacenterR\vdatacenters\x12/\n" +
-	"\x13provisioning_status\x18\t \x01(\tR\x12provisioningStatus\"\xb6\x01\n" +
+	"\x13provisioning_status\x18\t \x01(\tR\x12provisioningStatus\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x10\n" +
+	"\x03ttl\x18\v \x01(\rR\x03ttl\x12%\n" +
+	"\x0efailover_delay\x18\f \x01(\rR\rfailoverDelay\x12%\n" +
+	"\x0efailback_delay\x18\r \x01(\rR\rfailbackDelay\x12#\n" +
+	"\rhandout_limit\x18\x0e \x01(\rR\fhandoutLimit\"\xea\x01\n" +
 	"\x04Pool\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
 	"\x0eadmin_state_up\x18\x02 \x01(\bR\fadminStateUp\x12!\n" +
 	"\amembers\x18\x03 \x03(\v2\a.MemberR\amembers\x12$\n" +
 	"\bmonitors\x18\x04 \x03(\v2\b.MonitorR\bmonitors\x12/\n" +
-	"\x13provisioning_status\x18\x05 \x01(\tR\x12provisioningStatus\"\x9e\x03\n" +
+	"\x13provisioning_status\x18\x05 \x01(\tR\x12provisioningStatus\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\rR\bpriority\"\x9e\x03\n" +
 	"\n" +
 	"Datacenter\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
@@ -874,7 +954,7 @@ const file_internal_rpcmodels_rpc_models_proto_rawDesc = "" +
 	"\n" +
 	"assignment\x18\x03 \x03(\v2\x11.GeomapAssignmentR\n" +
 	"assignment\x12/\n" +
-	"\x13provisioning_status\x18\x04 \x01(\tR\x12provisioningStatus\"\xfa\x01\n" +
+	"\x13provisioning_status\x18\x04 \x01(\tR\x12provisioningStatus\"\xaa\x02\n" +
 	"\x06Member\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
 	"\x0eadmin_state_up\x18\x02 \x01(\bR\fadminStateUp\x12\x18\n" +
@@ -884,7 +964,10 @@ const file_internal_rpcmodels_rpc_models_proto_rawDesc = "" +
 	"\x13provisioning_status\x18\x06 \x01(\tR\x12provisioningStatus\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\a \x01(\tR\tprojectId\x12\x17\n" +
-	"\apool_id\x18\b \x01(\tR\x06poolId\"\x90\x04\n" +
+	"\apool_id\x18\b \x01(\tR\x06poolId\x12\x16\n" +
+	"\x06weight\x18\t \x01(\rR\x06weight\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\"\x90\x04\n" +
 	"\aMonitor\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
 	"\x0eadmin_state_up\x18\x02 \x01(\bR\fadminStateUp\x12\x1a\n" +
@@ -32,6 +32,7 @@ import (
 	"github.com/sapcc/andromeda/restapi"
 	"github.com/sapcc/andromeda/restapi/operations"
 	"github.com/sapcc/andromeda/restapi/operations/administrative"
+	"github.com/sapcc/andromeda/restapi/operations/configuration"
 	"github.com/sapcc/andromeda/restapi/operations/datacenters"
 	"github.com/sapcc/andromeda/restapi/operations/domains"
 	"github.com/sapcc/andromeda/restapi/operations/geographic_maps"
@@ -99,6 +100,8 @@ func ExecuteServer(server *restapi.Server) error {
 	api.DomainsGetDomainsDomainIDHandler = domains.GetDomainsDomainIDHandlerFunc(c.Domains.GetDomainsDomainID)
 	api.DomainsPutDomainsDomainIDHandler = domains.PutDomainsDomainIDHandlerFunc(c.Domains.PutDomainsDomainID)
 	api.DomainsDeleteDomainsDomainIDHandler = domains.DeleteDomainsDomainIDHandlerFunc(c.Domains.DeleteDomainsDomainID)
+	api.DomainsPostDomainsDomainIDRetryHandler = domains.PostDomainsDomainIDRetryHandlerFunc(c.Domains.PostDomainsDomainIDRetry)
+	api.DomainsPostDomainsDomainIDRestoreHandler = domains.PostDomainsDomainIDRestoreHandlerFunc(c.Domains.PostDomainsDomainIDRestore)
 
 	// Pools
 	api.PoolsGetPoolsHandler = pools.GetPoolsHandlerFunc(c.Pools.GetPools)
@@ -106,6 +109,7 @@ func ExecuteServer(server *restapi.Server) error {
 	api.PoolsGetPoolsPoolIDHandler = pools.GetPoolsPoolIDHandlerFunc(c.Pools.GetPoolsPoolID)
 	api.PoolsPutPoolsPoolIDHandler = pools.PutPoolsPoolIDHandlerFunc(c.Pools.PutPoolsPoolID)
 	api.PoolsDeletePoolsPoolIDHandler = pools.DeletePoolsPoolIDHandlerFunc(c.Pools.DeletePoolsPoolID)
+	api.PoolsPostPoolsPoolIDRetryHandler = pools.PostPoolsPoolIDRetryHandlerFunc(c.Pools.PostPoolsPoolIDRetry)
 
 	// Members
 	api.MembersGetMembersHandler = members.GetMembersHandlerFunc(c.Members.GetMembers)
@@ -113,6 +117,9 @@ func ExecuteServer(server *restapi.Server) error {
 	api.MembersGetMembersMemberIDHandler = members.GetMembersMemberIDHandlerFunc(c.Members.GetMembersMemberID)
 	api.MembersPutMembersMemberIDHandler = members.PutMembersMemberIDHandlerFunc(c.Members.PutMembersMemberID)
 	api.MembersDeleteMembersMemberIDHandler = members.DeleteMembersMemberIDHandlerFunc(c.Members.DeleteMembersMemberID)
+	api.MembersGetMembersMemberIDStatusHistoryHandler = members.GetMembersMemberIDStatusHistoryHandlerFunc(
+		c.Members.GetMembersMemberIDStatusHistory)
+	api.MembersPostMembersMemberIDRetryHandler = members.PostMembersMemberIDRetryHandlerFunc(c.Members.PostMembersMemberIDRetry)
 
 	// Datacenters
 	api.DatacentersGetDatacentersHandler = datacenters.GetDatacentersHandlerFunc(c.Datacenters.GetDatacenters)
@@ -120,6 +127,8 @@ func ExecuteServer(server *restapi.Server) error {
 	api.DatacentersGetDatacentersDatacenterIDHandler = datacenters.GetDatacentersDatacenterIDHandlerFunc(c.Datacenters.GetDatacentersDatacenterID)
 	api.DatacentersPutDatacentersDatacenterIDHandler = datacenters.PutDatacentersDatacenterIDHandlerFunc(c.Datacenters.PutDatacentersDatacenterID)
 	api.DatacentersDeleteDatacentersDatacenterIDHandler = datacenters.DeleteDatacentersDatacenterIDHandlerFunc(c.Datacenters.DeleteDatacentersDatacenterID)
+	api.DatacentersPostDatacentersDatacenterIDRetryHandler = datacenters.PostDatacentersDatacenterIDRetryHandlerFunc(
+		c.Datacenters.PostDatacentersDatacenterIDRetry)
 
 	// Geographic Maps
 	api.GeographicMapsGetGeomapsHandler = geographic_maps.GetGeomapsHandlerFunc(c.GeoMaps.GetGeomaps)
@@ -127,6 +136,7 @@ func ExecuteServer(server *restapi.Server) error {
 	api.GeographicMapsGetGeomapsGeomapIDHandler = geographic_maps.GetGeomapsGeomapIDHandlerFunc(c.GeoMaps.GetGeomapsGeoMapID)
 	api.GeographicMapsPutGeomapsGeomapIDHandler = geographic_maps.PutGeomapsGeomapIDHandlerFunc(c.GeoMaps.PutGeomapsGeoMapID)
 	api.GeographicMapsDeleteGeomapsGeomapIDHandler = geographic_maps.DeleteGeomapsGeomapIDHandlerFunc(c.GeoMaps.DeleteGeomapsGeoMapID)
+	api.GeographicMapsPostGeomapsGeomapIDRetryHandler = geographic_maps.PostGeomapsGeomapIDRetryHandlerFunc(c.GeoMaps.PostGeomapsGeoMapIDRetry)
 
 	// Monitors
 	api.MonitorsGetMonitorsHandler = monitors.GetMonitorsHandlerFunc(c.Monitors.GetMonitors)
@@ -134,11 +144,21 @@ func ExecuteServer(server *restapi.Server) error {
 	api.MonitorsGetMonitorsMonitorIDHandler = monitors.GetMonitorsMonitorIDHandlerFunc(c.Monitors.GetMonitorsMonitorID)
 	api.MonitorsPutMonitorsMonitorIDHandler = monitors.PutMonitorsMonitorIDHandlerFunc(c.Monitors.PutMonitorsMonitorID)
 	api.MonitorsDeleteMonitorsMonitorIDHandler = monitors.DeleteMonitorsMonitorIDHandlerFunc(c.Monitors.DeleteMonitorsMonitorID)
+	api.MonitorsPostMonitorsMonitorIDRetryHandler = monitors.PostMonitorsMonitorIDRetryHandlerFunc(c.Monitors.PostMonitorsMonitorIDRetry)
 
 	// Administrative
 	api.AdministrativeGetServicesHandler = administrative.GetServicesHandlerFunc(c.Services.GetServices)
 	api.AdministrativePostSyncHandler = administrative.PostSyncHandlerFunc(c.Sync.PostSync)
 	api.AdministrativeGetCidrBlocksHandler = administrative.GetCidrBlocksHandlerFunc(c.CidrBlocks.GetCidrBlocks)
+	api.AdministrativeGetF5DiffHandler = administrative.GetF5DiffHandlerFunc(c.F5.GetF5Diff)
+	api.AdministrativeGetAgentsHandler = administrative.GetAgentsHandlerFunc(c.Agents.GetAgents)
+	api.AdministrativeGetResetHandler = administrative.GetResetHandlerFunc(c.Reset.GetReset)
+	api.AdministrativePostResetResourceTypeResourceIDHandler = administrative.PostResetResourceTypeResourceIDHandlerFunc(
+		c.Reset.PostResetResourceTypeResourceID)
+
+	// Configuration
+	api.ConfigurationGetExportHandler = configuration.GetExportHandlerFunc(c.Configuration.GetExport)
+	api.ConfigurationPostImportHandler = configuration.PostImportHandlerFunc(c.Configuration.PostImport)
 
 	// Quota Middleware
 	if config.Global.Quota.Enabled {
@@ -205,6 +225,9 @@ func ExecuteServer(server *restapi.Server) error {
 func RPCServer(db *sqlx.DB) *stormrpc.Server {
 	srv := rpc.NewServer("andromeda-server")
 	svc := &server.RPCHandler{DB: db}
+	if config.Global.Default.Prometheus {
+		server.RegisterMetrics(prometheus.DefaultRegisterer)
+	}
 	server.RegisterRPCServerServer(srv, svc)
 	return srv
 }
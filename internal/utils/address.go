@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"net/netip"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// AddressRecordType returns the record type handing out an IP address, A for IPv4 and AAAA for IPv6
+// addresses, or an empty string if the address is not an IP address (e.g. a hostname).
+func AddressRecordType(address string) string {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return ""
+	}
+	if addr.Unmap().Is4() {
+		return models.DomainRecordTypeA
+	}
+	return models.DomainRecordTypeAAAA
+}
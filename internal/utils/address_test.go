@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressRecordType(t *testing.T) {
+	assert.Equal(t, "A", AddressRecordType("192.0.2.1"))
+	assert.Equal(t, "A", AddressRecordType("::ffff:192.0.2.1"))
+	assert.Equal(t, "AAAA", AddressRecordType("2001:db8::1"))
+	assert.Equal(t, "", AddressRecordType("www.example.com"))
+}
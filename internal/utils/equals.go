@@ -138,3 +138,22 @@ func DeepEqualFields(x, y interface{}, fieldsToCompare []string) bool {
 	log.Debugf("DeepEqualFields(%s): equal=%t", v1.Type(), ret)
 	return ret
 }
+
+// DifferingFields returns the top-level fields of fieldsToCompare, which are not equal in x and y,
+// nested fields (e.g. "TrafficTargets.Weight") are compared as part of their top-level field.
+func DifferingFields(x, y interface{}, fieldsToCompare []string) []string {
+	var differing []string
+	for _, field := range fieldsToCompare {
+		if strings.Contains(field, ".") {
+			continue
+		}
+		subFields := []string{field}
+		for _, subField := range getSubFields(field, fieldsToCompare) {
+			subFields = append(subFields, field+"."+subField)
+		}
+		if !DeepEqualFields(x, y, subFields) {
+			differing = append(differing, field)
+		}
+	}
+	return differing
+}
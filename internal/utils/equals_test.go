@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testTarget struct {
+	Name   string
+	Weight float64
+}
+
+type testProperty struct {
+	Name    string
+	Type    string
+	Targets []testTarget
+}
+
+func TestDifferingFields(t *testing.T) {
+	fields := []string{"Name", "Type", "Targets", "Targets.Weight"}
+	a := &testProperty{Name: "a", Type: "geographic", Targets: []testTarget{{Name: "dc1", Weight: 50}}}
+
+	t.Run("Returns no fields if equal", func(t *testing.T) {
+		b := &testProperty{Name: "a", Type: "geographic", Targets: []testTarget{{Name: "other", Weight: 50}}}
+		assert.Empty(t, DifferingFields(a, b, fields))
+	})
+
+	t.Run("Returns top-level fields of differing nested fields", func(t *testing.T) {
+		b := &testProperty{Name: "a", Type: "weighted", Targets: []testTarget{{Name: "dc1", Weight: 10}}}
+		assert.Equal(t, []string{"Type", "Targets"}, DifferingFields(a, b, fields))
+	})
+}
@@ -29,8 +29,15 @@ var (
 	MissingProvider              = &models.Error{Code: 400, Message: "invalid value for 'provider': 'provider' is required"}
 	MissingAddressOrPort         = &models.Error{Code: 400, Message: "invalid value for 'address' and 'port': 'address' and 'port' are required"}
 	InvalidMemberAddressForF5    = &models.Error{Code: 400, Message: "invalid value for 'address': must be a valid IPv4 or IPv6 address for pools associated with F5 domains"}
+	InvalidMemberAddressFamily   = &models.Error{Code: 400, Message: "invalid value for 'address': IPv4-mapped IPv6 addresses and addresses with a zone are not supported"}
+	MemberAddressFamilyMismatch  = &models.Error{Code: 400, Message: "invalid value for 'address': address family is not handed out by any domain of the pool, check the record_type of the domains"}
+	InvalidMemberAddressForName  = &models.Error{Code: 400, Message: "invalid value for 'address': must be a hostname for pools associated with CNAME or MX domains"}
+	MXUnsupportedForAkamai       = &models.Error{Code: 400, Message: "invalid value for 'record_type': MX domains are not supported by the akamai provider"}
 	FQDNImmutable                = &models.Error{Code: 400, Message: "invalid value for 'fqdn': change of immutable attribute 'fqdn' not allowed"}
 	RestrictedDatacenterProvider = &models.Error{Code: 400, Message: "invalid value for 'provider': project-specific f5 datacenters are not supported; please use those with scope=public already available"}
+	NotInErrorState              = &models.Error{Code: 409, Message: "provisioning can only be retried for resources in provisioning status ERROR"}
+	NotRestorable                = &models.Error{Code: 409, Message: "only deleted domains can be restored within the restore window"}
+	DomainDeleted                = &models.Error{Code: 409, Message: "domain is deleted, restore it before updating"}
 	MySQLForeignKeyViolation     = &mysql.MySQLError{Number: 1451}
 )
 
@@ -53,6 +60,21 @@ func GetErrorPoolNotFound(poolID *strfmt.UUID) *models.Error {
 		"invalid value for 'pool_id': Pool '%s' not found", poolID)}
 }
 
+func GetErrorNoLiveAgent(provider string) *models.Error {
+	return &models.Error{Code: 400, Message: fmt.Sprintf(
+		"invalid value for 'provider': no live agent for provider '%s'", provider)}
+}
+
+func GetErrorInvalidAlias(alias string) *models.Error {
+	return &models.Error{Code: 400, Message: fmt.Sprintf(
+		"invalid value for 'aliases': '%s' is not a valid hostname", alias)}
+}
+
+func GetErrorOutOfBounds(field string, provider string, minimum, maximum int64) *models.Error {
+	return &models.Error{Code: 400, Message: fmt.Sprintf(
+		"invalid value for '%s': must be between %d and %d for provider '%s'", field, minimum, maximum, provider)}
+}
+
 func GetErrorPoolHasAlreadyAMonitor(poolID *strfmt.UUID) *models.Error {
 	return &models.Error{Code: 400, Message: fmt.Sprintf(
 		"invalid value for 'pool_id': Pool '%s' already has a monitor", poolID)}
@@ -29,6 +29,10 @@ var (
 	// resource types that do not map directly to a `quota` table column
 	// due to their quota being bound to a provider
 	providerBoundResourceQuotas = []string{"domain"}
+
+	// providers without a `quota` table column, resources of these
+	// providers are not subject to quota enforcement
+	quotaExemptProviders = []string{"noop"}
 )
 
 type (
@@ -103,6 +107,10 @@ func (qc *quotaController) QuotaHandler(next http.Handler) http.Handler {
 				return
 			}
 			log.Debugf("Provider read from request body: %s", provider)
+			if slices.Contains(quotaExemptProviders, provider) {
+				next.ServeHTTP(w, r)
+				return
+			}
 		}
 
 		// Get project scope
@@ -124,11 +132,12 @@ func (qc *quotaController) QuotaHandler(next http.Handler) http.Handler {
 		var quotaAvailable, quotaUsed int
 
 		insert := sq.Insert("quota").
-			Columns("project_id", "domain_akamai", "domain_f5", "pool", "member", "monitor", "datacenter").
+			Columns("project_id", "domain_akamai", "domain_f5", "domain_dns", "pool", "member", "monitor", "datacenter").
 			Values(
 				project,
 				config.Global.Quota.DefaultQuotaDomainAkamai,
 				config.Global.Quota.DefaultQuotaDomainF5,
+				config.Global.Quota.DefaultQuotaDomainDNS,
 				config.Global.Quota.DefaultQuotaPool,
 				config.Global.Quota.DefaultQuotaMember,
 				config.Global.Quota.DefaultQuotaMonitor,
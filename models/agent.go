@@ -0,0 +1,176 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Agent agent
+//
+// swagger:model agent
+type Agent struct {
+
+	// The administrative state of the agent, disabled agents skip their work until enabled again.
+	// Example: true
+	AdminStateUp bool `json:"admin_state_up,omitempty" db:"admin_state_up,omitempty"`
+
+	// Capabilities of the agent, domains can only be provisioned by agents capable to sync.
+	// Example: ["sync","member_status"]
+	Capabilities []string `json:"capabilities" db:"capabilities"`
+
+	// The UTC date and timestamp of the last heartbeat.
+	// Example: 2020-05-11 17:21:34
+	// Format: date-time
+	Heartbeat strfmt.DateTime `json:"heartbeat,omitempty" db:"heartbeat,omitempty"`
+
+	// Hostname of the computer the agent is running.
+	// Example: example.host
+	Host string `json:"host,omitempty" db:"host,omitempty"`
+
+	// The UTC date and timestamp of the last successful sync.
+	// Example: 2020-05-11 17:21:34
+	// Format: date-time
+	LastSync *strfmt.DateTime `json:"last_sync,omitempty" db:"last_sync,omitempty"`
+
+	// Providers this agent supports.
+	// Example: ["akamai"]
+	Providers []string `json:"providers" db:"providers"`
+
+	// ALIVE if the agent is enabled and sent a heartbeat within the agent TTL.
+	// Example: ALIVE
+	// Enum: [ALIVE STALE]
+	Status string `json:"status,omitempty" db:"status,omitempty"`
+
+	// Type of agent.
+	// Example: andromeda-akamai-agent
+	Type string `json:"type,omitempty" db:"type,omitempty"`
+
+	// Version of the agent.
+	// Example: 1.2.3
+	Version string `json:"version,omitempty" db:"version,omitempty"`
+}
+
+// Validate validates this agent
+func (m *Agent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHeartbeat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastSync(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Agent) validateHeartbeat(formats strfmt.Registry) error {
+	if swag.IsZero(m.Heartbeat) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("heartbeat", "body", "date-time", m.Heartbeat.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Agent) validateLastSync(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastSync) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_sync", "body", "date-time", m.LastSync.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var agentTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["ALIVE","STALE"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		agentTypeStatusPropEnum = append(agentTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AgentStatusALIVE captures enum value "ALIVE"
+	AgentStatusALIVE string = "ALIVE"
+
+	// AgentStatusSTALE captures enum value "STALE"
+	AgentStatusSTALE string = "STALE"
+)
+
+// prop value enum
+func (m *Agent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, agentTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Agent) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this agent based on context it is used
+func (m *Agent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Agent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Agent) UnmarshalBinary(b []byte) error {
+	var res Agent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,450 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Configuration Versioned configuration of a project, resources reference each other by their ids within the document.
+//
+// swagger:model configuration
+type Configuration struct {
+
+	// datacenters
+	Datacenters []*Datacenter `json:"datacenters" db:"datacenters"`
+
+	// domains
+	Domains []*Domain `json:"domains" db:"domains"`
+
+	// geomaps
+	Geomaps []*Geomap `json:"geomaps" db:"geomaps"`
+
+	// members
+	Members []*Member `json:"members" db:"members"`
+
+	// monitors
+	Monitors []*Monitor `json:"monitors" db:"monitors"`
+
+	// pools
+	Pools []*Pool `json:"pools" db:"pools"`
+
+	// Version of the document format.
+	// Required: true
+	// Enum: [1]
+	Version *int64 `json:"version" db:"version"`
+}
+
+// Validate validates this configuration
+func (m *Configuration) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDatacenters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDomains(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGeomaps(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMembers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMonitors(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePools(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Configuration) validateDatacenters(formats strfmt.Registry) error {
+	if swag.IsZero(m.Datacenters) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Datacenters); i++ {
+		if swag.IsZero(m.Datacenters[i]) { // not required
+			continue
+		}
+
+		if m.Datacenters[i] != nil {
+			if err := m.Datacenters[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("datacenters" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("datacenters" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Configuration) validateDomains(formats strfmt.Registry) error {
+	if swag.IsZero(m.Domains) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Domains); i++ {
+		if swag.IsZero(m.Domains[i]) { // not required
+			continue
+		}
+
+		if m.Domains[i] != nil {
+			if err := m.Domains[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("domains" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("domains" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Configuration) validateGeomaps(formats strfmt.Registry) error {
+	if swag.IsZero(m.Geomaps) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Geomaps); i++ {
+		if swag.IsZero(m.Geomaps[i]) { // not required
+			continue
+		}
+
+		if m.Geomaps[i] != nil {
+			if err := m.Geomaps[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("geomaps" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("geomaps" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Configuration) validateMembers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Members) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Members); i++ {
+		if swag.IsZero(m.Members[i]) { // not required
+			continue
+		}
+
+		if m.Members[i] != nil {
+			if err := m.Members[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("members" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("members" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Configuration) validateMonitors(formats strfmt.Registry) error {
+	if swag.IsZero(m.Monitors) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Monitors); i++ {
+		if swag.IsZero(m.Monitors[i]) { // not required
+			continue
+		}
+
+		if m.Monitors[i] != nil {
+			if err := m.Monitors[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("monitors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("monitors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Configuration) validatePools(formats strfmt.Registry) error {
+	if swag.IsZero(m.Pools) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Pools); i++ {
+		if swag.IsZero(m.Pools[i]) { // not required
+			continue
+		}
+
+		if m.Pools[i] != nil {
+			if err := m.Pools[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pools" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("pools" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var configurationTypeVersionPropEnum []interface{}
+
+func init() {
+	var res []int64
+	if err := json.Unmarshal([]byte(`[1]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		configurationTypeVersionPropEnum = append(configurationTypeVersionPropEnum, v)
+	}
+}
+
+// prop value enum
+func (m *Configuration) validateVersionEnum(path, location string, value int64) error {
+	if err := validate.EnumCase(path, location, value, configurationTypeVersionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Configuration) validateVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("version", "body", m.Version); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateVersionEnum("version", "body", *m.Version); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this configuration based on the context it is used
+func (m *Configuration) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDatacenters(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDomains(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateGeomaps(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMembers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMonitors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePools(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Configuration) contextValidateDatacenters(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Datacenters); i++ {
+
+		if m.Datacenters[i] != nil {
+			if err := m.Datacenters[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("datacenters" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("datacenters" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Configuration) contextValidateDomains(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Domains); i++ {
+
+		if m.Domains[i] != nil {
+			if err := m.Domains[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("domains" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("domains" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Configuration) contextValidateGeomaps(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Geomaps); i++ {
+
+		if m.Geomaps[i] != nil {
+			if err := m.Geomaps[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("geomaps" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("geomaps" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Configuration) contextValidateMembers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Members); i++ {
+
+		if m.Members[i] != nil {
+			if err := m.Members[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("members" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("members" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Configuration) contextValidateMonitors(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Monitors); i++ {
+
+		if m.Monitors[i] != nil {
+			if err := m.Monitors[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("monitors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("monitors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Configuration) contextValidatePools(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Pools); i++ {
+
+		if m.Pools[i] != nil {
+			if err := m.Pools[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pools" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("pools" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Configuration) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Configuration) UnmarshalBinary(b []byte) error {
+	var res Configuration
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
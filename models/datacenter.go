@@ -53,6 +53,11 @@ type Datacenter struct {
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty" db:"id,omitempty"`
 
+	// The UTC date and timestamp of the last provisioning failure.
+	// Read Only: true
+	// Format: date-time
+	LastErrorAt *strfmt.DateTime `json:"last_error_at,omitempty" db:"last_error_at,omitempty"`
+
 	// latitude
 	// Example: 52.526055
 	Latitude *float64 `json:"latitude" db:"latitude"`
@@ -77,9 +82,13 @@ type Datacenter struct {
 
 	// Provider driver for the backend solution
 	// Example: akamai
-	// Enum: [akamai f5]
+	// Enum: [akamai f5 dns noop]
 	Provider string `json:"provider,omitempty" db:"provider,omitempty"`
 
+	// The reason of the last provisioning failure reported by the agent, cleared once provisioned.
+	// Read Only: true
+	ProvisioningError *string `json:"provisioning_error,omitempty" db:"provisioning_error,omitempty"`
+
 	// provisioning status
 	// Read Only: true
 	// Enum: [PENDING_CREATE PENDING_UPDATE PENDING_DELETE ACTIVE ERROR]
@@ -125,6 +134,10 @@ func (m *Datacenter) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLastErrorAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -219,6 +232,18 @@ func (m *Datacenter) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Datacenter) validateLastErrorAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastErrorAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_error_at", "body", "date-time", m.LastErrorAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Datacenter) validateName(formats strfmt.Registry) error {
 	if swag.IsZero(m.Name) { // not required
 		return nil
@@ -251,7 +276,7 @@ var datacenterTypeProviderPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["akamai","f5"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["akamai","f5","dns","noop"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -266,6 +291,12 @@ const (
 
 	// DatacenterProviderF5 captures enum value "f5"
 	DatacenterProviderF5 string = "f5"
+
+	// DatacenterProviderDNS captures enum value "dns"
+	DatacenterProviderDNS string = "dns"
+
+	// DatacenterProviderNoop captures enum value "noop"
+	DatacenterProviderNoop string = "noop"
 )
 
 // prop value enum
@@ -418,10 +449,18 @@ func (m *Datacenter) ContextValidate(ctx context.Context, formats strfmt.Registr
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateLastErrorAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateMeta(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateProvisioningError(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProvisioningStatus(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -454,6 +493,15 @@ func (m *Datacenter) contextValidateID(ctx context.Context, formats strfmt.Regis
 	return nil
 }
 
+func (m *Datacenter) contextValidateLastErrorAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "last_error_at", "body", m.LastErrorAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Datacenter) contextValidateMeta(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "meta", "body", int64(m.Meta)); err != nil {
@@ -463,6 +511,15 @@ func (m *Datacenter) contextValidateMeta(ctx context.Context, formats strfmt.Reg
 	return nil
 }
 
+func (m *Datacenter) contextValidateProvisioningError(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "provisioning_error", "body", m.ProvisioningError); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Datacenter) contextValidateProvisioningStatus(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "provisioning_status", "body", string(m.ProvisioningStatus)); err != nil {
@@ -0,0 +1,482 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DeclarationDiff declaration diff
+//
+// swagger:model declaration_diff
+type DeclarationDiff struct {
+
+	// Tenants to be created, updated or deleted, unchanged tenants are omitted.
+	Tenants []*DeclarationDiffTenantsItems0 `json:"tenants" db:"tenants"`
+}
+
+// Validate validates this declaration diff
+func (m *DeclarationDiff) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTenants(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DeclarationDiff) validateTenants(formats strfmt.Registry) error {
+	if swag.IsZero(m.Tenants) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Tenants); i++ {
+		if swag.IsZero(m.Tenants[i]) { // not required
+			continue
+		}
+
+		if m.Tenants[i] != nil {
+			if err := m.Tenants[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("tenants" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("tenants" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this declaration diff based on the context it is used
+func (m *DeclarationDiff) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTenants(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DeclarationDiff) contextValidateTenants(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Tenants); i++ {
+
+		if m.Tenants[i] != nil {
+			if err := m.Tenants[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("tenants" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("tenants" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DeclarationDiff) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DeclarationDiff) UnmarshalBinary(b []byte) error {
+	var res DeclarationDiff
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// DeclarationDiffTenantsItems0 declaration diff tenants items0
+//
+// swagger:model DeclarationDiffTenantsItems0
+type DeclarationDiffTenantsItems0 struct {
+
+	// action
+	// Enum: [create update delete]
+	Action string `json:"action,omitempty" db:"action,omitempty"`
+
+	// applications
+	Applications []*DeclarationDiffTenantsItems0ApplicationsItems0 `json:"applications" db:"applications"`
+
+	// tenant
+	// Example: domain_a9b3c3e4-8e6b-4c2b-9f5e-2f1b3c4d5e6f
+	Tenant string `json:"tenant,omitempty" db:"tenant,omitempty"`
+}
+
+// Validate validates this declaration diff tenants items0
+func (m *DeclarationDiffTenantsItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateApplications(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var declarationDiffTenantsItems0TypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["create","update","delete"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		declarationDiffTenantsItems0TypeActionPropEnum = append(declarationDiffTenantsItems0TypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// DeclarationDiffTenantsItems0ActionCreate captures enum value "create"
+	DeclarationDiffTenantsItems0ActionCreate string = "create"
+
+	// DeclarationDiffTenantsItems0ActionUpdate captures enum value "update"
+	DeclarationDiffTenantsItems0ActionUpdate string = "update"
+
+	// DeclarationDiffTenantsItems0ActionDelete captures enum value "delete"
+	DeclarationDiffTenantsItems0ActionDelete string = "delete"
+)
+
+// prop value enum
+func (m *DeclarationDiffTenantsItems0) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, declarationDiffTenantsItems0TypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DeclarationDiffTenantsItems0) validateAction(formats strfmt.Registry) error {
+	if swag.IsZero(m.Action) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DeclarationDiffTenantsItems0) validateApplications(formats strfmt.Registry) error {
+	if swag.IsZero(m.Applications) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Applications); i++ {
+		if swag.IsZero(m.Applications[i]) { // not required
+			continue
+		}
+
+		if m.Applications[i] != nil {
+			if err := m.Applications[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("applications" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("applications" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this declaration diff tenants items0 based on the context it is used
+func (m *DeclarationDiffTenantsItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateApplications(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DeclarationDiffTenantsItems0) contextValidateApplications(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Applications); i++ {
+
+		if m.Applications[i] != nil {
+			if err := m.Applications[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("applications" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("applications" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DeclarationDiffTenantsItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DeclarationDiffTenantsItems0) UnmarshalBinary(b []byte) error {
+	var res DeclarationDiffTenantsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// DeclarationDiffTenantsItems0ApplicationsItems0 declaration diff tenants items0 applications items0
+//
+// swagger:model DeclarationDiffTenantsItems0ApplicationsItems0
+type DeclarationDiffTenantsItems0ApplicationsItems0 struct {
+
+	// action
+	// Enum: [create update delete]
+	Action string `json:"action,omitempty" db:"action,omitempty"`
+
+	// application
+	// Example: application
+	Application string `json:"application,omitempty" db:"application,omitempty"`
+
+	// Changed properties of updated applications.
+	Changes []*DeclarationDiffTenantsItems0ApplicationsItems0ChangesItems0 `json:"changes" db:"changes"`
+}
+
+// Validate validates this declaration diff tenants items0 applications items0
+func (m *DeclarationDiffTenantsItems0ApplicationsItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateChanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var declarationDiffTenantsItems0ApplicationsItems0TypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["create","update","delete"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		declarationDiffTenantsItems0ApplicationsItems0TypeActionPropEnum = append(declarationDiffTenantsItems0ApplicationsItems0TypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// DeclarationDiffTenantsItems0ApplicationsItems0ActionCreate captures enum value "create"
+	DeclarationDiffTenantsItems0ApplicationsItems0ActionCreate string = "create"
+
+	// DeclarationDiffTenantsItems0ApplicationsItems0ActionUpdate captures enum value "update"
+	DeclarationDiffTenantsItems0ApplicationsItems0ActionUpdate string = "update"
+
+	// DeclarationDiffTenantsItems0ApplicationsItems0ActionDelete captures enum value "delete"
+	DeclarationDiffTenantsItems0ApplicationsItems0ActionDelete string = "delete"
+)
+
+// prop value enum
+func (m *DeclarationDiffTenantsItems0ApplicationsItems0) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, declarationDiffTenantsItems0ApplicationsItems0TypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DeclarationDiffTenantsItems0ApplicationsItems0) validateAction(formats strfmt.Registry) error {
+	if swag.IsZero(m.Action) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DeclarationDiffTenantsItems0ApplicationsItems0) validateChanges(formats strfmt.Registry) error {
+	if swag.IsZero(m.Changes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Changes); i++ {
+		if swag.IsZero(m.Changes[i]) { // not required
+			continue
+		}
+
+		if m.Changes[i] != nil {
+			if err := m.Changes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("changes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("changes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this declaration diff tenants items0 applications items0 based on the context it is used
+func (m *DeclarationDiffTenantsItems0ApplicationsItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateChanges(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DeclarationDiffTenantsItems0ApplicationsItems0) contextValidateChanges(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Changes); i++ {
+
+		if m.Changes[i] != nil {
+			if err := m.Changes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("changes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("changes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DeclarationDiffTenantsItems0ApplicationsItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DeclarationDiffTenantsItems0ApplicationsItems0) UnmarshalBinary(b []byte) error {
+	var res DeclarationDiffTenantsItems0ApplicationsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// DeclarationDiffTenantsItems0ApplicationsItems0ChangesItems0 declaration diff tenants items0 applications items0 changes items0
+//
+// swagger:model DeclarationDiffTenantsItems0ApplicationsItems0ChangesItems0
+type DeclarationDiffTenantsItems0ApplicationsItems0ChangesItems0 struct {
+
+	// Deployed value, absent if the property is added.
+	Deployed interface{} `json:"deployed,omitempty" db:"deployed,omitempty"`
+
+	// Desired value, absent if the property is removed.
+	Desired interface{} `json:"desired,omitempty" db:"desired,omitempty"`
+
+	// JSON pointer of the changed property.
+	// Example: /domain_a9b3c3e4-8e6b-4c2b-9f5e-2f1b3c4d5e6f/application/domain/poolLbMode
+	Path string `json:"path,omitempty" db:"path,omitempty"`
+}
+
+// Validate validates this declaration diff tenants items0 applications items0 changes items0
+func (m *DeclarationDiffTenantsItems0ApplicationsItems0ChangesItems0) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this declaration diff tenants items0 applications items0 changes items0 based on context it is used
+func (m *DeclarationDiffTenantsItems0ApplicationsItems0ChangesItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DeclarationDiffTenantsItems0ApplicationsItems0ChangesItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DeclarationDiffTenantsItems0ApplicationsItems0ChangesItems0) UnmarshalBinary(b []byte) error {
+	var res DeclarationDiffTenantsItems0ApplicationsItems0ChangesItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -28,7 +28,7 @@ type Domain struct {
 	// The administrative state of the resource, which is up (true) or down (false). Default is true.
 	AdminStateUp *bool `json:"admin_state_up,omitempty" db:"admin_state_up,omitempty"`
 
-	// aliases
+	// Additional hostnames resolving like the FQDN. F5 aliases may contain the wildcards * and ?, Akamai serves each alias by an additional property.
 	Aliases []string `json:"aliases" db:"aliases"`
 
 	// If not empty, the backend created a CNAME target to be used for the FQDN.
@@ -43,17 +43,45 @@ type Domain struct {
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty" db:"created_at,omitempty"`
 
+	// The UTC date and timestamp when the domain was deleted, it can be restored until the restore window elapsed.
+	// Read Only: true
+	// Format: date-time
+	DeletedAt *strfmt.DateTime `json:"deleted_at,omitempty" db:"deleted_at,omitempty"`
+
+	// Seconds a datacenter has to be up again before traffic fails back to it. Only supported by Akamai.
+	// Example: 0
+	// Maximum: 3600
+	// Minimum: 0
+	FailbackDelay *int64 `json:"failback_delay,omitempty" db:"failback_delay,omitempty"`
+
+	// Seconds a datacenter has to be down before traffic fails over to another datacenter. Only supported by Akamai.
+	// Example: 0
+	// Maximum: 3600
+	// Minimum: 0
+	FailoverDelay *int64 `json:"failover_delay,omitempty" db:"failover_delay,omitempty"`
+
 	// Desired Fully-Qualified Host Name.
 	// Example: example.org
 	// Max Length: 512
 	// Format: hostname
 	Fqdn *strfmt.Hostname `json:"fqdn,omitempty" db:"fqdn,omitempty"`
 
+	// Maximum number of addresses handed out per answer, 0 hands out all live addresses. Akamai supports up to 8 addresses.
+	// Example: 0
+	// Maximum: 500
+	// Minimum: 0
+	HandoutLimit *int64 `json:"handout_limit,omitempty" db:"handout_limit,omitempty"`
+
 	// The id of the resource.
 	// Read Only: true
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty" db:"id,omitempty"`
 
+	// The UTC date and timestamp of the last provisioning failure.
+	// Read Only: true
+	// Format: date-time
+	LastErrorAt *strfmt.DateTime `json:"last_error_at,omitempty" db:"last_error_at,omitempty"`
+
 	// Load balancing method to use for the references pools.
 	// Enum: [WEIGHTED ROUND_ROBIN GEOGRAPHIC AVAILABILITY]
 	Mode *string `json:"mode,omitempty" db:"mode,omitempty"`
@@ -61,7 +89,7 @@ type Domain struct {
 	// Human-readable name of the resource.
 	Name *string `json:"name,omitempty" db:"name,omitempty"`
 
-	// pools
+	// Pools that this domain uses for load balancing, in order of priority. AVAILABILITY domains hand out the first pool with available members and fall back to the following pools in order, on Akamai only with availability_failover enabled. Pools assigned via the domains of a pool are appended with the lowest priority.
 	Pools []strfmt.UUID `json:"pools" db:"pools"`
 
 	// The ID of the project owning this resource.
@@ -72,23 +100,33 @@ type Domain struct {
 
 	// Supported provider drivers
 	// Example: akamai
-	// Enum: [akamai f5]
+	// Enum: [akamai f5 dns noop]
 	Provider *string `json:"provider,omitempty" db:"provider,omitempty"`
 
+	// The reason of the last provisioning failure reported by the agent, cleared once provisioned.
+	// Read Only: true
+	ProvisioningError *string `json:"provisioning_error,omitempty" db:"provisioning_error,omitempty"`
+
 	// provisioning status
 	// Read Only: true
-	// Enum: [PENDING_CREATE PENDING_UPDATE PENDING_DELETE ACTIVE ERROR]
+	// Enum: [PENDING_CREATE PENDING_UPDATE PENDING_DELETE ACTIVE ERROR DELETED]
 	ProvisioningStatus string `json:"provisioning_status,omitempty" db:"provisioning_status,omitempty"`
 
-	// DNS Record type to use.
+	// DNS Record type to use. F5 domains of type A or AAAA are dual-stack and hand out IPv4 members as A and IPv6 members as AAAA records, Akamai domains only hand out members of the address family of their record type. CNAME and MX domains hand out the hostnames of their members, MX is not supported by Akamai.
 	// Enum: [A AAAA CNAME MX]
 	RecordType *string `json:"record_type,omitempty" db:"record_type,omitempty"`
 
-	// status
+	// Operating status aggregated from the status of all enabled pools of the domain.
 	// Read Only: true
-	// Enum: [ONLINE DOWN]
+	// Enum: [ONLINE DEGRADED OFFLINE NO_MONITOR UNKNOWN]
 	Status string `json:"status,omitempty" db:"status,omitempty"`
 
+	// Time to live, in seconds, of the handed out records. Akamai supports 30 to 3600 seconds, F5 at least 1 second.
+	// Example: 30
+	// Maximum: 86400
+	// Minimum: 0
+	TTL *int64 `json:"ttl,omitempty" db:"ttl,omitempty"`
+
 	// The UTC date and timestamp when the resource was created.
 	// Example: 2020-09-09T14:52:15
 	// Read Only: true
@@ -108,14 +146,34 @@ func (m *Domain) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDeletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFailbackDelay(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFailoverDelay(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateFqdn(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateHandoutLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateLastErrorAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMode(formats); err != nil {
 		res = append(res, err)
 	}
@@ -144,6 +202,10 @@ func (m *Domain) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTTL(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUpdatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -178,6 +240,50 @@ func (m *Domain) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Domain) validateDeletedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeletedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("deleted_at", "body", "date-time", m.DeletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Domain) validateFailbackDelay(formats strfmt.Registry) error {
+	if swag.IsZero(m.FailbackDelay) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("failback_delay", "body", *m.FailbackDelay, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("failback_delay", "body", *m.FailbackDelay, 3600, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Domain) validateFailoverDelay(formats strfmt.Registry) error {
+	if swag.IsZero(m.FailoverDelay) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("failover_delay", "body", *m.FailoverDelay, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("failover_delay", "body", *m.FailoverDelay, 3600, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Domain) validateFqdn(formats strfmt.Registry) error {
 	if swag.IsZero(m.Fqdn) { // not required
 		return nil
@@ -194,6 +300,22 @@ func (m *Domain) validateFqdn(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Domain) validateHandoutLimit(formats strfmt.Registry) error {
+	if swag.IsZero(m.HandoutLimit) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("handout_limit", "body", *m.HandoutLimit, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("handout_limit", "body", *m.HandoutLimit, 500, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Domain) validateID(formats strfmt.Registry) error {
 	if swag.IsZero(m.ID) { // not required
 		return nil
@@ -206,6 +328,18 @@ func (m *Domain) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Domain) validateLastErrorAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastErrorAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_error_at", "body", "date-time", m.LastErrorAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var domainTypeModePropEnum []interface{}
 
 func init() {
@@ -290,7 +424,7 @@ var domainTypeProviderPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["akamai","f5"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["akamai","f5","dns","noop"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -305,6 +439,12 @@ const (
 
 	// DomainProviderF5 captures enum value "f5"
 	DomainProviderF5 string = "f5"
+
+	// DomainProviderDNS captures enum value "dns"
+	DomainProviderDNS string = "dns"
+
+	// DomainProviderNoop captures enum value "noop"
+	DomainProviderNoop string = "noop"
 )
 
 // prop value enum
@@ -332,7 +472,7 @@ var domainTypeProvisioningStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["PENDING_CREATE","PENDING_UPDATE","PENDING_DELETE","ACTIVE","ERROR"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["PENDING_CREATE","PENDING_UPDATE","PENDING_DELETE","ACTIVE","ERROR","DELETED"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -356,6 +496,9 @@ const (
 
 	// DomainProvisioningStatusERROR captures enum value "ERROR"
 	DomainProvisioningStatusERROR string = "ERROR"
+
+	// DomainProvisioningStatusDELETED captures enum value "DELETED"
+	DomainProvisioningStatusDELETED string = "DELETED"
 )
 
 // prop value enum
@@ -431,7 +574,7 @@ var domainTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["ONLINE","DOWN"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["ONLINE","DEGRADED","OFFLINE","NO_MONITOR","UNKNOWN"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -444,8 +587,17 @@ const (
 	// DomainStatusONLINE captures enum value "ONLINE"
 	DomainStatusONLINE string = "ONLINE"
 
-	// DomainStatusDOWN captures enum value "DOWN"
-	DomainStatusDOWN string = "DOWN"
+	// DomainStatusDEGRADED captures enum value "DEGRADED"
+	DomainStatusDEGRADED string = "DEGRADED"
+
+	// DomainStatusOFFLINE captures enum value "OFFLINE"
+	DomainStatusOFFLINE string = "OFFLINE"
+
+	// DomainStatusNOMONITOR captures enum value "NO_MONITOR"
+	DomainStatusNOMONITOR string = "NO_MONITOR"
+
+	// DomainStatusUNKNOWN captures enum value "UNKNOWN"
+	DomainStatusUNKNOWN string = "UNKNOWN"
 )
 
 // prop value enum
@@ -469,6 +621,22 @@ func (m *Domain) validateStatus(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Domain) validateTTL(formats strfmt.Registry) error {
+	if swag.IsZero(m.TTL) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("ttl", "body", *m.TTL, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("ttl", "body", *m.TTL, 86400, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Domain) validateUpdatedAt(formats strfmt.Registry) error {
 	if swag.IsZero(m.UpdatedAt) { // not required
 		return nil
@@ -493,10 +661,22 @@ func (m *Domain) ContextValidate(ctx context.Context, formats strfmt.Registry) e
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateDeletedAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateID(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateLastErrorAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateProvisioningError(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProvisioningStatus(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -533,6 +713,15 @@ func (m *Domain) contextValidateCreatedAt(ctx context.Context, formats strfmt.Re
 	return nil
 }
 
+func (m *Domain) contextValidateDeletedAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "deleted_at", "body", m.DeletedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Domain) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "id", "body", strfmt.UUID(m.ID)); err != nil {
@@ -542,6 +731,24 @@ func (m *Domain) contextValidateID(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *Domain) contextValidateLastErrorAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "last_error_at", "body", m.LastErrorAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Domain) contextValidateProvisioningError(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "provisioning_error", "body", m.ProvisioningError); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Domain) contextValidateProvisioningStatus(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "provisioning_status", "body", string(m.ProvisioningStatus)); err != nil {
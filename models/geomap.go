@@ -44,6 +44,11 @@ type Geomap struct {
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty" db:"id,omitempty"`
 
+	// The UTC date and timestamp of the last provisioning failure.
+	// Read Only: true
+	// Format: date-time
+	LastErrorAt *strfmt.DateTime `json:"last_error_at,omitempty" db:"last_error_at,omitempty"`
+
 	// Human-readable name of the resource.
 	// Max Length: 255
 	Name *string `json:"name,omitempty" db:"name,omitempty"`
@@ -56,9 +61,13 @@ type Geomap struct {
 
 	// Provider driver for the backend solution
 	// Example: akamai
-	// Enum: [akamai f5]
+	// Enum: [akamai f5 dns noop]
 	Provider string `json:"provider,omitempty" db:"provider,omitempty"`
 
+	// The reason of the last provisioning failure reported by the agent, cleared once provisioned.
+	// Read Only: true
+	ProvisioningError *string `json:"provisioning_error,omitempty" db:"provisioning_error,omitempty"`
+
 	// provisioning status
 	// Read Only: true
 	// Enum: [PENDING_CREATE PENDING_UPDATE PENDING_DELETE ACTIVE ERROR]
@@ -95,6 +104,10 @@ func (m *Geomap) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLastErrorAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -188,6 +201,18 @@ func (m *Geomap) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Geomap) validateLastErrorAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastErrorAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_error_at", "body", "date-time", m.LastErrorAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Geomap) validateName(formats strfmt.Registry) error {
 	if swag.IsZero(m.Name) { // not required
 		return nil
@@ -220,7 +245,7 @@ var geomapTypeProviderPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["akamai","f5"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["akamai","f5","dns","noop"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -235,6 +260,12 @@ const (
 
 	// GeomapProviderF5 captures enum value "f5"
 	GeomapProviderF5 string = "f5"
+
+	// GeomapProviderDNS captures enum value "dns"
+	GeomapProviderDNS string = "dns"
+
+	// GeomapProviderNoop captures enum value "noop"
+	GeomapProviderNoop string = "noop"
 )
 
 // prop value enum
@@ -379,6 +410,14 @@ func (m *Geomap) ContextValidate(ctx context.Context, formats strfmt.Registry) e
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateLastErrorAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateProvisioningError(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProvisioningStatus(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -431,6 +470,24 @@ func (m *Geomap) contextValidateID(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *Geomap) contextValidateLastErrorAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "last_error_at", "body", m.LastErrorAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Geomap) contextValidateProvisioningError(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "provisioning_error", "body", m.ProvisioningError); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Geomap) contextValidateProvisioningStatus(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "provisioning_status", "body", string(m.ProvisioningStatus)); err != nil {
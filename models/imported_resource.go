@@ -0,0 +1,214 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ImportedResource A resource of an imported configuration document.
+//
+// swagger:model imported_resource
+type ImportedResource struct {
+
+	// Whether the resource was created, updated or an existing public datacenter was referenced.
+	// Enum: [created updated referenced]
+	Action string `json:"action,omitempty" db:"action,omitempty"`
+
+	// The id of the resource in the project.
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty" db:"id,omitempty"`
+
+	// resource type
+	// Enum: [domain pool member monitor datacenter geomap]
+	ResourceType string `json:"resource_type,omitempty" db:"resource_type,omitempty"`
+
+	// The id of the resource within the document.
+	// Format: uuid
+	SourceID strfmt.UUID `json:"source_id,omitempty" db:"source_id,omitempty"`
+}
+
+// Validate validates this imported resource
+func (m *ImportedResource) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResourceType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSourceID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var importedResourceTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["created","updated","referenced"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		importedResourceTypeActionPropEnum = append(importedResourceTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// ImportedResourceActionCreated captures enum value "created"
+	ImportedResourceActionCreated string = "created"
+
+	// ImportedResourceActionUpdated captures enum value "updated"
+	ImportedResourceActionUpdated string = "updated"
+
+	// ImportedResourceActionReferenced captures enum value "referenced"
+	ImportedResourceActionReferenced string = "referenced"
+)
+
+// prop value enum
+func (m *ImportedResource) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, importedResourceTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ImportedResource) validateAction(formats strfmt.Registry) error {
+	if swag.IsZero(m.Action) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ImportedResource) validateID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var importedResourceTypeResourceTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["domain","pool","member","monitor","datacenter","geomap"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		importedResourceTypeResourceTypePropEnum = append(importedResourceTypeResourceTypePropEnum, v)
+	}
+}
+
+const (
+
+	// ImportedResourceResourceTypeDomain captures enum value "domain"
+	ImportedResourceResourceTypeDomain string = "domain"
+
+	// ImportedResourceResourceTypePool captures enum value "pool"
+	ImportedResourceResourceTypePool string = "pool"
+
+	// ImportedResourceResourceTypeMember captures enum value "member"
+	ImportedResourceResourceTypeMember string = "member"
+
+	// ImportedResourceResourceTypeMonitor captures enum value "monitor"
+	ImportedResourceResourceTypeMonitor string = "monitor"
+
+	// ImportedResourceResourceTypeDatacenter captures enum value "datacenter"
+	ImportedResourceResourceTypeDatacenter string = "datacenter"
+
+	// ImportedResourceResourceTypeGeomap captures enum value "geomap"
+	ImportedResourceResourceTypeGeomap string = "geomap"
+)
+
+// prop value enum
+func (m *ImportedResource) validateResourceTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, importedResourceTypeResourceTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ImportedResource) validateResourceType(formats strfmt.Registry) error {
+	if swag.IsZero(m.ResourceType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateResourceTypeEnum("resource_type", "body", m.ResourceType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ImportedResource) validateSourceID(formats strfmt.Registry) error {
+	if swag.IsZero(m.SourceID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("source_id", "body", "uuid", m.SourceID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this imported resource based on context it is used
+func (m *ImportedResource) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ImportedResource) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ImportedResource) UnmarshalBinary(b []byte) error {
+	var res ImportedResource
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -24,7 +24,7 @@ import (
 // swagger:model member
 type Member struct {
 
-	// Address to use. Can also be a domain name.
+	// Address to use, IPv4 or IPv6. Must be a domain name if the pool is associated with CNAME or MX domains, which is not supported for other F5 domains. IP addresses must be of an address family handed out by a domain of the pool.
 	// Example: 1.2.3.4
 	Address *string `json:"address,omitempty" db:"address,omitempty"`
 
@@ -41,11 +41,21 @@ type Member struct {
 	// Format: uuid
 	DatacenterID *strfmt.UUID `json:"datacenter_id,omitempty" db:"datacenter_id,omitempty"`
 
+	// True if the status of the member changed frequently within the flap detection window.
+	// Example: false
+	// Read Only: true
+	Flapping *bool `json:"flapping,omitempty" db:"flapping,omitempty"`
+
 	// The id of the resource.
 	// Read Only: true
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty" db:"id,omitempty"`
 
+	// The UTC date and timestamp of the last provisioning failure.
+	// Read Only: true
+	// Format: date-time
+	LastErrorAt *strfmt.DateTime `json:"last_error_at,omitempty" db:"last_error_at,omitempty"`
+
 	// Human-readable name of the resource.
 	// Max Length: 255
 	Name *string `json:"name,omitempty" db:"name,omitempty"`
@@ -66,6 +76,10 @@ type Member struct {
 	// Min Length: 32
 	ProjectID *string `json:"project_id,omitempty" db:"project_id,omitempty"`
 
+	// The reason of the last provisioning failure reported by the agent, cleared once provisioned.
+	// Read Only: true
+	ProvisioningError *string `json:"provisioning_error,omitempty" db:"provisioning_error,omitempty"`
+
 	// provisioning status
 	// Read Only: true
 	// Enum: [PENDING_CREATE PENDING_UPDATE PENDING_DELETE ACTIVE ERROR]
@@ -81,6 +95,12 @@ type Member struct {
 	// Read Only: true
 	// Format: date-time
 	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty" db:"updated_at,omitempty"`
+
+	// Relative weight of the member within its pool, used for load balancing if the domain mode is WEIGHTED. A weight of 0 disables traffic to this member.
+	// Example: 10
+	// Maximum: 100
+	// Minimum: 0
+	Weight *int64 `json:"weight,omitempty" db:"weight,omitempty"`
 }
 
 // Validate validates this member
@@ -99,6 +119,10 @@ func (m *Member) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLastErrorAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -127,6 +151,10 @@ func (m *Member) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateWeight(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -169,6 +197,18 @@ func (m *Member) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Member) validateLastErrorAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastErrorAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_error_at", "body", "date-time", m.LastErrorAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Member) validateName(formats strfmt.Registry) error {
 	if swag.IsZero(m.Name) { // not required
 		return nil
@@ -333,6 +373,22 @@ func (m *Member) validateUpdatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Member) validateWeight(formats strfmt.Registry) error {
+	if swag.IsZero(m.Weight) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("weight", "body", *m.Weight, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("weight", "body", *m.Weight, 100, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this member based on the context it is used
 func (m *Member) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -341,10 +397,22 @@ func (m *Member) ContextValidate(ctx context.Context, formats strfmt.Registry) e
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateFlapping(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateID(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateLastErrorAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateProvisioningError(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProvisioningStatus(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -372,6 +440,15 @@ func (m *Member) contextValidateCreatedAt(ctx context.Context, formats strfmt.Re
 	return nil
 }
 
+func (m *Member) contextValidateFlapping(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "flapping", "body", m.Flapping); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Member) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "id", "body", strfmt.UUID(m.ID)); err != nil {
@@ -381,6 +458,24 @@ func (m *Member) contextValidateID(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *Member) contextValidateLastErrorAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "last_error_at", "body", m.LastErrorAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Member) contextValidateProvisioningError(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "provisioning_error", "body", m.ProvisioningError); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Member) contextValidateProvisioningStatus(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "provisioning_status", "body", string(m.ProvisioningStatus)); err != nil {
@@ -54,6 +54,11 @@ type Monitor struct {
 	// Minimum: 10
 	Interval *int64 `json:"interval,omitempty" db:"interval,omitempty"`
 
+	// The UTC date and timestamp of the last provisioning failure.
+	// Read Only: true
+	// Format: date-time
+	LastErrorAt *strfmt.DateTime `json:"last_error_at,omitempty" db:"last_error_at,omitempty"`
+
 	// Human-readable name of the resource.
 	// Max Length: 255
 	Name *string `json:"name,omitempty" db:"name,omitempty"`
@@ -68,6 +73,10 @@ type Monitor struct {
 	// Min Length: 32
 	ProjectID *string `json:"project_id,omitempty" db:"project_id,omitempty"`
 
+	// The reason of the last provisioning failure reported by the agent, cleared once provisioned.
+	// Read Only: true
+	ProvisioningError *string `json:"provisioning_error,omitempty" db:"provisioning_error,omitempty"`
+
 	// provisioning status
 	// Read Only: true
 	// Enum: [PENDING_CREATE PENDING_UPDATE PENDING_DELETE ACTIVE ERROR]
@@ -125,6 +134,10 @@ func (m *Monitor) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLastErrorAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -280,6 +293,18 @@ func (m *Monitor) validateInterval(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Monitor) validateLastErrorAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastErrorAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_error_at", "body", "date-time", m.LastErrorAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Monitor) validateName(formats strfmt.Registry) error {
 	if swag.IsZero(m.Name) { // not required
 		return nil
@@ -486,6 +511,14 @@ func (m *Monitor) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateLastErrorAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateProvisioningError(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProvisioningStatus(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -518,6 +551,24 @@ func (m *Monitor) contextValidateID(ctx context.Context, formats strfmt.Registry
 	return nil
 }
 
+func (m *Monitor) contextValidateLastErrorAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "last_error_at", "body", m.LastErrorAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Monitor) contextValidateProvisioningError(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "provisioning_error", "body", m.ProvisioningError); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Monitor) contextValidateProvisioningStatus(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "provisioning_status", "body", string(m.ProvisioningStatus)); err != nil {
@@ -34,7 +34,7 @@ type Pool struct {
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty" db:"created_at,omitempty"`
 
-	// Array of domains assigned to this pool
+	// Array of domains assigned to this pool, the pool is appended to the pools of newly assigned domains with the lowest priority
 	Domains []strfmt.UUID `json:"domains" db:"domains"`
 
 	// The id of the resource.
@@ -42,6 +42,11 @@ type Pool struct {
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty" db:"id,omitempty"`
 
+	// The UTC date and timestamp of the last provisioning failure.
+	// Read Only: true
+	// Format: date-time
+	LastErrorAt *strfmt.DateTime `json:"last_error_at,omitempty" db:"last_error_at,omitempty"`
+
 	// Array of member ids that this pool uses for load balancing.
 	// Read Only: true
 	Members []strfmt.UUID `json:"members" db:"members"`
@@ -60,14 +65,18 @@ type Pool struct {
 	// Min Length: 32
 	ProjectID *string `json:"project_id,omitempty" db:"project_id,omitempty"`
 
+	// The reason of the last provisioning failure reported by the agent, cleared once provisioned.
+	// Read Only: true
+	ProvisioningError *string `json:"provisioning_error,omitempty" db:"provisioning_error,omitempty"`
+
 	// provisioning status
 	// Read Only: true
 	// Enum: [PENDING_CREATE PENDING_UPDATE PENDING_DELETE ACTIVE ERROR]
 	ProvisioningStatus string `json:"provisioning_status,omitempty" db:"provisioning_status,omitempty"`
 
-	// status
+	// Operating status aggregated from the status of all enabled members of the pool.
 	// Read Only: true
-	// Enum: [ONLINE DOWN]
+	// Enum: [ONLINE DEGRADED OFFLINE NO_MONITOR UNKNOWN]
 	Status string `json:"status,omitempty" db:"status,omitempty"`
 
 	// The UTC date and timestamp when the resource was created.
@@ -93,6 +102,10 @@ func (m *Pool) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLastErrorAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMembers(formats); err != nil {
 		res = append(res, err)
 	}
@@ -167,6 +180,18 @@ func (m *Pool) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Pool) validateLastErrorAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastErrorAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_error_at", "body", "date-time", m.LastErrorAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Pool) validateMembers(formats strfmt.Registry) error {
 	if swag.IsZero(m.Members) { // not required
 		return nil
@@ -282,7 +307,7 @@ var poolTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["ONLINE","DOWN"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["ONLINE","DEGRADED","OFFLINE","NO_MONITOR","UNKNOWN"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -295,8 +320,17 @@ const (
 	// PoolStatusONLINE captures enum value "ONLINE"
 	PoolStatusONLINE string = "ONLINE"
 
-	// PoolStatusDOWN captures enum value "DOWN"
-	PoolStatusDOWN string = "DOWN"
+	// PoolStatusDEGRADED captures enum value "DEGRADED"
+	PoolStatusDEGRADED string = "DEGRADED"
+
+	// PoolStatusOFFLINE captures enum value "OFFLINE"
+	PoolStatusOFFLINE string = "OFFLINE"
+
+	// PoolStatusNOMONITOR captures enum value "NO_MONITOR"
+	PoolStatusNOMONITOR string = "NO_MONITOR"
+
+	// PoolStatusUNKNOWN captures enum value "UNKNOWN"
+	PoolStatusUNKNOWN string = "UNKNOWN"
 )
 
 // prop value enum
@@ -344,6 +378,10 @@ func (m *Pool) ContextValidate(ctx context.Context, formats strfmt.Registry) err
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateLastErrorAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateMembers(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -352,6 +390,10 @@ func (m *Pool) ContextValidate(ctx context.Context, formats strfmt.Registry) err
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateProvisioningError(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProvisioningStatus(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -388,6 +430,15 @@ func (m *Pool) contextValidateID(ctx context.Context, formats strfmt.Registry) e
 	return nil
 }
 
+func (m *Pool) contextValidateLastErrorAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "last_error_at", "body", m.LastErrorAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Pool) contextValidateMembers(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "members", "body", []strfmt.UUID(m.Members)); err != nil {
@@ -406,6 +457,15 @@ func (m *Pool) contextValidateMonitors(ctx context.Context, formats strfmt.Regis
 	return nil
 }
 
+func (m *Pool) contextValidateProvisioningError(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "provisioning_error", "body", m.ProvisioningError); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Pool) contextValidateProvisioningStatus(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "provisioning_status", "body", string(m.ProvisioningStatus)); err != nil {
@@ -29,6 +29,10 @@ type Quota struct {
 	// Example: 5
 	DomainAkamai *int64 `json:"domain_akamai,omitempty" db:"domain_akamai,omitempty"`
 
+	// The configured domain quota limit for provider DNS. A setting of null means it is using the deployment default quota. A setting of -1 means unlimited.
+	// Example: 5
+	DomainDNS *int64 `json:"domain_dns,omitempty" db:"domain_dns,omitempty"`
+
 	// The configured domain quota limit for provider F5. A setting of null means it is using the deployment default quota. A setting of -1 means unlimited.
 	// Example: 5
 	DomainF5 *int64 `json:"domain_f5,omitempty" db:"domain_f5,omitempty"`
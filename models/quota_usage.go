@@ -29,6 +29,10 @@ type QuotaUsage struct {
 	// Example: 5
 	InUseDomainAkamai int64 `json:"in_use_domain_akamai" db:"in_use_domain_akamai"`
 
+	// The current quota usage of domain (provider = DNS).
+	// Example: 5
+	InUseDomainDNS int64 `json:"in_use_domain_dns" db:"in_use_domain_dns"`
+
 	// The current quota usage of domain (provider = F5).
 	// Example: 5
 	InUseDomainF5 int64 `json:"in_use_domain_f5" db:"in_use_domain_f5"`
@@ -37,6 +37,10 @@ type Service struct {
 	// Example: andromeda-agent-fbb49979-03f5-4a97-a334-1fd2c9f61e7e
 	ID string `json:"id,omitempty" db:"id,omitempty"`
 
+	// Whether the service replica is the elected leader of its agent, not set for services without leader election.
+	// Example: true
+	Leader *bool `json:"leader,omitempty" db:"leader,omitempty"`
+
 	// metadata
 	Metadata interface{} `json:"metadata,omitempty" db:"metadata,omitempty"`
 
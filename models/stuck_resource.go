@@ -0,0 +1,226 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StuckResource stuck resource
+//
+// swagger:model stuck_resource
+type StuckResource struct {
+
+	// The id of the resource.
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty" db:"id,omitempty"`
+
+	// The ID of the project owning this resource.
+	ProjectID string `json:"project_id,omitempty" db:"project_id,omitempty"`
+
+	// The reason of the last provisioning failure.
+	ProvisioningError *string `json:"provisioning_error,omitempty" db:"provisioning_error,omitempty"`
+
+	// provisioning status
+	// Enum: [PENDING_CREATE PENDING_UPDATE PENDING_DELETE ACTIVE ERROR]
+	ProvisioningStatus string `json:"provisioning_status,omitempty" db:"provisioning_status,omitempty"`
+
+	// resource type
+	// Enum: [domain pool member monitor datacenter geomap]
+	ResourceType string `json:"resource_type,omitempty" db:"resource_type,omitempty"`
+
+	// The UTC date and timestamp when the resource was last updated.
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty" db:"updated_at,omitempty"`
+}
+
+// Validate validates this stuck resource
+func (m *StuckResource) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProvisioningStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResourceType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StuckResource) validateID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var stuckResourceTypeProvisioningStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["PENDING_CREATE","PENDING_UPDATE","PENDING_DELETE","ACTIVE","ERROR"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		stuckResourceTypeProvisioningStatusPropEnum = append(stuckResourceTypeProvisioningStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// StuckResourceProvisioningStatusPENDINGCREATE captures enum value "PENDING_CREATE"
+	StuckResourceProvisioningStatusPENDINGCREATE string = "PENDING_CREATE"
+
+	// StuckResourceProvisioningStatusPENDINGUPDATE captures enum value "PENDING_UPDATE"
+	StuckResourceProvisioningStatusPENDINGUPDATE string = "PENDING_UPDATE"
+
+	// StuckResourceProvisioningStatusPENDINGDELETE captures enum value "PENDING_DELETE"
+	StuckResourceProvisioningStatusPENDINGDELETE string = "PENDING_DELETE"
+
+	// StuckResourceProvisioningStatusACTIVE captures enum value "ACTIVE"
+	StuckResourceProvisioningStatusACTIVE string = "ACTIVE"
+
+	// StuckResourceProvisioningStatusERROR captures enum value "ERROR"
+	StuckResourceProvisioningStatusERROR string = "ERROR"
+)
+
+// prop value enum
+func (m *StuckResource) validateProvisioningStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, stuckResourceTypeProvisioningStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *StuckResource) validateProvisioningStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.ProvisioningStatus) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateProvisioningStatusEnum("provisioning_status", "body", m.ProvisioningStatus); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var stuckResourceTypeResourceTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["domain","pool","member","monitor","datacenter","geomap"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		stuckResourceTypeResourceTypePropEnum = append(stuckResourceTypeResourceTypePropEnum, v)
+	}
+}
+
+const (
+
+	// StuckResourceResourceTypeDomain captures enum value "domain"
+	StuckResourceResourceTypeDomain string = "domain"
+
+	// StuckResourceResourceTypePool captures enum value "pool"
+	StuckResourceResourceTypePool string = "pool"
+
+	// StuckResourceResourceTypeMember captures enum value "member"
+	StuckResourceResourceTypeMember string = "member"
+
+	// StuckResourceResourceTypeMonitor captures enum value "monitor"
+	StuckResourceResourceTypeMonitor string = "monitor"
+
+	// StuckResourceResourceTypeDatacenter captures enum value "datacenter"
+	StuckResourceResourceTypeDatacenter string = "datacenter"
+
+	// StuckResourceResourceTypeGeomap captures enum value "geomap"
+	StuckResourceResourceTypeGeomap string = "geomap"
+)
+
+// prop value enum
+func (m *StuckResource) validateResourceTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, stuckResourceTypeResourceTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *StuckResource) validateResourceType(formats strfmt.Registry) error {
+	if swag.IsZero(m.ResourceType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateResourceTypeEnum("resource_type", "body", m.ResourceType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StuckResource) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this stuck resource based on context it is used
+func (m *StuckResource) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StuckResource) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StuckResource) UnmarshalBinary(b []byte) error {
+	var res StuckResource
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
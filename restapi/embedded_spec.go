@@ -36,6 +36,38 @@ func init() {
   },
   "basePath": "/v1",
   "paths": {
+    "/agents": {
+      "get": {
+        "description": "Lists the agents registered by their heartbeats. Agents without heartbeat within the agent TTL, or disabled\nby an administrator, are STALE.\n",
+        "tags": [
+          "Administrative"
+        ],
+        "summary": "List agents",
+        "responses": {
+          "200": {
+            "description": "A JSON array of agents",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "agents": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/agent"
+                  }
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:agent:get_all"
+      }
+    },
     "/cidr-blocks": {
       "get": {
         "tags": [
@@ -324,6 +356,57 @@ func init() {
         }
       ]
     },
+    "/datacenters/{datacenter_id}/retry": {
+      "post": {
+        "description": "Moves a datacenter with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.",
+        "tags": [
+          "Datacenters"
+        ],
+        "summary": "Retry provisioning of a datacenter",
+        "responses": {
+          "202": {
+            "description": "The datacenter will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "datacenter": {
+                  "$ref": "#/definitions/datacenter"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:datacenter:retry"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the datacenter",
+          "name": "datacenter_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/domains": {
       "get": {
         "tags": [
@@ -576,6 +659,165 @@ func init() {
         }
       ]
     },
+    "/domains/{domain_id}/restore": {
+      "post": {
+        "description": "Restores a deleted domain together with its pool relations, as long as it has not been purged after the restore window (api_settings.domain_restore_window) elapsed. Restored domains count against the domain quota again.",
+        "tags": [
+          "Domains"
+        ],
+        "summary": "Restore a deleted domain",
+        "responses": {
+          "202": {
+            "description": "The domain will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "domain": {
+                  "$ref": "#/definitions/domain"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:domain:restore"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the domain",
+          "name": "domain_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/domains/{domain_id}/retry": {
+      "post": {
+        "description": "Moves a domain with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.",
+        "tags": [
+          "Domains"
+        ],
+        "summary": "Retry provisioning of a domain",
+        "responses": {
+          "202": {
+            "description": "The domain will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "domain": {
+                  "$ref": "#/definitions/domain"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:domain:retry"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the domain",
+          "name": "domain_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/export": {
+      "get": {
+        "description": "Serialises all domains, pools, members, monitors, datacenters and geographic maps of a project into one versioned document. Resources reference each other by their ids within the document, public datacenters used by the project are included.",
+        "tags": [
+          "Configuration"
+        ],
+        "summary": "Export the configuration of a project",
+        "parameters": [
+          {
+            "type": "string",
+            "x-nullable": true,
+            "description": "Project to export, defaults to the project of the token.",
+            "name": "project_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The configuration of the project.",
+            "schema": {
+              "$ref": "#/definitions/configuration"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:export:get"
+      }
+    },
+    "/f5/diff": {
+      "get": {
+        "description": "Builds the AS3 declaration of all F5 domains (dry-run) and returns its difference to the declaration\ncurrently deployed on the active F5 device per tenant and application. Nothing is applied.\n",
+        "tags": [
+          "Administrative"
+        ],
+        "summary": "Preview the F5 AS3 declaration",
+        "responses": {
+          "200": {
+            "description": "Difference between the deployed and the desired AS3 declaration.",
+            "schema": {
+              "$ref": "#/definitions/declaration_diff"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:f5:diff"
+      }
+    },
     "/geomaps": {
       "get": {
         "tags": [
@@ -830,64 +1072,176 @@ func init() {
         }
       ]
     },
-    "/members": {
-      "get": {
+    "/geomaps/{geomap_id}/retry": {
+      "post": {
+        "description": "Moves a geographic map with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.",
         "tags": [
-          "Members"
-        ],
-        "summary": "List members",
-        "parameters": [
-          {
-            "$ref": "#/parameters/marker"
-          },
-          {
-            "$ref": "#/parameters/limit"
-          },
-          {
-            "$ref": "#/parameters/sort"
-          },
-          {
-            "$ref": "#/parameters/page_reverse"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "x-nullable": true,
-            "description": "Pool ID of the members to fetch",
-            "name": "pool_id",
-            "in": "query"
-          }
+          "Geographic maps"
         ],
+        "summary": "Retry provisioning of a geographic map",
         "responses": {
-          "200": {
-            "description": "A JSON array of members",
+          "202": {
+            "description": "The geographic map will be provisioned again.",
             "schema": {
               "type": "object",
               "properties": {
-                "links": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/link"
-                  },
-                  "x-omitempty": true
-                },
-                "members": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/member"
-                  }
+                "geomap": {
+                  "$ref": "#/definitions/geomap"
                 }
               }
             }
           },
-          "400": {
-            "description": "Bad request",
+          "404": {
+            "description": "Not Found",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "default": {
-            "description": "Unexpected Error",
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:geomap:retry"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the geomap",
+          "name": "geomap_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/import": {
+      "post": {
+        "description": "Creates or updates the resources of an exported configuration document in a project. Domains are matched by fqdn and provider, members by address and port within their pool, all other resources by name or, if unnamed, by their id. Public datacenters are referenced instead of copied if they exist.",
+        "tags": [
+          "Configuration"
+        ],
+        "summary": "Import the configuration of a project",
+        "parameters": [
+          {
+            "type": "string",
+            "x-nullable": true,
+            "description": "Project to import into, defaults to the project of the token.",
+            "name": "project_id",
+            "in": "query"
+          },
+          {
+            "name": "configuration",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/configuration"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The resources of the document and what the import did with them.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "resources": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/imported_resource"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "Quota exceeded",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:import:post"
+      }
+    },
+    "/members": {
+      "get": {
+        "tags": [
+          "Members"
+        ],
+        "summary": "List members",
+        "parameters": [
+          {
+            "$ref": "#/parameters/marker"
+          },
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/sort"
+          },
+          {
+            "$ref": "#/parameters/page_reverse"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "x-nullable": true,
+            "description": "Pool ID of the members to fetch",
+            "name": "pool_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A JSON array of members",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/link"
+                  },
+                  "x-omitempty": true
+                },
+                "members": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/member"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1076,6 +1430,126 @@ func init() {
         }
       ]
     },
+    "/members/{member_id}/retry": {
+      "post": {
+        "description": "Moves a member with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.",
+        "tags": [
+          "Members"
+        ],
+        "summary": "Retry provisioning of a member",
+        "responses": {
+          "202": {
+            "description": "The member will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "member": {
+                  "$ref": "#/definitions/member"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:member:retry"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the member",
+          "name": "member_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/members/{member_id}/status-history": {
+      "get": {
+        "description": "Lists the status transitions of a member, newest first.",
+        "tags": [
+          "Members"
+        ],
+        "summary": "Show member status history",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "date-time",
+            "x-nullable": true,
+            "description": "Only transitions at or after this UTC date and timestamp.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "x-nullable": true,
+            "description": "Only transitions before this UTC date and timestamp.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/limit"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A JSON array of status transitions.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "status_history": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/member_status_transition"
+                  }
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:member:get_status_history"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the member",
+          "name": "member_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/monitors": {
       "get": {
         "tags": [
@@ -1142,7 +1616,7 @@ func init() {
         "x-policy": "andromeda:monitor:get_all"
       },
       "post": {
-        "description": "### F5 monitors\n\nAndromeda pool monitors are mapped to [F5 monitors][f5-monitors] based on the ` + "`" + `type` + "`" + ` property as follows:\n\n[f5-monitors]: \u003chttps://clouddocs.f5.com/products/extensions/f5-appsvcs-extension/latest/refguide/schemaref/Monitor.schema.json.html\u003e\n\n| Andromeda | F5             |\n|-----------|----------------|\n| ` + "`" + `HTTP` + "`" + `    | ` + "`" + `http` + "`" + `         |\n| ` + "`" + `HTTPS` + "`" + `   | ` + "`" + `https` + "`" + `        |\n| ` + "`" + `ICMP` + "`" + `    | ` + "`" + `gateway-icmp` + "`" + ` |\n| ` + "`" + `TCP` + "`" + `     | ` + "`" + `tcp` + "`" + `          |\n| ` + "`" + `UDP` + "`" + `     | ` + "`" + `udp` + "`" + `          |\n\nAndromeda monitor properties map to an F5 ` + "`" + `Monitor` + "`" + ` as follows:\n\n| Andromeda     | F5 ` + "`" + `Monitor` + "`" + ` object   | Comments                                       |\n|---------------|-----------------------|------------------------------------------------|\n| ` + "`" + `domain_name` + "`" + ` | -                     | Unsupported by F5/AS3                          |\n| ` + "`" + `http_method` + "`" + ` | -                     | Unsupported by F5/AS3 (see caveats below)      |\n| ` + "`" + `interval` + "`" + `    | ` + "`" + `interval` + "`" + `            |                                                |\n| ` + "`" + `receive` + "`" + `     | ` + "`" + `receive` + "`" + `             | ` + "`" + `HTTP` + "`" + ` / ` + "`" + `HTTPS` + "`" + ` only                          |\n| ` + "`" + `send` + "`" + `        | ` + "`" + `send` + "`" + `                | ` + "`" + `HTTP` + "`" + ` / ` + "`" + `HTTPS` + "`" + ` only                          |\n| ` + "`" + `timeout` + "`" + `     | ` + "`" + `timeout` + "`" + `             |                                                |\n| ` + "`" + `type` + "`" + `        | ` + "`" + `monitorType` + "`" + `         |                                                |\n\nCaveats:\n\n* For HTTP/S monitors, F5 decides on the HTTP method, URL path and HTTP version according to ` + "`" + `Monitor.send` + "`" + `. For details please refer to the [Monitor_HTTP object][monitor-http-object].\n\n[monitor-http-object]: \u003chttps://clouddocs.f5.com/products/extensions/f5-appsvcs-extension/latest/refguide/schemaref/Monitor.schema.json.html#monitor-http-object\u003e",
+        "description": "### F5 monitors\n\nAndromeda pool monitors are mapped to [F5 monitors][f5-monitors] based on the ` + "`" + `type` + "`" + ` property as follows:\n\n[f5-monitors]: \u003chttps://clouddocs.f5.com/products/extensions/f5-appsvcs-extension/latest/refguide/schemaref/Monitor.schema.json.html\u003e\n\n| Andromeda | F5             |\n|-----------|----------------|\n| ` + "`" + `HTTP` + "`" + `    | ` + "`" + `http` + "`" + `         |\n| ` + "`" + `HTTPS` + "`" + `   | ` + "`" + `https` + "`" + `        |\n| ` + "`" + `ICMP` + "`" + `    | ` + "`" + `gateway-icmp` + "`" + ` |\n| ` + "`" + `TCP` + "`" + `     | ` + "`" + `tcp` + "`" + `          |\n| ` + "`" + `UDP` + "`" + `     | ` + "`" + `udp` + "`" + `          |\n\nAndromeda monitor properties map to an F5 ` + "`" + `GSLB_Monitor` + "`" + ` as follows:\n\n| Andromeda     | F5 ` + "`" + `GSLB_Monitor` + "`" + ` object | Comments                                       |\n|---------------|--------------------------|------------------------------------------------|\n| ` + "`" + `domain_name` + "`" + ` | -                        | Unsupported by F5/AS3                          |\n| ` + "`" + `http_method` + "`" + ` | -                        | Unsupported by F5/AS3 (see caveats below)      |\n| ` + "`" + `interval` + "`" + `    | ` + "`" + `interval` + "`" + `               |                                                |\n| ` + "`" + `receive` + "`" + `     | ` + "`" + `receive` + "`" + `                | ` + "`" + `HTTP` + "`" + ` / ` + "`" + `HTTPS` + "`" + ` only                          |\n| ` + "`" + `send` + "`" + `        | ` + "`" + `send` + "`" + `                   | ` + "`" + `HTTP` + "`" + ` / ` + "`" + `HTTPS` + "`" + ` only                          |\n| ` + "`" + `timeout` + "`" + `     | ` + "`" + `probeTimeout` + "`" + `           |                                                |\n| ` + "`" + `type` + "`" + `        | ` + "`" + `monitorType` + "`" + `            |                                                |\n\nCaveats:\n\n* For HTTP/S monitors, F5 decides on the HTTP method, URL path and HTTP version according to ` + "`" + `Monitor.send` + "`" + `. For details please refer to the [Monitor_HTTP object][monitor-http-object].\n\n[monitor-http-object]: \u003chttps://clouddocs.f5.com/products/extensions/f5-appsvcs-extension/latest/refguide/schemaref/Monitor.schema.json.html#monitor-http-object\u003e",
         "tags": [
           "Monitors"
         ],
@@ -1323,6 +1797,57 @@ func init() {
         }
       ]
     },
+    "/monitors/{monitor_id}/retry": {
+      "post": {
+        "description": "Moves a monitor with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.",
+        "tags": [
+          "Monitors"
+        ],
+        "summary": "Retry provisioning of a monitor",
+        "responses": {
+          "202": {
+            "description": "The monitor will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "monitor": {
+                  "$ref": "#/definitions/monitor"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:monitor:retry"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the monitor",
+          "name": "monitor_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/pools": {
       "get": {
         "tags": [
@@ -1577,6 +2102,57 @@ func init() {
         }
       ]
     },
+    "/pools/{pool_id}/retry": {
+      "post": {
+        "description": "Moves a pool with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.",
+        "tags": [
+          "Pools"
+        ],
+        "summary": "Retry provisioning of a pool",
+        "responses": {
+          "202": {
+            "description": "The pool will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "pool": {
+                  "$ref": "#/definitions/pool"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:pool:retry"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the pool",
+          "name": "pool_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/quotas": {
       "get": {
         "tags": [
@@ -1700,26 +2276,173 @@ func init() {
             }
           }
         },
-        "x-policy": "andromeda:quota:get_one"
-      },
-      "put": {
+        "x-policy": "andromeda:quota:get_one"
+      },
+      "put": {
+        "tags": [
+          "Administrative"
+        ],
+        "summary": "Update Quota",
+        "parameters": [
+          {
+            "name": "quota",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "quota"
+              ],
+              "properties": {
+                "quota": {
+                  "$ref": "#/definitions/quota"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Updated quota for a project.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "quota": {
+                  "$ref": "#/definitions/quota"
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:quota:put"
+      },
+      "delete": {
+        "tags": [
+          "Administrative"
+        ],
+        "summary": "Reset all Quota of a project",
+        "responses": {
+          "204": {
+            "description": "Resource successfully reseted."
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:quota:delete"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The ID of the project to query.",
+          "name": "project_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/reset": {
+      "get": {
+        "description": "Lists resources in a pending provisioning status or in ERROR which have not been updated within the threshold.",
+        "tags": [
+          "Administrative"
+        ],
+        "summary": "List stuck resources",
+        "parameters": [
+          {
+            "type": "integer",
+            "default": 3600,
+            "description": "Threshold in seconds since the last update of the resource.",
+            "name": "older_than",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "domain",
+              "pool",
+              "member",
+              "monitor",
+              "datacenter",
+              "geomap"
+            ],
+            "type": "string",
+            "x-nullable": true,
+            "description": "Only list resources of this type.",
+            "name": "resource_type",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A JSON array of stuck resources.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "resources": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/stuck_resource"
+                  }
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:reset:get_all"
+      }
+    },
+    "/reset/{resource_type}/{resource_id}": {
+      "post": {
+        "description": "Requeues the resource for provisioning (ERROR resources become PENDING_UPDATE), or forces the provisioning\nstatus to ACTIVE or ERROR.\n",
         "tags": [
           "Administrative"
         ],
-        "summary": "Update Quota",
+        "summary": "Reset the provisioning status of a resource",
         "parameters": [
           {
-            "name": "quota",
+            "name": "reset",
             "in": "body",
             "required": true,
             "schema": {
               "type": "object",
               "required": [
-                "quota"
+                "action"
               ],
               "properties": {
-                "quota": {
-                  "$ref": "#/definitions/quota"
+                "action": {
+                  "description": "The reset action.",
+                  "type": "string",
+                  "enum": [
+                    "requeue",
+                    "active",
+                    "error"
+                  ]
+                },
+                "reason": {
+                  "description": "The provisioning error recorded for action error.",
+                  "type": "string",
+                  "maxLength": 255
                 }
               }
             }
@@ -1727,34 +2450,16 @@ func init() {
         ],
         "responses": {
           "202": {
-            "description": "Updated quota for a project.",
+            "description": "The resource has been reset.",
             "schema": {
               "type": "object",
               "properties": {
-                "quota": {
-                  "$ref": "#/definitions/quota"
+                "resource": {
+                  "$ref": "#/definitions/stuck_resource"
                 }
               }
             }
           },
-          "default": {
-            "description": "Unexpected Error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        },
-        "x-policy": "andromeda:quota:put"
-      },
-      "delete": {
-        "tags": [
-          "Administrative"
-        ],
-        "summary": "Reset all Quota of a project",
-        "responses": {
-          "204": {
-            "description": "Resource successfully reseted."
-          },
           "404": {
             "description": "Not Found",
             "schema": {
@@ -1768,13 +2473,29 @@ func init() {
             }
           }
         },
-        "x-policy": "andromeda:quota:delete"
+        "x-policy": "andromeda:reset:post"
       },
       "parameters": [
         {
+          "enum": [
+            "domain",
+            "pool",
+            "member",
+            "monitor",
+            "datacenter",
+            "geomap"
+          ],
           "type": "string",
-          "description": "The ID of the project to query.",
-          "name": "project_id",
+          "description": "The type of the resource",
+          "name": "resource_type",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the resource",
+          "name": "resource_id",
           "in": "path",
           "required": true
         }
@@ -1813,10 +2534,11 @@ func init() {
     },
     "/sync": {
       "post": {
+        "description": "Requests a sync of the given domains from the agents of their providers, a full sync is requested if no\ndomains are given. The F5 agent replies once the sync is completed, errors are returned to the caller.\nUnknown domains are rejected.\n",
         "tags": [
           "Administrative"
         ],
-        "summary": "Enqueue a full sync",
+        "summary": "Sync domains",
         "parameters": [
           {
             "name": "domains",
@@ -1842,7 +2564,7 @@ func init() {
         ],
         "responses": {
           "202": {
-            "description": "Full sync has been enqueued."
+            "description": "Sync has been enqueued or completed."
           },
           "default": {
             "description": "Unexpected Error",
@@ -1856,6 +2578,126 @@ func init() {
     }
   },
   "definitions": {
+    "agent": {
+      "type": "object",
+      "properties": {
+        "admin_state_up": {
+          "description": "The administrative state of the agent, disabled agents skip their work until enabled again.",
+          "type": "boolean",
+          "example": true
+        },
+        "capabilities": {
+          "description": "Capabilities of the agent, domains can only be provisioned by agents capable to sync.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "sync",
+            "member_status"
+          ]
+        },
+        "heartbeat": {
+          "description": "The UTC date and timestamp of the last heartbeat.",
+          "type": "string",
+          "format": "date-time",
+          "example": "2020-05-11 17:21:34"
+        },
+        "host": {
+          "description": "Hostname of the computer the agent is running.",
+          "type": "string",
+          "example": "example.host"
+        },
+        "last_sync": {
+          "description": "The UTC date and timestamp of the last successful sync.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "example": "2020-05-11 17:21:34"
+        },
+        "providers": {
+          "description": "Providers this agent supports.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "akamai"
+          ]
+        },
+        "status": {
+          "description": "ALIVE if the agent is enabled and sent a heartbeat within the agent TTL.",
+          "type": "string",
+          "enum": [
+            "ALIVE",
+            "STALE"
+          ],
+          "example": "ALIVE"
+        },
+        "type": {
+          "description": "Type of agent.",
+          "type": "string",
+          "example": "andromeda-akamai-agent"
+        },
+        "version": {
+          "description": "Version of the agent.",
+          "type": "string",
+          "example": "1.2.3"
+        }
+      }
+    },
+    "configuration": {
+      "description": "Versioned configuration of a project, resources reference each other by their ids within the document.",
+      "type": "object",
+      "required": [
+        "version"
+      ],
+      "properties": {
+        "datacenters": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/datacenter"
+          }
+        },
+        "domains": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/domain"
+          }
+        },
+        "geomaps": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/geomap"
+          }
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/member"
+          }
+        },
+        "monitors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/monitor"
+          }
+        },
+        "pools": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pool"
+          }
+        },
+        "version": {
+          "description": "Version of the document format.",
+          "type": "integer",
+          "enum": [
+            1
+          ]
+        }
+      }
+    },
     "datacenter": {
       "type": "object",
       "properties": {
@@ -1902,6 +2744,13 @@ func init() {
           "format": "uuid",
           "readOnly": true
         },
+        "last_error_at": {
+          "description": "The UTC date and timestamp of the last provisioning failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "latitude": {
           "type": "number",
           "format": "double",
@@ -1941,10 +2790,18 @@ func init() {
           "type": "string",
           "enum": [
             "akamai",
-            "f5"
+            "f5",
+            "dns",
+            "noop"
           ],
           "example": "akamai"
         },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure reported by the agent, cleared once provisioned.",
+          "type": "string",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "provisioning_status": {
           "type": "string",
           "enum": [
@@ -1983,6 +2840,72 @@ func init() {
         }
       }
     },
+    "declaration_diff": {
+      "type": "object",
+      "properties": {
+        "tenants": {
+          "description": "Tenants to be created, updated or deleted, unchanged tenants are omitted.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "action": {
+                "type": "string",
+                "enum": [
+                  "create",
+                  "update",
+                  "delete"
+                ]
+              },
+              "applications": {
+                "type": "array",
+                "items": {
+                  "type": "object",
+                  "properties": {
+                    "action": {
+                      "type": "string",
+                      "enum": [
+                        "create",
+                        "update",
+                        "delete"
+                      ]
+                    },
+                    "application": {
+                      "type": "string",
+                      "example": "application"
+                    },
+                    "changes": {
+                      "description": "Changed properties of updated applications.",
+                      "type": "array",
+                      "items": {
+                        "type": "object",
+                        "properties": {
+                          "deployed": {
+                            "description": "Deployed value, absent if the property is added."
+                          },
+                          "desired": {
+                            "description": "Desired value, absent if the property is removed."
+                          },
+                          "path": {
+                            "description": "JSON pointer of the changed property.",
+                            "type": "string",
+                            "example": "/domain_a9b3c3e4-8e6b-4c2b-9f5e-2f1b3c4d5e6f/application/domain/poolLbMode"
+                          }
+                        }
+                      }
+                    }
+                  }
+                }
+              },
+              "tenant": {
+                "type": "string",
+                "example": "domain_a9b3c3e4-8e6b-4c2b-9f5e-2f1b3c4d5e6f"
+              }
+            }
+          }
+        }
+      }
+    },
     "domain": {
       "description": "A representation of a domain",
       "type": "object",
@@ -1993,6 +2916,7 @@ func init() {
           "default": true
         },
         "aliases": {
+          "description": "Additional hostnames resolving like the FQDN. F5 aliases may contain the wildcards * and ?, Akamai serves each alias by an additional property.",
           "type": "array",
           "items": {
             "type": "string",
@@ -2015,6 +2939,29 @@ func init() {
           "readOnly": true,
           "example": "2020-05-11T17:21:34"
         },
+        "deleted_at": {
+          "description": "The UTC date and timestamp when the domain was deleted, it can be restored until the restore window elapsed.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "failback_delay": {
+          "description": "Seconds a datacenter has to be up again before traffic fails back to it. Only supported by Akamai.",
+          "type": "integer",
+          "default": 0,
+          "maximum": 3600,
+          "x-nullable": true,
+          "example": 0
+        },
+        "failover_delay": {
+          "description": "Seconds a datacenter has to be down before traffic fails over to another datacenter. Only supported by Akamai.",
+          "type": "integer",
+          "default": 0,
+          "maximum": 3600,
+          "x-nullable": true,
+          "example": 0
+        },
         "fqdn": {
           "description": "Desired Fully-Qualified Host Name.",
           "type": "string",
@@ -2023,12 +2970,27 @@ func init() {
           "x-nullable": true,
           "example": "example.org"
         },
+        "handout_limit": {
+          "description": "Maximum number of addresses handed out per answer, 0 hands out all live addresses. Akamai supports up to 8 addresses.",
+          "type": "integer",
+          "default": 0,
+          "maximum": 500,
+          "x-nullable": true,
+          "example": 0
+        },
         "id": {
           "description": "The id of the resource.",
           "type": "string",
           "format": "uuid",
           "readOnly": true
         },
+        "last_error_at": {
+          "description": "The UTC date and timestamp of the last provisioning failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "mode": {
           "description": "Load balancing method to use for the references pools.",
           "type": "string",
@@ -2046,6 +3008,7 @@ func init() {
           "x-nullable": true
         },
         "pools": {
+          "description": "Pools that this domain uses for load balancing, in order of priority. AVAILABILITY domains hand out the first pool with available members and fall back to the following pools in order, on Akamai only with availability_failover enabled. Pools assigned via the domains of a pool are appended with the lowest priority.",
           "type": "array",
           "items": {
             "description": "Pool that this domain uses for load balancing.",
@@ -2066,11 +3029,19 @@ func init() {
           "type": "string",
           "enum": [
             "akamai",
-            "f5"
+            "f5",
+            "dns",
+            "noop"
           ],
           "x-nullable": true,
           "example": "akamai"
         },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure reported by the agent, cleared once provisioned.",
+          "type": "string",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "provisioning_status": {
           "type": "string",
           "enum": [
@@ -2078,12 +3049,13 @@ func init() {
             "PENDING_UPDATE",
             "PENDING_DELETE",
             "ACTIVE",
-            "ERROR"
+            "ERROR",
+            "DELETED"
           ],
           "readOnly": true
         },
         "record_type": {
-          "description": "DNS Record type to use.",
+          "description": "DNS Record type to use. F5 domains of type A or AAAA are dual-stack and hand out IPv4 members as A and IPv6 members as AAAA records, Akamai domains only hand out members of the address family of their record type. CNAME and MX domains hand out the hostnames of their members, MX is not supported by Akamai.",
           "type": "string",
           "default": "A",
           "enum": [
@@ -2094,13 +3066,25 @@ func init() {
           ]
         },
         "status": {
+          "description": "Operating status aggregated from the status of all enabled pools of the domain.",
           "type": "string",
           "enum": [
             "ONLINE",
-            "DOWN"
+            "DEGRADED",
+            "OFFLINE",
+            "NO_MONITOR",
+            "UNKNOWN"
           ],
           "readOnly": true
         },
+        "ttl": {
+          "description": "Time to live, in seconds, of the handed out records. Akamai supports 30 to 3600 seconds, F5 at least 1 second.",
+          "type": "integer",
+          "default": 30,
+          "maximum": 86400,
+          "x-nullable": true,
+          "example": 30
+        },
         "updated_at": {
           "description": "The UTC date and timestamp when the resource was created.",
           "type": "string",
@@ -2169,6 +3153,13 @@ func init() {
           "format": "uuid",
           "readOnly": true
         },
+        "last_error_at": {
+          "description": "The UTC date and timestamp of the last provisioning failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "name": {
           "description": "Human-readable name of the resource.",
           "type": "string",
@@ -2188,10 +3179,18 @@ func init() {
           "type": "string",
           "enum": [
             "akamai",
-            "f5"
+            "f5",
+            "dns",
+            "noop"
           ],
           "example": "akamai"
         },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure reported by the agent, cleared once provisioned.",
+          "type": "string",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "provisioning_status": {
           "type": "string",
           "enum": [
@@ -2222,6 +3221,42 @@ func init() {
         }
       }
     },
+    "imported_resource": {
+      "description": "A resource of an imported configuration document.",
+      "type": "object",
+      "properties": {
+        "action": {
+          "description": "Whether the resource was created, updated or an existing public datacenter was referenced.",
+          "type": "string",
+          "enum": [
+            "created",
+            "updated",
+            "referenced"
+          ]
+        },
+        "id": {
+          "description": "The id of the resource in the project.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "resource_type": {
+          "type": "string",
+          "enum": [
+            "domain",
+            "pool",
+            "member",
+            "monitor",
+            "datacenter",
+            "geomap"
+          ]
+        },
+        "source_id": {
+          "description": "The id of the resource within the document.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
     "link": {
       "type": "object",
       "properties": {
@@ -2243,7 +3278,7 @@ func init() {
       "type": "object",
       "properties": {
         "address": {
-          "description": "Address to use. Can also be a domain name.",
+          "description": "Address to use, IPv4 or IPv6. Must be a domain name if the pool is associated with CNAME or MX domains, which is not supported for other F5 domains. IP addresses must be of an address family handed out by a domain of the pool.",
           "type": "string",
           "x-nullable": true,
           "example": "1.2.3.4"
@@ -2267,12 +3302,25 @@ func init() {
           "format": "uuid",
           "x-nullable": true
         },
+        "flapping": {
+          "description": "True if the status of the member changed frequently within the flap detection window.",
+          "type": "boolean",
+          "readOnly": true,
+          "example": false
+        },
         "id": {
           "description": "The id of the resource.",
           "type": "string",
           "format": "uuid",
           "readOnly": true
         },
+        "last_error_at": {
+          "description": "The UTC date and timestamp of the last provisioning failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "name": {
           "description": "Human-readable name of the resource.",
           "type": "string",
@@ -2300,6 +3348,12 @@ func init() {
           "x-nullable": true,
           "example": "fa84c217f361441986a220edf9b1e337"
         },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure reported by the agent, cleared once provisioned.",
+          "type": "string",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "provisioning_status": {
           "type": "string",
           "enum": [
@@ -2326,6 +3380,36 @@ func init() {
           "format": "date-time",
           "readOnly": true,
           "example": "2020-09-09T14:52:15"
+        },
+        "weight": {
+          "description": "Relative weight of the member within its pool, used for load balancing if the domain mode is WEIGHTED. A weight of 0 disables traffic to this member.",
+          "type": "integer",
+          "default": 1,
+          "maximum": 100,
+          "x-nullable": true,
+          "example": 10
+        }
+      }
+    },
+    "member_status_transition": {
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "description": "The UTC date and timestamp of the transition.",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true,
+          "example": "2020-05-11T17:21:34"
+        },
+        "previous_status": {
+          "description": "Status of the member before the transition.",
+          "type": "string",
+          "example": "ONLINE"
+        },
+        "status": {
+          "description": "Status of the member after the transition.",
+          "type": "string",
+          "example": "OFFLINE"
         }
       }
     },
@@ -2380,7 +3464,14 @@ func init() {
           "maximum": 86399,
           "minimum": 10,
           "x-nullable": true,
-          "example": 10
+          "example": 10
+        },
+        "last_error_at": {
+          "description": "The UTC date and timestamp of the last provisioning failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
         },
         "name": {
           "description": "Human-readable name of the resource.",
@@ -2402,6 +3493,12 @@ func init() {
           "x-nullable": true,
           "example": "fa84c217f361441986a220edf9b1e337"
         },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure reported by the agent, cleared once provisioned.",
+          "type": "string",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "provisioning_status": {
           "type": "string",
           "enum": [
@@ -2474,7 +3571,7 @@ func init() {
           "example": "2020-05-11 17:21:34"
         },
         "domains": {
-          "description": "Array of domains assigned to this pool",
+          "description": "Array of domains assigned to this pool, the pool is appended to the pools of newly assigned domains with the lowest priority",
           "type": "array",
           "items": {
             "description": "Domain ID",
@@ -2488,6 +3585,13 @@ func init() {
           "format": "uuid",
           "readOnly": true
         },
+        "last_error_at": {
+          "description": "The UTC date and timestamp of the last provisioning failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "members": {
           "description": "Array of member ids that this pool uses for load balancing.",
           "type": "array",
@@ -2520,6 +3624,12 @@ func init() {
           "x-nullable": true,
           "example": "fa84c217f361441986a220edf9b1e337"
         },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure reported by the agent, cleared once provisioned.",
+          "type": "string",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "provisioning_status": {
           "type": "string",
           "enum": [
@@ -2532,10 +3642,14 @@ func init() {
           "readOnly": true
         },
         "status": {
+          "description": "Operating status aggregated from the status of all enabled members of the pool.",
           "type": "string",
           "enum": [
             "ONLINE",
-            "DOWN"
+            "DEGRADED",
+            "OFFLINE",
+            "NO_MONITOR",
+            "UNKNOWN"
           ],
           "readOnly": true
         },
@@ -2563,6 +3677,12 @@ func init() {
           "x-nullable": true,
           "example": 5
         },
+        "domain_dns": {
+          "description": "The configured domain quota limit for provider DNS. A setting of null means it is using the deployment default quota. A setting of -1 means unlimited.",
+          "type": "integer",
+          "x-nullable": true,
+          "example": 5
+        },
         "domain_f5": {
           "description": "The configured domain quota limit for provider F5. A setting of null means it is using the deployment default quota. A setting of -1 means unlimited.",
           "type": "integer",
@@ -2604,6 +3724,12 @@ func init() {
           "x-omitempty": false,
           "example": 5
         },
+        "in_use_domain_dns": {
+          "description": "The current quota usage of domain (provider = DNS).",
+          "type": "integer",
+          "x-omitempty": false,
+          "example": 5
+        },
         "in_use_domain_f5": {
           "description": "The current quota usage of domain (provider = F5).",
           "type": "integer",
@@ -2650,6 +3776,12 @@ func init() {
           "type": "string",
           "example": "andromeda-agent-fbb49979-03f5-4a97-a334-1fd2c9f61e7e"
         },
+        "leader": {
+          "description": "Whether the service replica is the elected leader of its agent, not set for services without leader election.",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": true
+        },
         "metadata": {
           "type": "object"
         },
@@ -2674,6 +3806,51 @@ func init() {
           "example": "1.2.3"
         }
       }
+    },
+    "stuck_resource": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "description": "The id of the resource.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "project_id": {
+          "description": "The ID of the project owning this resource.",
+          "type": "string"
+        },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "provisioning_status": {
+          "type": "string",
+          "enum": [
+            "PENDING_CREATE",
+            "PENDING_UPDATE",
+            "PENDING_DELETE",
+            "ACTIVE",
+            "ERROR"
+          ]
+        },
+        "resource_type": {
+          "type": "string",
+          "enum": [
+            "domain",
+            "pool",
+            "member",
+            "monitor",
+            "datacenter",
+            "geomap"
+          ]
+        },
+        "updated_at": {
+          "description": "The UTC date and timestamp when the resource was last updated.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
     }
   },
   "parameters": {
@@ -2727,6 +3904,10 @@ func init() {
     {
       "description": "Administrative API",
       "name": "Administrative"
+    },
+    {
+      "description": "Export and import of the configuration of a project.",
+      "name": "Configuration"
     }
   ]
 }`))
@@ -2745,6 +3926,38 @@ func init() {
   },
   "basePath": "/v1",
   "paths": {
+    "/agents": {
+      "get": {
+        "description": "Lists the agents registered by their heartbeats. Agents without heartbeat within the agent TTL, or disabled\nby an administrator, are STALE.\n",
+        "tags": [
+          "Administrative"
+        ],
+        "summary": "List agents",
+        "responses": {
+          "200": {
+            "description": "A JSON array of agents",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "agents": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/agent"
+                  }
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:agent:get_all"
+      }
+    },
     "/cidr-blocks": {
       "get": {
         "tags": [
@@ -3046,6 +4259,57 @@ func init() {
         }
       ]
     },
+    "/datacenters/{datacenter_id}/retry": {
+      "post": {
+        "description": "Moves a datacenter with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.",
+        "tags": [
+          "Datacenters"
+        ],
+        "summary": "Retry provisioning of a datacenter",
+        "responses": {
+          "202": {
+            "description": "The datacenter will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "datacenter": {
+                  "$ref": "#/definitions/datacenter"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:datacenter:retry"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the datacenter",
+          "name": "datacenter_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/domains": {
       "get": {
         "tags": [
@@ -3268,28 +4532,197 @@ func init() {
             }
           }
         },
-        "x-policy": "andromeda:domain:put"
+        "x-policy": "andromeda:domain:put"
+      },
+      "delete": {
+        "tags": [
+          "Domains"
+        ],
+        "summary": "Delete a domain",
+        "responses": {
+          "204": {
+            "description": "Resource successfully deleted."
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:domain:delete"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the domain",
+          "name": "domain_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/domains/{domain_id}/restore": {
+      "post": {
+        "description": "Restores a deleted domain together with its pool relations, as long as it has not been purged after the restore window (api_settings.domain_restore_window) elapsed. Restored domains count against the domain quota again.",
+        "tags": [
+          "Domains"
+        ],
+        "summary": "Restore a deleted domain",
+        "responses": {
+          "202": {
+            "description": "The domain will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "domain": {
+                  "$ref": "#/definitions/domain"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:domain:restore"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the domain",
+          "name": "domain_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/domains/{domain_id}/retry": {
+      "post": {
+        "description": "Moves a domain with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.",
+        "tags": [
+          "Domains"
+        ],
+        "summary": "Retry provisioning of a domain",
+        "responses": {
+          "202": {
+            "description": "The domain will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "domain": {
+                  "$ref": "#/definitions/domain"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:domain:retry"
       },
-      "delete": {
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the domain",
+          "name": "domain_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/export": {
+      "get": {
+        "description": "Serialises all domains, pools, members, monitors, datacenters and geographic maps of a project into one versioned document. Resources reference each other by their ids within the document, public datacenters used by the project are included.",
         "tags": [
-          "Domains"
+          "Configuration"
+        ],
+        "summary": "Export the configuration of a project",
+        "parameters": [
+          {
+            "type": "string",
+            "x-nullable": true,
+            "description": "Project to export, defaults to the project of the token.",
+            "name": "project_id",
+            "in": "query"
+          }
         ],
-        "summary": "Delete a domain",
         "responses": {
-          "204": {
-            "description": "Resource successfully deleted."
-          },
-          "404": {
-            "description": "Not Found",
+          "200": {
+            "description": "The configuration of the project.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/configuration"
             }
           },
-          "409": {
-            "description": "Conflict",
+          "default": {
+            "description": "Unexpected Error",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        },
+        "x-policy": "andromeda:export:get"
+      }
+    },
+    "/f5/diff": {
+      "get": {
+        "description": "Builds the AS3 declaration of all F5 domains (dry-run) and returns its difference to the declaration\ncurrently deployed on the active F5 device per tenant and application. Nothing is applied.\n",
+        "tags": [
+          "Administrative"
+        ],
+        "summary": "Preview the F5 AS3 declaration",
+        "responses": {
+          "200": {
+            "description": "Difference between the deployed and the desired AS3 declaration.",
+            "schema": {
+              "$ref": "#/definitions/declaration_diff"
+            }
           },
           "default": {
             "description": "Unexpected Error",
@@ -3298,18 +4731,8 @@ func init() {
             }
           }
         },
-        "x-policy": "andromeda:domain:delete"
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "format": "uuid",
-          "description": "The UUID of the domain",
-          "name": "domain_id",
-          "in": "path",
-          "required": true
-        }
-      ]
+        "x-policy": "andromeda:f5:diff"
+      }
     },
     "/geomaps": {
       "get": {
@@ -3578,6 +5001,118 @@ func init() {
         }
       ]
     },
+    "/geomaps/{geomap_id}/retry": {
+      "post": {
+        "description": "Moves a geographic map with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.",
+        "tags": [
+          "Geographic maps"
+        ],
+        "summary": "Retry provisioning of a geographic map",
+        "responses": {
+          "202": {
+            "description": "The geographic map will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "geomap": {
+                  "$ref": "#/definitions/geomap"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:geomap:retry"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the geomap",
+          "name": "geomap_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/import": {
+      "post": {
+        "description": "Creates or updates the resources of an exported configuration document in a project. Domains are matched by fqdn and provider, members by address and port within their pool, all other resources by name or, if unnamed, by their id. Public datacenters are referenced instead of copied if they exist.",
+        "tags": [
+          "Configuration"
+        ],
+        "summary": "Import the configuration of a project",
+        "parameters": [
+          {
+            "type": "string",
+            "x-nullable": true,
+            "description": "Project to import into, defaults to the project of the token.",
+            "name": "project_id",
+            "in": "query"
+          },
+          {
+            "name": "configuration",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/configuration"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The resources of the document and what the import did with them.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "resources": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/imported_resource"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "Quota exceeded",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:import:post"
+      }
+    },
     "/members": {
       "get": {
         "tags": [
@@ -3793,23 +5328,146 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "default": {
-            "description": "Unexpected Error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:member:put"
+      },
+      "delete": {
+        "tags": [
+          "Members"
+        ],
+        "summary": "Delete a member",
+        "responses": {
+          "204": {
+            "description": "Resource successfully deleted."
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:member:delete"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the member",
+          "name": "member_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/members/{member_id}/retry": {
+      "post": {
+        "description": "Moves a member with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.",
+        "tags": [
+          "Members"
+        ],
+        "summary": "Retry provisioning of a member",
+        "responses": {
+          "202": {
+            "description": "The member will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "member": {
+                  "$ref": "#/definitions/member"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:member:retry"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the member",
+          "name": "member_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/members/{member_id}/status-history": {
+      "get": {
+        "description": "Lists the status transitions of a member, newest first.",
+        "tags": [
+          "Members"
+        ],
+        "summary": "Show member status history",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "date-time",
+            "x-nullable": true,
+            "description": "Only transitions at or after this UTC date and timestamp.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "x-nullable": true,
+            "description": "Only transitions before this UTC date and timestamp.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Sets the page size.",
+            "name": "limit",
+            "in": "query"
           }
-        },
-        "x-policy": "andromeda:member:put"
-      },
-      "delete": {
-        "tags": [
-          "Members"
         ],
-        "summary": "Delete a member",
         "responses": {
-          "204": {
-            "description": "Resource successfully deleted."
+          "200": {
+            "description": "A JSON array of status transitions.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "status_history": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/member_status_transition"
+                  }
+                }
+              }
+            }
           },
           "404": {
             "description": "Not Found",
@@ -3824,7 +5482,7 @@ func init() {
             }
           }
         },
-        "x-policy": "andromeda:member:delete"
+        "x-policy": "andromeda:member:get_status_history"
       },
       "parameters": [
         {
@@ -3916,7 +5574,7 @@ func init() {
         "x-policy": "andromeda:monitor:get_all"
       },
       "post": {
-        "description": "### F5 monitors\n\nAndromeda pool monitors are mapped to [F5 monitors][f5-monitors] based on the ` + "`" + `type` + "`" + ` property as follows:\n\n[f5-monitors]: \u003chttps://clouddocs.f5.com/products/extensions/f5-appsvcs-extension/latest/refguide/schemaref/Monitor.schema.json.html\u003e\n\n| Andromeda | F5             |\n|-----------|----------------|\n| ` + "`" + `HTTP` + "`" + `    | ` + "`" + `http` + "`" + `         |\n| ` + "`" + `HTTPS` + "`" + `   | ` + "`" + `https` + "`" + `        |\n| ` + "`" + `ICMP` + "`" + `    | ` + "`" + `gateway-icmp` + "`" + ` |\n| ` + "`" + `TCP` + "`" + `     | ` + "`" + `tcp` + "`" + `          |\n| ` + "`" + `UDP` + "`" + `     | ` + "`" + `udp` + "`" + `          |\n\nAndromeda monitor properties map to an F5 ` + "`" + `Monitor` + "`" + ` as follows:\n\n| Andromeda     | F5 ` + "`" + `Monitor` + "`" + ` object   | Comments                                       |\n|---------------|-----------------------|------------------------------------------------|\n| ` + "`" + `domain_name` + "`" + ` | -                     | Unsupported by F5/AS3                          |\n| ` + "`" + `http_method` + "`" + ` | -                     | Unsupported by F5/AS3 (see caveats below)      |\n| ` + "`" + `interval` + "`" + `    | ` + "`" + `interval` + "`" + `            |                                                |\n| ` + "`" + `receive` + "`" + `     | ` + "`" + `receive` + "`" + `             | ` + "`" + `HTTP` + "`" + ` / ` + "`" + `HTTPS` + "`" + ` only                          |\n| ` + "`" + `send` + "`" + `        | ` + "`" + `send` + "`" + `                | ` + "`" + `HTTP` + "`" + ` / ` + "`" + `HTTPS` + "`" + ` only                          |\n| ` + "`" + `timeout` + "`" + `     | ` + "`" + `timeout` + "`" + `             |                                                |\n| ` + "`" + `type` + "`" + `        | ` + "`" + `monitorType` + "`" + `         |                                                |\n\nCaveats:\n\n* For HTTP/S monitors, F5 decides on the HTTP method, URL path and HTTP version according to ` + "`" + `Monitor.send` + "`" + `. For details please refer to the [Monitor_HTTP object][monitor-http-object].\n\n[monitor-http-object]: \u003chttps://clouddocs.f5.com/products/extensions/f5-appsvcs-extension/latest/refguide/schemaref/Monitor.schema.json.html#monitor-http-object\u003e",
+        "description": "### F5 monitors\n\nAndromeda pool monitors are mapped to [F5 monitors][f5-monitors] based on the ` + "`" + `type` + "`" + ` property as follows:\n\n[f5-monitors]: \u003chttps://clouddocs.f5.com/products/extensions/f5-appsvcs-extension/latest/refguide/schemaref/Monitor.schema.json.html\u003e\n\n| Andromeda | F5             |\n|-----------|----------------|\n| ` + "`" + `HTTP` + "`" + `    | ` + "`" + `http` + "`" + `         |\n| ` + "`" + `HTTPS` + "`" + `   | ` + "`" + `https` + "`" + `        |\n| ` + "`" + `ICMP` + "`" + `    | ` + "`" + `gateway-icmp` + "`" + ` |\n| ` + "`" + `TCP` + "`" + `     | ` + "`" + `tcp` + "`" + `          |\n| ` + "`" + `UDP` + "`" + `     | ` + "`" + `udp` + "`" + `          |\n\nAndromeda monitor properties map to an F5 ` + "`" + `GSLB_Monitor` + "`" + ` as follows:\n\n| Andromeda     | F5 ` + "`" + `GSLB_Monitor` + "`" + ` object | Comments                                       |\n|---------------|--------------------------|------------------------------------------------|\n| ` + "`" + `domain_name` + "`" + ` | -                        | Unsupported by F5/AS3                          |\n| ` + "`" + `http_method` + "`" + ` | -                        | Unsupported by F5/AS3 (see caveats below)      |\n| ` + "`" + `interval` + "`" + `    | ` + "`" + `interval` + "`" + `               |                                                |\n| ` + "`" + `receive` + "`" + `     | ` + "`" + `receive` + "`" + `                | ` + "`" + `HTTP` + "`" + ` / ` + "`" + `HTTPS` + "`" + ` only                          |\n| ` + "`" + `send` + "`" + `        | ` + "`" + `send` + "`" + `                   | ` + "`" + `HTTP` + "`" + ` / ` + "`" + `HTTPS` + "`" + ` only                          |\n| ` + "`" + `timeout` + "`" + `     | ` + "`" + `probeTimeout` + "`" + `           |                                                |\n| ` + "`" + `type` + "`" + `        | ` + "`" + `monitorType` + "`" + `            |                                                |\n\nCaveats:\n\n* For HTTP/S monitors, F5 decides on the HTTP method, URL path and HTTP version according to ` + "`" + `Monitor.send` + "`" + `. For details please refer to the [Monitor_HTTP object][monitor-http-object].\n\n[monitor-http-object]: \u003chttps://clouddocs.f5.com/products/extensions/f5-appsvcs-extension/latest/refguide/schemaref/Monitor.schema.json.html#monitor-http-object\u003e",
         "tags": [
           "Monitors"
         ],
@@ -4097,6 +5755,57 @@ func init() {
         }
       ]
     },
+    "/monitors/{monitor_id}/retry": {
+      "post": {
+        "description": "Moves a monitor with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.",
+        "tags": [
+          "Monitors"
+        ],
+        "summary": "Retry provisioning of a monitor",
+        "responses": {
+          "202": {
+            "description": "The monitor will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "monitor": {
+                  "$ref": "#/definitions/monitor"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:monitor:retry"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the monitor",
+          "name": "monitor_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/pools": {
       "get": {
         "tags": [
@@ -4364,6 +6073,57 @@ func init() {
         }
       ]
     },
+    "/pools/{pool_id}/retry": {
+      "post": {
+        "description": "Moves a pool with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.",
+        "tags": [
+          "Pools"
+        ],
+        "summary": "Retry provisioning of a pool",
+        "responses": {
+          "202": {
+            "description": "The pool will be provisioned again.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "pool": {
+                  "$ref": "#/definitions/pool"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:pool:retry"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the pool",
+          "name": "pool_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/quotas": {
       "get": {
         "tags": [
@@ -4551,6 +6311,152 @@ func init() {
         }
       ]
     },
+    "/reset": {
+      "get": {
+        "description": "Lists resources in a pending provisioning status or in ERROR which have not been updated within the threshold.",
+        "tags": [
+          "Administrative"
+        ],
+        "summary": "List stuck resources",
+        "parameters": [
+          {
+            "minimum": 0,
+            "type": "integer",
+            "default": 3600,
+            "description": "Threshold in seconds since the last update of the resource.",
+            "name": "older_than",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "domain",
+              "pool",
+              "member",
+              "monitor",
+              "datacenter",
+              "geomap"
+            ],
+            "type": "string",
+            "x-nullable": true,
+            "description": "Only list resources of this type.",
+            "name": "resource_type",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A JSON array of stuck resources.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "resources": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/stuck_resource"
+                  }
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:reset:get_all"
+      }
+    },
+    "/reset/{resource_type}/{resource_id}": {
+      "post": {
+        "description": "Requeues the resource for provisioning (ERROR resources become PENDING_UPDATE), or forces the provisioning\nstatus to ACTIVE or ERROR.\n",
+        "tags": [
+          "Administrative"
+        ],
+        "summary": "Reset the provisioning status of a resource",
+        "parameters": [
+          {
+            "name": "reset",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "action"
+              ],
+              "properties": {
+                "action": {
+                  "description": "The reset action.",
+                  "type": "string",
+                  "enum": [
+                    "requeue",
+                    "active",
+                    "error"
+                  ]
+                },
+                "reason": {
+                  "description": "The provisioning error recorded for action error.",
+                  "type": "string",
+                  "maxLength": 255
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The resource has been reset.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "resource": {
+                  "$ref": "#/definitions/stuck_resource"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Unexpected Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        },
+        "x-policy": "andromeda:reset:post"
+      },
+      "parameters": [
+        {
+          "enum": [
+            "domain",
+            "pool",
+            "member",
+            "monitor",
+            "datacenter",
+            "geomap"
+          ],
+          "type": "string",
+          "description": "The type of the resource",
+          "name": "resource_type",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "The UUID of the resource",
+          "name": "resource_id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/services": {
       "get": {
         "tags": [
@@ -4584,10 +6490,11 @@ func init() {
     },
     "/sync": {
       "post": {
+        "description": "Requests a sync of the given domains from the agents of their providers, a full sync is requested if no\ndomains are given. The F5 agent replies once the sync is completed, errors are returned to the caller.\nUnknown domains are rejected.\n",
         "tags": [
           "Administrative"
         ],
-        "summary": "Enqueue a full sync",
+        "summary": "Sync domains",
         "parameters": [
           {
             "name": "domains",
@@ -4613,7 +6520,7 @@ func init() {
         ],
         "responses": {
           "202": {
-            "description": "Full sync has been enqueued."
+            "description": "Sync has been enqueued or completed."
           },
           "default": {
             "description": "Unexpected Error",
@@ -4627,6 +6534,69 @@ func init() {
     }
   },
   "definitions": {
+    "DeclarationDiffTenantsItems0": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "delete"
+          ]
+        },
+        "applications": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DeclarationDiffTenantsItems0ApplicationsItems0"
+          }
+        },
+        "tenant": {
+          "type": "string",
+          "example": "domain_a9b3c3e4-8e6b-4c2b-9f5e-2f1b3c4d5e6f"
+        }
+      }
+    },
+    "DeclarationDiffTenantsItems0ApplicationsItems0": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "delete"
+          ]
+        },
+        "application": {
+          "type": "string",
+          "example": "application"
+        },
+        "changes": {
+          "description": "Changed properties of updated applications.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DeclarationDiffTenantsItems0ApplicationsItems0ChangesItems0"
+          }
+        }
+      }
+    },
+    "DeclarationDiffTenantsItems0ApplicationsItems0ChangesItems0": {
+      "type": "object",
+      "properties": {
+        "deployed": {
+          "description": "Deployed value, absent if the property is added."
+        },
+        "desired": {
+          "description": "Desired value, absent if the property is removed."
+        },
+        "path": {
+          "description": "JSON pointer of the changed property.",
+          "type": "string",
+          "example": "/domain_a9b3c3e4-8e6b-4c2b-9f5e-2f1b3c4d5e6f/application/domain/poolLbMode"
+        }
+      }
+    },
     "GeomapAssignmentsItems0": {
       "description": "Assignment.",
       "type": "object",
@@ -4663,6 +6633,126 @@ func init() {
         }
       ]
     },
+    "agent": {
+      "type": "object",
+      "properties": {
+        "admin_state_up": {
+          "description": "The administrative state of the agent, disabled agents skip their work until enabled again.",
+          "type": "boolean",
+          "example": true
+        },
+        "capabilities": {
+          "description": "Capabilities of the agent, domains can only be provisioned by agents capable to sync.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "sync",
+            "member_status"
+          ]
+        },
+        "heartbeat": {
+          "description": "The UTC date and timestamp of the last heartbeat.",
+          "type": "string",
+          "format": "date-time",
+          "example": "2020-05-11 17:21:34"
+        },
+        "host": {
+          "description": "Hostname of the computer the agent is running.",
+          "type": "string",
+          "example": "example.host"
+        },
+        "last_sync": {
+          "description": "The UTC date and timestamp of the last successful sync.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "example": "2020-05-11 17:21:34"
+        },
+        "providers": {
+          "description": "Providers this agent supports.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "akamai"
+          ]
+        },
+        "status": {
+          "description": "ALIVE if the agent is enabled and sent a heartbeat within the agent TTL.",
+          "type": "string",
+          "enum": [
+            "ALIVE",
+            "STALE"
+          ],
+          "example": "ALIVE"
+        },
+        "type": {
+          "description": "Type of agent.",
+          "type": "string",
+          "example": "andromeda-akamai-agent"
+        },
+        "version": {
+          "description": "Version of the agent.",
+          "type": "string",
+          "example": "1.2.3"
+        }
+      }
+    },
+    "configuration": {
+      "description": "Versioned configuration of a project, resources reference each other by their ids within the document.",
+      "type": "object",
+      "required": [
+        "version"
+      ],
+      "properties": {
+        "datacenters": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/datacenter"
+          }
+        },
+        "domains": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/domain"
+          }
+        },
+        "geomaps": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/geomap"
+          }
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/member"
+          }
+        },
+        "monitors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/monitor"
+          }
+        },
+        "pools": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pool"
+          }
+        },
+        "version": {
+          "description": "Version of the document format.",
+          "type": "integer",
+          "enum": [
+            1
+          ]
+        }
+      }
+    },
     "datacenter": {
       "type": "object",
       "properties": {
@@ -4709,6 +6799,13 @@ func init() {
           "format": "uuid",
           "readOnly": true
         },
+        "last_error_at": {
+          "description": "The UTC date and timestamp of the last provisioning failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "latitude": {
           "type": "number",
           "format": "double",
@@ -4748,10 +6845,18 @@ func init() {
           "type": "string",
           "enum": [
             "akamai",
-            "f5"
+            "f5",
+            "dns",
+            "noop"
           ],
           "example": "akamai"
         },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure reported by the agent, cleared once provisioned.",
+          "type": "string",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "provisioning_status": {
           "type": "string",
           "enum": [
@@ -4790,6 +6895,18 @@ func init() {
         }
       }
     },
+    "declaration_diff": {
+      "type": "object",
+      "properties": {
+        "tenants": {
+          "description": "Tenants to be created, updated or deleted, unchanged tenants are omitted.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DeclarationDiffTenantsItems0"
+          }
+        }
+      }
+    },
     "domain": {
       "description": "A representation of a domain",
       "type": "object",
@@ -4800,6 +6917,7 @@ func init() {
           "default": true
         },
         "aliases": {
+          "description": "Additional hostnames resolving like the FQDN. F5 aliases may contain the wildcards * and ?, Akamai serves each alias by an additional property.",
           "type": "array",
           "items": {
             "type": "string",
@@ -4822,6 +6940,31 @@ func init() {
           "readOnly": true,
           "example": "2020-05-11T17:21:34"
         },
+        "deleted_at": {
+          "description": "The UTC date and timestamp when the domain was deleted, it can be restored until the restore window elapsed.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "failback_delay": {
+          "description": "Seconds a datacenter has to be up again before traffic fails back to it. Only supported by Akamai.",
+          "type": "integer",
+          "default": 0,
+          "maximum": 3600,
+          "minimum": 0,
+          "x-nullable": true,
+          "example": 0
+        },
+        "failover_delay": {
+          "description": "Seconds a datacenter has to be down before traffic fails over to another datacenter. Only supported by Akamai.",
+          "type": "integer",
+          "default": 0,
+          "maximum": 3600,
+          "minimum": 0,
+          "x-nullable": true,
+          "example": 0
+        },
         "fqdn": {
           "description": "Desired Fully-Qualified Host Name.",
           "type": "string",
@@ -4830,12 +6973,28 @@ func init() {
           "x-nullable": true,
           "example": "example.org"
         },
+        "handout_limit": {
+          "description": "Maximum number of addresses handed out per answer, 0 hands out all live addresses. Akamai supports up to 8 addresses.",
+          "type": "integer",
+          "default": 0,
+          "maximum": 500,
+          "minimum": 0,
+          "x-nullable": true,
+          "example": 0
+        },
         "id": {
           "description": "The id of the resource.",
           "type": "string",
           "format": "uuid",
           "readOnly": true
         },
+        "last_error_at": {
+          "description": "The UTC date and timestamp of the last provisioning failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "mode": {
           "description": "Load balancing method to use for the references pools.",
           "type": "string",
@@ -4853,6 +7012,7 @@ func init() {
           "x-nullable": true
         },
         "pools": {
+          "description": "Pools that this domain uses for load balancing, in order of priority. AVAILABILITY domains hand out the first pool with available members and fall back to the following pools in order, on Akamai only with availability_failover enabled. Pools assigned via the domains of a pool are appended with the lowest priority.",
           "type": "array",
           "items": {
             "description": "Pool that this domain uses for load balancing.",
@@ -4873,11 +7033,19 @@ func init() {
           "type": "string",
           "enum": [
             "akamai",
-            "f5"
+            "f5",
+            "dns",
+            "noop"
           ],
           "x-nullable": true,
           "example": "akamai"
         },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure reported by the agent, cleared once provisioned.",
+          "type": "string",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "provisioning_status": {
           "type": "string",
           "enum": [
@@ -4885,12 +7053,13 @@ func init() {
             "PENDING_UPDATE",
             "PENDING_DELETE",
             "ACTIVE",
-            "ERROR"
+            "ERROR",
+            "DELETED"
           ],
           "readOnly": true
         },
         "record_type": {
-          "description": "DNS Record type to use.",
+          "description": "DNS Record type to use. F5 domains of type A or AAAA are dual-stack and hand out IPv4 members as A and IPv6 members as AAAA records, Akamai domains only hand out members of the address family of their record type. CNAME and MX domains hand out the hostnames of their members, MX is not supported by Akamai.",
           "type": "string",
           "default": "A",
           "enum": [
@@ -4901,13 +7070,26 @@ func init() {
           ]
         },
         "status": {
+          "description": "Operating status aggregated from the status of all enabled pools of the domain.",
           "type": "string",
           "enum": [
             "ONLINE",
-            "DOWN"
+            "DEGRADED",
+            "OFFLINE",
+            "NO_MONITOR",
+            "UNKNOWN"
           ],
           "readOnly": true
         },
+        "ttl": {
+          "description": "Time to live, in seconds, of the handed out records. Akamai supports 30 to 3600 seconds, F5 at least 1 second.",
+          "type": "integer",
+          "default": 30,
+          "maximum": 86400,
+          "minimum": 0,
+          "x-nullable": true,
+          "example": 30
+        },
         "updated_at": {
           "description": "The UTC date and timestamp when the resource was created.",
           "type": "string",
@@ -4962,6 +7144,13 @@ func init() {
           "format": "uuid",
           "readOnly": true
         },
+        "last_error_at": {
+          "description": "The UTC date and timestamp of the last provisioning failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "name": {
           "description": "Human-readable name of the resource.",
           "type": "string",
@@ -4981,10 +7170,18 @@ func init() {
           "type": "string",
           "enum": [
             "akamai",
-            "f5"
+            "f5",
+            "dns",
+            "noop"
           ],
           "example": "akamai"
         },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure reported by the agent, cleared once provisioned.",
+          "type": "string",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "provisioning_status": {
           "type": "string",
           "enum": [
@@ -5015,6 +7212,42 @@ func init() {
         }
       }
     },
+    "imported_resource": {
+      "description": "A resource of an imported configuration document.",
+      "type": "object",
+      "properties": {
+        "action": {
+          "description": "Whether the resource was created, updated or an existing public datacenter was referenced.",
+          "type": "string",
+          "enum": [
+            "created",
+            "updated",
+            "referenced"
+          ]
+        },
+        "id": {
+          "description": "The id of the resource in the project.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "resource_type": {
+          "type": "string",
+          "enum": [
+            "domain",
+            "pool",
+            "member",
+            "monitor",
+            "datacenter",
+            "geomap"
+          ]
+        },
+        "source_id": {
+          "description": "The id of the resource within the document.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
     "link": {
       "type": "object",
       "properties": {
@@ -5036,7 +7269,7 @@ func init() {
       "type": "object",
       "properties": {
         "address": {
-          "description": "Address to use. Can also be a domain name.",
+          "description": "Address to use, IPv4 or IPv6. Must be a domain name if the pool is associated with CNAME or MX domains, which is not supported for other F5 domains. IP addresses must be of an address family handed out by a domain of the pool.",
           "type": "string",
           "x-nullable": true,
           "example": "1.2.3.4"
@@ -5060,12 +7293,25 @@ func init() {
           "format": "uuid",
           "x-nullable": true
         },
+        "flapping": {
+          "description": "True if the status of the member changed frequently within the flap detection window.",
+          "type": "boolean",
+          "readOnly": true,
+          "example": false
+        },
         "id": {
           "description": "The id of the resource.",
           "type": "string",
           "format": "uuid",
           "readOnly": true
         },
+        "last_error_at": {
+          "description": "The UTC date and timestamp of the last provisioning failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "name": {
           "description": "Human-readable name of the resource.",
           "type": "string",
@@ -5094,6 +7340,12 @@ func init() {
           "x-nullable": true,
           "example": "fa84c217f361441986a220edf9b1e337"
         },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure reported by the agent, cleared once provisioned.",
+          "type": "string",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "provisioning_status": {
           "type": "string",
           "enum": [
@@ -5120,6 +7372,37 @@ func init() {
           "format": "date-time",
           "readOnly": true,
           "example": "2020-09-09T14:52:15"
+        },
+        "weight": {
+          "description": "Relative weight of the member within its pool, used for load balancing if the domain mode is WEIGHTED. A weight of 0 disables traffic to this member.",
+          "type": "integer",
+          "default": 1,
+          "maximum": 100,
+          "minimum": 0,
+          "x-nullable": true,
+          "example": 10
+        }
+      }
+    },
+    "member_status_transition": {
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "description": "The UTC date and timestamp of the transition.",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true,
+          "example": "2020-05-11T17:21:34"
+        },
+        "previous_status": {
+          "description": "Status of the member before the transition.",
+          "type": "string",
+          "example": "ONLINE"
+        },
+        "status": {
+          "description": "Status of the member after the transition.",
+          "type": "string",
+          "example": "OFFLINE"
         }
       }
     },
@@ -5176,6 +7459,13 @@ func init() {
           "x-nullable": true,
           "example": 10
         },
+        "last_error_at": {
+          "description": "The UTC date and timestamp of the last provisioning failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "name": {
           "description": "Human-readable name of the resource.",
           "type": "string",
@@ -5196,6 +7486,12 @@ func init() {
           "x-nullable": true,
           "example": "fa84c217f361441986a220edf9b1e337"
         },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure reported by the agent, cleared once provisioned.",
+          "type": "string",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "provisioning_status": {
           "type": "string",
           "enum": [
@@ -5269,7 +7565,7 @@ func init() {
           "example": "2020-05-11 17:21:34"
         },
         "domains": {
-          "description": "Array of domains assigned to this pool",
+          "description": "Array of domains assigned to this pool, the pool is appended to the pools of newly assigned domains with the lowest priority",
           "type": "array",
           "items": {
             "description": "Domain ID",
@@ -5283,6 +7579,13 @@ func init() {
           "format": "uuid",
           "readOnly": true
         },
+        "last_error_at": {
+          "description": "The UTC date and timestamp of the last provisioning failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "members": {
           "description": "Array of member ids that this pool uses for load balancing.",
           "type": "array",
@@ -5315,6 +7618,12 @@ func init() {
           "x-nullable": true,
           "example": "fa84c217f361441986a220edf9b1e337"
         },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure reported by the agent, cleared once provisioned.",
+          "type": "string",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "provisioning_status": {
           "type": "string",
           "enum": [
@@ -5327,10 +7636,14 @@ func init() {
           "readOnly": true
         },
         "status": {
+          "description": "Operating status aggregated from the status of all enabled members of the pool.",
           "type": "string",
           "enum": [
             "ONLINE",
-            "DOWN"
+            "DEGRADED",
+            "OFFLINE",
+            "NO_MONITOR",
+            "UNKNOWN"
           ],
           "readOnly": true
         },
@@ -5358,6 +7671,12 @@ func init() {
           "x-nullable": true,
           "example": 5
         },
+        "domain_dns": {
+          "description": "The configured domain quota limit for provider DNS. A setting of null means it is using the deployment default quota. A setting of -1 means unlimited.",
+          "type": "integer",
+          "x-nullable": true,
+          "example": 5
+        },
         "domain_f5": {
           "description": "The configured domain quota limit for provider F5. A setting of null means it is using the deployment default quota. A setting of -1 means unlimited.",
           "type": "integer",
@@ -5399,6 +7718,12 @@ func init() {
           "x-omitempty": false,
           "example": 5
         },
+        "in_use_domain_dns": {
+          "description": "The current quota usage of domain (provider = DNS).",
+          "type": "integer",
+          "x-omitempty": false,
+          "example": 5
+        },
         "in_use_domain_f5": {
           "description": "The current quota usage of domain (provider = F5).",
           "type": "integer",
@@ -5445,6 +7770,12 @@ func init() {
           "type": "string",
           "example": "andromeda-agent-fbb49979-03f5-4a97-a334-1fd2c9f61e7e"
         },
+        "leader": {
+          "description": "Whether the service replica is the elected leader of its agent, not set for services without leader election.",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": true
+        },
         "metadata": {
           "type": "object"
         },
@@ -5469,6 +7800,51 @@ func init() {
           "example": "1.2.3"
         }
       }
+    },
+    "stuck_resource": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "description": "The id of the resource.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "project_id": {
+          "description": "The ID of the project owning this resource.",
+          "type": "string"
+        },
+        "provisioning_error": {
+          "description": "The reason of the last provisioning failure.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "provisioning_status": {
+          "type": "string",
+          "enum": [
+            "PENDING_CREATE",
+            "PENDING_UPDATE",
+            "PENDING_DELETE",
+            "ACTIVE",
+            "ERROR"
+          ]
+        },
+        "resource_type": {
+          "type": "string",
+          "enum": [
+            "domain",
+            "pool",
+            "member",
+            "monitor",
+            "datacenter",
+            "geomap"
+          ]
+        },
+        "updated_at": {
+          "description": "The UTC date and timestamp when the resource was last updated.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
     }
   },
   "parameters": {
@@ -5522,6 +7898,10 @@ func init() {
     {
       "description": "Administrative API",
       "name": "Administrative"
+    },
+    {
+      "description": "Export and import of the configuration of a project.",
+      "name": "Configuration"
     }
   ]
 }`))
@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetAgentsHandlerFunc turns a function with the right signature into a get agents handler
+type GetAgentsHandlerFunc func(GetAgentsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetAgentsHandlerFunc) Handle(params GetAgentsParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetAgentsHandler interface for that can handle valid get agents params
+type GetAgentsHandler interface {
+	Handle(GetAgentsParams) middleware.Responder
+}
+
+// NewGetAgents creates a new http.Handler for the get agents operation
+func NewGetAgents(ctx *middleware.Context, handler GetAgentsHandler) *GetAgents {
+	return &GetAgents{Context: ctx, Handler: handler}
+}
+
+/*
+	GetAgents swagger:route GET /agents Administrative getAgents
+
+# List agents
+
+Lists the agents registered by their heartbeats. Agents without heartbeat within the agent TTL, or disabled
+by an administrator, are STALE.
+*/
+type GetAgents struct {
+	Context *middleware.Context
+	Handler GetAgentsHandler
+}
+
+func (o *GetAgents) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetAgentsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// GetAgentsOKBody get agents o k body
+//
+// swagger:model GetAgentsOKBody
+type GetAgentsOKBody struct {
+
+	// agents
+	Agents []*models.Agent `json:"agents"`
+}
+
+// Validate validates this get agents o k body
+func (o *GetAgentsOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAgents(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAgentsOKBody) validateAgents(formats strfmt.Registry) error {
+	if swag.IsZero(o.Agents) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Agents); i++ {
+		if swag.IsZero(o.Agents[i]) { // not required
+			continue
+		}
+
+		if o.Agents[i] != nil {
+			if err := o.Agents[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getAgentsOK" + "." + "agents" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("getAgentsOK" + "." + "agents" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get agents o k body based on the context it is used
+func (o *GetAgentsOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateAgents(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAgentsOKBody) contextValidateAgents(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Agents); i++ {
+
+		if o.Agents[i] != nil {
+			if err := o.Agents[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getAgentsOK" + "." + "agents" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("getAgentsOK" + "." + "agents" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetAgentsOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetAgentsOKBody) UnmarshalBinary(b []byte) error {
+	var res GetAgentsOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
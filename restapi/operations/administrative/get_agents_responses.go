@@ -0,0 +1,122 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetAgentsOKCode is the HTTP code returned for type GetAgentsOK
+const GetAgentsOKCode int = 200
+
+/*
+GetAgentsOK A JSON array of agents
+
+swagger:response getAgentsOK
+*/
+type GetAgentsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *GetAgentsOKBody `json:"body,omitempty"`
+}
+
+// NewGetAgentsOK creates GetAgentsOK with default headers values
+func NewGetAgentsOK() *GetAgentsOK {
+
+	return &GetAgentsOK{}
+}
+
+// WithPayload adds the payload to the get agents o k response
+func (o *GetAgentsOK) WithPayload(payload *GetAgentsOKBody) *GetAgentsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get agents o k response
+func (o *GetAgentsOK) SetPayload(payload *GetAgentsOKBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAgentsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetAgentsDefault Unexpected Error
+
+swagger:response getAgentsDefault
+*/
+type GetAgentsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetAgentsDefault creates GetAgentsDefault with default headers values
+func NewGetAgentsDefault(code int) *GetAgentsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetAgentsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get agents default response
+func (o *GetAgentsDefault) WithStatusCode(code int) *GetAgentsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get agents default response
+func (o *GetAgentsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get agents default response
+func (o *GetAgentsDefault) WithPayload(payload *models.Error) *GetAgentsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get agents default response
+func (o *GetAgentsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAgentsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
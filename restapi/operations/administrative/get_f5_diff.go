@@ -0,0 +1,63 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetF5DiffHandlerFunc turns a function with the right signature into a get f5 diff handler
+type GetF5DiffHandlerFunc func(GetF5DiffParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetF5DiffHandlerFunc) Handle(params GetF5DiffParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetF5DiffHandler interface for that can handle valid get f5 diff params
+type GetF5DiffHandler interface {
+	Handle(GetF5DiffParams) middleware.Responder
+}
+
+// NewGetF5Diff creates a new http.Handler for the get f5 diff operation
+func NewGetF5Diff(ctx *middleware.Context, handler GetF5DiffHandler) *GetF5Diff {
+	return &GetF5Diff{Context: ctx, Handler: handler}
+}
+
+/*
+	GetF5Diff swagger:route GET /f5/diff Administrative getF5Diff
+
+# Preview the F5 AS3 declaration
+
+Builds the AS3 declaration of all F5 domains (dry-run) and returns its difference to the declaration
+currently deployed on the active F5 device per tenant and application. Nothing is applied.
+*/
+type GetF5Diff struct {
+	Context *middleware.Context
+	Handler GetF5DiffHandler
+}
+
+func (o *GetF5Diff) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetF5DiffParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,50 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// NewGetF5DiffParams creates a new GetF5DiffParams object
+//
+// There are no default values defined in the spec.
+func NewGetF5DiffParams() GetF5DiffParams {
+
+	return GetF5DiffParams{}
+}
+
+// GetF5DiffParams contains all the bound params for the get f5 diff operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetF5Diff
+type GetF5DiffParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetF5DiffParams() beforehand.
+func (o *GetF5DiffParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetF5DiffOKCode is the HTTP code returned for type GetF5DiffOK
+const GetF5DiffOKCode int = 200
+
+/*
+GetF5DiffOK Difference between the deployed and the desired AS3 declaration.
+
+swagger:response getF5DiffOK
+*/
+type GetF5DiffOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DeclarationDiff `json:"body,omitempty"`
+}
+
+// NewGetF5DiffOK creates GetF5DiffOK with default headers values
+func NewGetF5DiffOK() *GetF5DiffOK {
+
+	return &GetF5DiffOK{}
+}
+
+// WithPayload adds the payload to the get f5 diff o k response
+func (o *GetF5DiffOK) WithPayload(payload *models.DeclarationDiff) *GetF5DiffOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get f5 diff o k response
+func (o *GetF5DiffOK) SetPayload(payload *models.DeclarationDiff) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetF5DiffOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetF5DiffDefault Unexpected Error
+
+swagger:response getF5DiffDefault
+*/
+type GetF5DiffDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetF5DiffDefault creates GetF5DiffDefault with default headers values
+func NewGetF5DiffDefault(code int) *GetF5DiffDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetF5DiffDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get f5 diff default response
+func (o *GetF5DiffDefault) WithStatusCode(code int) *GetF5DiffDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get f5 diff default response
+func (o *GetF5DiffDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get f5 diff default response
+func (o *GetF5DiffDefault) WithPayload(payload *models.Error) *GetF5DiffDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get f5 diff default response
+func (o *GetF5DiffDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetF5DiffDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetResetHandlerFunc turns a function with the right signature into a get reset handler
+type GetResetHandlerFunc func(GetResetParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetResetHandlerFunc) Handle(params GetResetParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetResetHandler interface for that can handle valid get reset params
+type GetResetHandler interface {
+	Handle(GetResetParams) middleware.Responder
+}
+
+// NewGetReset creates a new http.Handler for the get reset operation
+func NewGetReset(ctx *middleware.Context, handler GetResetHandler) *GetReset {
+	return &GetReset{Context: ctx, Handler: handler}
+}
+
+/*
+	GetReset swagger:route GET /reset Administrative getReset
+
+# List stuck resources
+
+Lists resources in a pending provisioning status or in ERROR which have not been updated within the threshold.
+*/
+type GetReset struct {
+	Context *middleware.Context
+	Handler GetResetHandler
+}
+
+func (o *GetReset) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetResetParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// GetResetOKBody get reset o k body
+//
+// swagger:model GetResetOKBody
+type GetResetOKBody struct {
+
+	// resources
+	Resources []*models.StuckResource `json:"resources"`
+}
+
+// Validate validates this get reset o k body
+func (o *GetResetOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateResources(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetResetOKBody) validateResources(formats strfmt.Registry) error {
+	if swag.IsZero(o.Resources) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Resources); i++ {
+		if swag.IsZero(o.Resources[i]) { // not required
+			continue
+		}
+
+		if o.Resources[i] != nil {
+			if err := o.Resources[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getResetOK" + "." + "resources" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("getResetOK" + "." + "resources" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get reset o k body based on the context it is used
+func (o *GetResetOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateResources(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetResetOKBody) contextValidateResources(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Resources); i++ {
+
+		if o.Resources[i] != nil {
+			if err := o.Resources[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getResetOK" + "." + "resources" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("getResetOK" + "." + "resources" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetResetOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetResetOKBody) UnmarshalBinary(b []byte) error {
+	var res GetResetOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
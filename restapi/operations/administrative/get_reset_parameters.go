@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetResetParams creates a new GetResetParams object
+// with the default values initialized.
+func NewGetResetParams() GetResetParams {
+
+	var (
+		// initialize parameters with default values
+
+		olderThanDefault = int64(3600)
+	)
+
+	return GetResetParams{
+		OlderThan: &olderThanDefault,
+	}
+}
+
+// GetResetParams contains all the bound params for the get reset operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetReset
+type GetResetParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Threshold in seconds since the last update of the resource.
+	  Minimum: 0
+	  In: query
+	  Default: 3600
+	*/
+	OlderThan *int64
+	/*Only list resources of this type.
+	  In: query
+	*/
+	ResourceType *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetResetParams() beforehand.
+func (o *GetResetParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qOlderThan, qhkOlderThan, _ := qs.GetOK("older_than")
+	if err := o.bindOlderThan(qOlderThan, qhkOlderThan, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qResourceType, qhkResourceType, _ := qs.GetOK("resource_type")
+	if err := o.bindResourceType(qResourceType, qhkResourceType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindOlderThan binds and validates parameter OlderThan from query.
+func (o *GetResetParams) bindOlderThan(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetResetParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("older_than", "query", "int64", raw)
+	}
+	o.OlderThan = &value
+
+	if err := o.validateOlderThan(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOlderThan carries on validations for parameter OlderThan
+func (o *GetResetParams) validateOlderThan(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("older_than", "query", *o.OlderThan, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindResourceType binds and validates parameter ResourceType from query.
+func (o *GetResetParams) bindResourceType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.ResourceType = &raw
+
+	if err := o.validateResourceType(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateResourceType carries on validations for parameter ResourceType
+func (o *GetResetParams) validateResourceType(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("resource_type", "query", *o.ResourceType, []interface{}{"domain", "pool", "member", "monitor", "datacenter", "geomap"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetResetOKCode is the HTTP code returned for type GetResetOK
+const GetResetOKCode int = 200
+
+/*
+GetResetOK A JSON array of stuck resources.
+
+swagger:response getResetOK
+*/
+type GetResetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *GetResetOKBody `json:"body,omitempty"`
+}
+
+// NewGetResetOK creates GetResetOK with default headers values
+func NewGetResetOK() *GetResetOK {
+
+	return &GetResetOK{}
+}
+
+// WithPayload adds the payload to the get reset o k response
+func (o *GetResetOK) WithPayload(payload *GetResetOKBody) *GetResetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get reset o k response
+func (o *GetResetOK) SetPayload(payload *GetResetOKBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetResetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetResetDefault Unexpected Error
+
+swagger:response getResetDefault
+*/
+type GetResetDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetResetDefault creates GetResetDefault with default headers values
+func NewGetResetDefault(code int) *GetResetDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetResetDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get reset default response
+func (o *GetResetDefault) WithStatusCode(code int) *GetResetDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get reset default response
+func (o *GetResetDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get reset default response
+func (o *GetResetDefault) WithPayload(payload *models.Error) *GetResetDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get reset default response
+func (o *GetResetDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetResetDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,275 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostResetResourceTypeResourceIDHandlerFunc turns a function with the right signature into a post reset resource type resource ID handler
+type PostResetResourceTypeResourceIDHandlerFunc func(PostResetResourceTypeResourceIDParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostResetResourceTypeResourceIDHandlerFunc) Handle(params PostResetResourceTypeResourceIDParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostResetResourceTypeResourceIDHandler interface for that can handle valid post reset resource type resource ID params
+type PostResetResourceTypeResourceIDHandler interface {
+	Handle(PostResetResourceTypeResourceIDParams) middleware.Responder
+}
+
+// NewPostResetResourceTypeResourceID creates a new http.Handler for the post reset resource type resource ID operation
+func NewPostResetResourceTypeResourceID(ctx *middleware.Context, handler PostResetResourceTypeResourceIDHandler) *PostResetResourceTypeResourceID {
+	return &PostResetResourceTypeResourceID{Context: ctx, Handler: handler}
+}
+
+/*
+	PostResetResourceTypeResourceID swagger:route POST /reset/{resource_type}/{resource_id} Administrative postResetResourceTypeResourceId
+
+# Reset the provisioning status of a resource
+
+Requeues the resource for provisioning (ERROR resources become PENDING_UPDATE), or forces the provisioning
+status to ACTIVE or ERROR.
+*/
+type PostResetResourceTypeResourceID struct {
+	Context *middleware.Context
+	Handler PostResetResourceTypeResourceIDHandler
+}
+
+func (o *PostResetResourceTypeResourceID) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostResetResourceTypeResourceIDParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// PostResetResourceTypeResourceIDAcceptedBody post reset resource type resource ID accepted body
+//
+// swagger:model PostResetResourceTypeResourceIDAcceptedBody
+type PostResetResourceTypeResourceIDAcceptedBody struct {
+
+	// resource
+	Resource *models.StuckResource `json:"resource,omitempty"`
+}
+
+// Validate validates this post reset resource type resource ID accepted body
+func (o *PostResetResourceTypeResourceIDAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateResource(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostResetResourceTypeResourceIDAcceptedBody) validateResource(formats strfmt.Registry) error {
+	if swag.IsZero(o.Resource) { // not required
+		return nil
+	}
+
+	if o.Resource != nil {
+		if err := o.Resource.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postResetResourceTypeResourceIdAccepted" + "." + "resource")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postResetResourceTypeResourceIdAccepted" + "." + "resource")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post reset resource type resource ID accepted body based on the context it is used
+func (o *PostResetResourceTypeResourceIDAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateResource(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostResetResourceTypeResourceIDAcceptedBody) contextValidateResource(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Resource != nil {
+		if err := o.Resource.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postResetResourceTypeResourceIdAccepted" + "." + "resource")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postResetResourceTypeResourceIdAccepted" + "." + "resource")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostResetResourceTypeResourceIDAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostResetResourceTypeResourceIDAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostResetResourceTypeResourceIDAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// PostResetResourceTypeResourceIDBody post reset resource type resource ID body
+//
+// swagger:model PostResetResourceTypeResourceIDBody
+type PostResetResourceTypeResourceIDBody struct {
+
+	// The reset action.
+	// Required: true
+	// Enum: [requeue active error]
+	Action *string `json:"action"`
+
+	// The provisioning error recorded for action error.
+	// Max Length: 255
+	Reason string `json:"reason,omitempty"`
+}
+
+// Validate validates this post reset resource type resource ID body
+func (o *PostResetResourceTypeResourceIDBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateReason(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var postResetResourceTypeResourceIdBodyTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["requeue","active","error"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		postResetResourceTypeResourceIdBodyTypeActionPropEnum = append(postResetResourceTypeResourceIdBodyTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// PostResetResourceTypeResourceIDBodyActionRequeue captures enum value "requeue"
+	PostResetResourceTypeResourceIDBodyActionRequeue string = "requeue"
+
+	// PostResetResourceTypeResourceIDBodyActionActive captures enum value "active"
+	PostResetResourceTypeResourceIDBodyActionActive string = "active"
+
+	// PostResetResourceTypeResourceIDBodyActionError captures enum value "error"
+	PostResetResourceTypeResourceIDBodyActionError string = "error"
+)
+
+// prop value enum
+func (o *PostResetResourceTypeResourceIDBody) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, postResetResourceTypeResourceIdBodyTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *PostResetResourceTypeResourceIDBody) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("reset"+"."+"action", "body", o.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := o.validateActionEnum("reset"+"."+"action", "body", *o.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *PostResetResourceTypeResourceIDBody) validateReason(formats strfmt.Registry) error {
+	if swag.IsZero(o.Reason) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("reset"+"."+"reason", "body", o.Reason, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post reset resource type resource ID body based on context it is used
+func (o *PostResetResourceTypeResourceIDBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostResetResourceTypeResourceIDBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostResetResourceTypeResourceIDBody) UnmarshalBinary(b []byte) error {
+	var res PostResetResourceTypeResourceIDBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
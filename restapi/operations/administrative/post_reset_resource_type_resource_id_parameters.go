@@ -0,0 +1,168 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewPostResetResourceTypeResourceIDParams creates a new PostResetResourceTypeResourceIDParams object
+//
+// There are no default values defined in the spec.
+func NewPostResetResourceTypeResourceIDParams() PostResetResourceTypeResourceIDParams {
+
+	return PostResetResourceTypeResourceIDParams{}
+}
+
+// PostResetResourceTypeResourceIDParams contains all the bound params for the post reset resource type resource ID operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters PostResetResourceTypeResourceID
+type PostResetResourceTypeResourceIDParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: body
+	*/
+	Reset PostResetResourceTypeResourceIDBody
+	/*The UUID of the resource
+	  Required: true
+	  In: path
+	*/
+	ResourceID strfmt.UUID
+	/*The type of the resource
+	  Required: true
+	  In: path
+	*/
+	ResourceType string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPostResetResourceTypeResourceIDParams() beforehand.
+func (o *PostResetResourceTypeResourceIDParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body PostResetResourceTypeResourceIDBody
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("reset", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("reset", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Reset = body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("reset", "body", ""))
+	}
+
+	rResourceID, rhkResourceID, _ := route.Params.GetOK("resource_id")
+	if err := o.bindResourceID(rResourceID, rhkResourceID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rResourceType, rhkResourceType, _ := route.Params.GetOK("resource_type")
+	if err := o.bindResourceType(rResourceType, rhkResourceType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindResourceID binds and validates parameter ResourceID from path.
+func (o *PostResetResourceTypeResourceIDParams) bindResourceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("resource_id", "path", "strfmt.UUID", raw)
+	}
+	o.ResourceID = *(value.(*strfmt.UUID))
+
+	if err := o.validateResourceID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateResourceID carries on validations for parameter ResourceID
+func (o *PostResetResourceTypeResourceIDParams) validateResourceID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("resource_id", "path", "uuid", o.ResourceID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindResourceType binds and validates parameter ResourceType from path.
+func (o *PostResetResourceTypeResourceIDParams) bindResourceType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.ResourceType = raw
+
+	if err := o.validateResourceType(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateResourceType carries on validations for parameter ResourceType
+func (o *PostResetResourceTypeResourceIDParams) validateResourceType(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("resource_type", "path", o.ResourceType, []interface{}{"domain", "pool", "member", "monitor", "datacenter", "geomap"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostResetResourceTypeResourceIDAcceptedCode is the HTTP code returned for type PostResetResourceTypeResourceIDAccepted
+const PostResetResourceTypeResourceIDAcceptedCode int = 202
+
+/*
+PostResetResourceTypeResourceIDAccepted The resource has been reset.
+
+swagger:response postResetResourceTypeResourceIdAccepted
+*/
+type PostResetResourceTypeResourceIDAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *PostResetResourceTypeResourceIDAcceptedBody `json:"body,omitempty"`
+}
+
+// NewPostResetResourceTypeResourceIDAccepted creates PostResetResourceTypeResourceIDAccepted with default headers values
+func NewPostResetResourceTypeResourceIDAccepted() *PostResetResourceTypeResourceIDAccepted {
+
+	return &PostResetResourceTypeResourceIDAccepted{}
+}
+
+// WithPayload adds the payload to the post reset resource type resource Id accepted response
+func (o *PostResetResourceTypeResourceIDAccepted) WithPayload(payload *PostResetResourceTypeResourceIDAcceptedBody) *PostResetResourceTypeResourceIDAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post reset resource type resource Id accepted response
+func (o *PostResetResourceTypeResourceIDAccepted) SetPayload(payload *PostResetResourceTypeResourceIDAcceptedBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostResetResourceTypeResourceIDAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostResetResourceTypeResourceIDNotFoundCode is the HTTP code returned for type PostResetResourceTypeResourceIDNotFound
+const PostResetResourceTypeResourceIDNotFoundCode int = 404
+
+/*
+PostResetResourceTypeResourceIDNotFound Not Found
+
+swagger:response postResetResourceTypeResourceIdNotFound
+*/
+type PostResetResourceTypeResourceIDNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostResetResourceTypeResourceIDNotFound creates PostResetResourceTypeResourceIDNotFound with default headers values
+func NewPostResetResourceTypeResourceIDNotFound() *PostResetResourceTypeResourceIDNotFound {
+
+	return &PostResetResourceTypeResourceIDNotFound{}
+}
+
+// WithPayload adds the payload to the post reset resource type resource Id not found response
+func (o *PostResetResourceTypeResourceIDNotFound) WithPayload(payload *models.Error) *PostResetResourceTypeResourceIDNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post reset resource type resource Id not found response
+func (o *PostResetResourceTypeResourceIDNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostResetResourceTypeResourceIDNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostResetResourceTypeResourceIDDefault Unexpected Error
+
+swagger:response postResetResourceTypeResourceIdDefault
+*/
+type PostResetResourceTypeResourceIDDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostResetResourceTypeResourceIDDefault creates PostResetResourceTypeResourceIDDefault with default headers values
+func NewPostResetResourceTypeResourceIDDefault(code int) *PostResetResourceTypeResourceIDDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostResetResourceTypeResourceIDDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post reset resource type resource ID default response
+func (o *PostResetResourceTypeResourceIDDefault) WithStatusCode(code int) *PostResetResourceTypeResourceIDDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post reset resource type resource ID default response
+func (o *PostResetResourceTypeResourceIDDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post reset resource type resource ID default response
+func (o *PostResetResourceTypeResourceIDDefault) WithPayload(payload *models.Error) *PostResetResourceTypeResourceIDDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post reset resource type resource ID default response
+func (o *PostResetResourceTypeResourceIDDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostResetResourceTypeResourceIDDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package administrative
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// PostResetResourceTypeResourceIDURL generates an URL for the post reset resource type resource ID operation
+type PostResetResourceTypeResourceIDURL struct {
+	ResourceID   strfmt.UUID
+	ResourceType string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *PostResetResourceTypeResourceIDURL) WithBasePath(bp string) *PostResetResourceTypeResourceIDURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *PostResetResourceTypeResourceIDURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *PostResetResourceTypeResourceIDURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/reset/{resource_type}/{resource_id}"
+
+	resourceID := o.ResourceID.String()
+	if resourceID != "" {
+		_path = strings.Replace(_path, "{resource_id}", resourceID, -1)
+	} else {
+		return nil, errors.New("resourceId is required on PostResetResourceTypeResourceIDURL")
+	}
+
+	resourceType := o.ResourceType
+	if resourceType != "" {
+		_path = strings.Replace(_path, "{resource_type}", resourceType, -1)
+	} else {
+		return nil, errors.New("resourceType is required on PostResetResourceTypeResourceIDURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *PostResetResourceTypeResourceIDURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *PostResetResourceTypeResourceIDURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *PostResetResourceTypeResourceIDURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on PostResetResourceTypeResourceIDURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on PostResetResourceTypeResourceIDURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *PostResetResourceTypeResourceIDURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
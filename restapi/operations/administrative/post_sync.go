@@ -42,7 +42,11 @@ func NewPostSync(ctx *middleware.Context, handler PostSyncHandler) *PostSync {
 /*
 	PostSync swagger:route POST /sync Administrative postSync
 
-Enqueue a full sync
+# Sync domains
+
+Requests a sync of the given domains from the agents of their providers, a full sync is requested if no
+domains are given. The F5 agent replies once the sync is completed, errors are returned to the caller.
+Unknown domains are rejected.
 */
 type PostSync struct {
 	Context *middleware.Context
@@ -21,7 +21,7 @@ import (
 const PostSyncAcceptedCode int = 202
 
 /*
-PostSyncAccepted Full sync has been enqueued.
+PostSyncAccepted Sync has been enqueued or completed.
 
 swagger:response postSyncAccepted
 */
@@ -24,6 +24,7 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/sapcc/andromeda/restapi/operations/administrative"
+	"github.com/sapcc/andromeda/restapi/operations/configuration"
 	"github.com/sapcc/andromeda/restapi/operations/datacenters"
 	"github.com/sapcc/andromeda/restapi/operations/domains"
 	"github.com/sapcc/andromeda/restapi/operations/geographic_maps"
@@ -75,6 +76,9 @@ func NewAndromedaAPI(spec *loads.Document) *AndromedaAPI {
 		AdministrativeDeleteQuotasProjectIDHandler: administrative.DeleteQuotasProjectIDHandlerFunc(func(params administrative.DeleteQuotasProjectIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation administrative.DeleteQuotasProjectID has not yet been implemented")
 		}),
+		AdministrativeGetAgentsHandler: administrative.GetAgentsHandlerFunc(func(params administrative.GetAgentsParams) middleware.Responder {
+			return middleware.NotImplemented("operation administrative.GetAgents has not yet been implemented")
+		}),
 		AdministrativeGetCidrBlocksHandler: administrative.GetCidrBlocksHandlerFunc(func(params administrative.GetCidrBlocksParams) middleware.Responder {
 			return middleware.NotImplemented("operation administrative.GetCidrBlocks has not yet been implemented")
 		}),
@@ -90,6 +94,12 @@ func NewAndromedaAPI(spec *loads.Document) *AndromedaAPI {
 		DomainsGetDomainsDomainIDHandler: domains.GetDomainsDomainIDHandlerFunc(func(params domains.GetDomainsDomainIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation domains.GetDomainsDomainID has not yet been implemented")
 		}),
+		ConfigurationGetExportHandler: configuration.GetExportHandlerFunc(func(params configuration.GetExportParams) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.GetExport has not yet been implemented")
+		}),
+		AdministrativeGetF5DiffHandler: administrative.GetF5DiffHandlerFunc(func(params administrative.GetF5DiffParams) middleware.Responder {
+			return middleware.NotImplemented("operation administrative.GetF5Diff has not yet been implemented")
+		}),
 		GeographicMapsGetGeomapsHandler: geographic_maps.GetGeomapsHandlerFunc(func(params geographic_maps.GetGeomapsParams) middleware.Responder {
 			return middleware.NotImplemented("operation geographic_maps.GetGeomaps has not yet been implemented")
 		}),
@@ -102,6 +112,9 @@ func NewAndromedaAPI(spec *loads.Document) *AndromedaAPI {
 		MembersGetMembersMemberIDHandler: members.GetMembersMemberIDHandlerFunc(func(params members.GetMembersMemberIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation members.GetMembersMemberID has not yet been implemented")
 		}),
+		MembersGetMembersMemberIDStatusHistoryHandler: members.GetMembersMemberIDStatusHistoryHandlerFunc(func(params members.GetMembersMemberIDStatusHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation members.GetMembersMemberIDStatusHistory has not yet been implemented")
+		}),
 		MonitorsGetMonitorsHandler: monitors.GetMonitorsHandlerFunc(func(params monitors.GetMonitorsParams) middleware.Responder {
 			return middleware.NotImplemented("operation monitors.GetMonitors has not yet been implemented")
 		}),
@@ -123,27 +136,57 @@ func NewAndromedaAPI(spec *loads.Document) *AndromedaAPI {
 		AdministrativeGetQuotasProjectIDHandler: administrative.GetQuotasProjectIDHandlerFunc(func(params administrative.GetQuotasProjectIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation administrative.GetQuotasProjectID has not yet been implemented")
 		}),
+		AdministrativeGetResetHandler: administrative.GetResetHandlerFunc(func(params administrative.GetResetParams) middleware.Responder {
+			return middleware.NotImplemented("operation administrative.GetReset has not yet been implemented")
+		}),
 		AdministrativeGetServicesHandler: administrative.GetServicesHandlerFunc(func(params administrative.GetServicesParams) middleware.Responder {
 			return middleware.NotImplemented("operation administrative.GetServices has not yet been implemented")
 		}),
 		DatacentersPostDatacentersHandler: datacenters.PostDatacentersHandlerFunc(func(params datacenters.PostDatacentersParams) middleware.Responder {
 			return middleware.NotImplemented("operation datacenters.PostDatacenters has not yet been implemented")
 		}),
+		DatacentersPostDatacentersDatacenterIDRetryHandler: datacenters.PostDatacentersDatacenterIDRetryHandlerFunc(func(params datacenters.PostDatacentersDatacenterIDRetryParams) middleware.Responder {
+			return middleware.NotImplemented("operation datacenters.PostDatacentersDatacenterIDRetry has not yet been implemented")
+		}),
 		DomainsPostDomainsHandler: domains.PostDomainsHandlerFunc(func(params domains.PostDomainsParams) middleware.Responder {
 			return middleware.NotImplemented("operation domains.PostDomains has not yet been implemented")
 		}),
+		DomainsPostDomainsDomainIDRestoreHandler: domains.PostDomainsDomainIDRestoreHandlerFunc(func(params domains.PostDomainsDomainIDRestoreParams) middleware.Responder {
+			return middleware.NotImplemented("operation domains.PostDomainsDomainIDRestore has not yet been implemented")
+		}),
+		DomainsPostDomainsDomainIDRetryHandler: domains.PostDomainsDomainIDRetryHandlerFunc(func(params domains.PostDomainsDomainIDRetryParams) middleware.Responder {
+			return middleware.NotImplemented("operation domains.PostDomainsDomainIDRetry has not yet been implemented")
+		}),
 		GeographicMapsPostGeomapsHandler: geographic_maps.PostGeomapsHandlerFunc(func(params geographic_maps.PostGeomapsParams) middleware.Responder {
 			return middleware.NotImplemented("operation geographic_maps.PostGeomaps has not yet been implemented")
 		}),
+		GeographicMapsPostGeomapsGeomapIDRetryHandler: geographic_maps.PostGeomapsGeomapIDRetryHandlerFunc(func(params geographic_maps.PostGeomapsGeomapIDRetryParams) middleware.Responder {
+			return middleware.NotImplemented("operation geographic_maps.PostGeomapsGeomapIDRetry has not yet been implemented")
+		}),
+		ConfigurationPostImportHandler: configuration.PostImportHandlerFunc(func(params configuration.PostImportParams) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.PostImport has not yet been implemented")
+		}),
 		MembersPostMembersHandler: members.PostMembersHandlerFunc(func(params members.PostMembersParams) middleware.Responder {
 			return middleware.NotImplemented("operation members.PostMembers has not yet been implemented")
 		}),
+		MembersPostMembersMemberIDRetryHandler: members.PostMembersMemberIDRetryHandlerFunc(func(params members.PostMembersMemberIDRetryParams) middleware.Responder {
+			return middleware.NotImplemented("operation members.PostMembersMemberIDRetry has not yet been implemented")
+		}),
 		MonitorsPostMonitorsHandler: monitors.PostMonitorsHandlerFunc(func(params monitors.PostMonitorsParams) middleware.Responder {
 			return middleware.NotImplemented("operation monitors.PostMonitors has not yet been implemented")
 		}),
+		MonitorsPostMonitorsMonitorIDRetryHandler: monitors.PostMonitorsMonitorIDRetryHandlerFunc(func(params monitors.PostMonitorsMonitorIDRetryParams) middleware.Responder {
+			return middleware.NotImplemented("operation monitors.PostMonitorsMonitorIDRetry has not yet been implemented")
+		}),
 		PoolsPostPoolsHandler: pools.PostPoolsHandlerFunc(func(params pools.PostPoolsParams) middleware.Responder {
 			return middleware.NotImplemented("operation pools.PostPools has not yet been implemented")
 		}),
+		PoolsPostPoolsPoolIDRetryHandler: pools.PostPoolsPoolIDRetryHandlerFunc(func(params pools.PostPoolsPoolIDRetryParams) middleware.Responder {
+			return middleware.NotImplemented("operation pools.PostPoolsPoolIDRetry has not yet been implemented")
+		}),
+		AdministrativePostResetResourceTypeResourceIDHandler: administrative.PostResetResourceTypeResourceIDHandlerFunc(func(params administrative.PostResetResourceTypeResourceIDParams) middleware.Responder {
+			return middleware.NotImplemented("operation administrative.PostResetResourceTypeResourceID has not yet been implemented")
+		}),
 		AdministrativePostSyncHandler: administrative.PostSyncHandlerFunc(func(params administrative.PostSyncParams) middleware.Responder {
 			return middleware.NotImplemented("operation administrative.PostSync has not yet been implemented")
 		}),
@@ -226,6 +269,8 @@ type AndromedaAPI struct {
 	PoolsDeletePoolsPoolIDHandler pools.DeletePoolsPoolIDHandler
 	// AdministrativeDeleteQuotasProjectIDHandler sets the operation handler for the delete quotas project ID operation
 	AdministrativeDeleteQuotasProjectIDHandler administrative.DeleteQuotasProjectIDHandler
+	// AdministrativeGetAgentsHandler sets the operation handler for the get agents operation
+	AdministrativeGetAgentsHandler administrative.GetAgentsHandler
 	// AdministrativeGetCidrBlocksHandler sets the operation handler for the get cidr blocks operation
 	AdministrativeGetCidrBlocksHandler administrative.GetCidrBlocksHandler
 	// DatacentersGetDatacentersHandler sets the operation handler for the get datacenters operation
@@ -236,6 +281,10 @@ type AndromedaAPI struct {
 	DomainsGetDomainsHandler domains.GetDomainsHandler
 	// DomainsGetDomainsDomainIDHandler sets the operation handler for the get domains domain ID operation
 	DomainsGetDomainsDomainIDHandler domains.GetDomainsDomainIDHandler
+	// ConfigurationGetExportHandler sets the operation handler for the get export operation
+	ConfigurationGetExportHandler configuration.GetExportHandler
+	// AdministrativeGetF5DiffHandler sets the operation handler for the get f5 diff operation
+	AdministrativeGetF5DiffHandler administrative.GetF5DiffHandler
 	// GeographicMapsGetGeomapsHandler sets the operation handler for the get geomaps operation
 	GeographicMapsGetGeomapsHandler geographic_maps.GetGeomapsHandler
 	// GeographicMapsGetGeomapsGeomapIDHandler sets the operation handler for the get geomaps geomap ID operation
@@ -244,6 +293,8 @@ type AndromedaAPI struct {
 	MembersGetMembersHandler members.GetMembersHandler
 	// MembersGetMembersMemberIDHandler sets the operation handler for the get members member ID operation
 	MembersGetMembersMemberIDHandler members.GetMembersMemberIDHandler
+	// MembersGetMembersMemberIDStatusHistoryHandler sets the operation handler for the get members member ID status history operation
+	MembersGetMembersMemberIDStatusHistoryHandler members.GetMembersMemberIDStatusHistoryHandler
 	// MonitorsGetMonitorsHandler sets the operation handler for the get monitors operation
 	MonitorsGetMonitorsHandler monitors.GetMonitorsHandler
 	// MonitorsGetMonitorsMonitorIDHandler sets the operation handler for the get monitors monitor ID operation
@@ -258,20 +309,40 @@ type AndromedaAPI struct {
 	AdministrativeGetQuotasDefaultsHandler administrative.GetQuotasDefaultsHandler
 	// AdministrativeGetQuotasProjectIDHandler sets the operation handler for the get quotas project ID operation
 	AdministrativeGetQuotasProjectIDHandler administrative.GetQuotasProjectIDHandler
+	// AdministrativeGetResetHandler sets the operation handler for the get reset operation
+	AdministrativeGetResetHandler administrative.GetResetHandler
 	// AdministrativeGetServicesHandler sets the operation handler for the get services operation
 	AdministrativeGetServicesHandler administrative.GetServicesHandler
 	// DatacentersPostDatacentersHandler sets the operation handler for the post datacenters operation
 	DatacentersPostDatacentersHandler datacenters.PostDatacentersHandler
+	// DatacentersPostDatacentersDatacenterIDRetryHandler sets the operation handler for the post datacenters datacenter ID retry operation
+	DatacentersPostDatacentersDatacenterIDRetryHandler datacenters.PostDatacentersDatacenterIDRetryHandler
 	// DomainsPostDomainsHandler sets the operation handler for the post domains operation
 	DomainsPostDomainsHandler domains.PostDomainsHandler
+	// DomainsPostDomainsDomainIDRestoreHandler sets the operation handler for the post domains domain ID restore operation
+	DomainsPostDomainsDomainIDRestoreHandler domains.PostDomainsDomainIDRestoreHandler
+	// DomainsPostDomainsDomainIDRetryHandler sets the operation handler for the post domains domain ID retry operation
+	DomainsPostDomainsDomainIDRetryHandler domains.PostDomainsDomainIDRetryHandler
 	// GeographicMapsPostGeomapsHandler sets the operation handler for the post geomaps operation
 	GeographicMapsPostGeomapsHandler geographic_maps.PostGeomapsHandler
+	// GeographicMapsPostGeomapsGeomapIDRetryHandler sets the operation handler for the post geomaps geomap ID retry operation
+	GeographicMapsPostGeomapsGeomapIDRetryHandler geographic_maps.PostGeomapsGeomapIDRetryHandler
+	// ConfigurationPostImportHandler sets the operation handler for the post import operation
+	ConfigurationPostImportHandler configuration.PostImportHandler
 	// MembersPostMembersHandler sets the operation handler for the post members operation
 	MembersPostMembersHandler members.PostMembersHandler
+	// MembersPostMembersMemberIDRetryHandler sets the operation handler for the post members member ID retry operation
+	MembersPostMembersMemberIDRetryHandler members.PostMembersMemberIDRetryHandler
 	// MonitorsPostMonitorsHandler sets the operation handler for the post monitors operation
 	MonitorsPostMonitorsHandler monitors.PostMonitorsHandler
+	// MonitorsPostMonitorsMonitorIDRetryHandler sets the operation handler for the post monitors monitor ID retry operation
+	MonitorsPostMonitorsMonitorIDRetryHandler monitors.PostMonitorsMonitorIDRetryHandler
 	// PoolsPostPoolsHandler sets the operation handler for the post pools operation
 	PoolsPostPoolsHandler pools.PostPoolsHandler
+	// PoolsPostPoolsPoolIDRetryHandler sets the operation handler for the post pools pool ID retry operation
+	PoolsPostPoolsPoolIDRetryHandler pools.PostPoolsPoolIDRetryHandler
+	// AdministrativePostResetResourceTypeResourceIDHandler sets the operation handler for the post reset resource type resource ID operation
+	AdministrativePostResetResourceTypeResourceIDHandler administrative.PostResetResourceTypeResourceIDHandler
 	// AdministrativePostSyncHandler sets the operation handler for the post sync operation
 	AdministrativePostSyncHandler administrative.PostSyncHandler
 	// DatacentersPutDatacentersDatacenterIDHandler sets the operation handler for the put datacenters datacenter ID operation
@@ -386,6 +457,9 @@ func (o *AndromedaAPI) Validate() error {
 	if o.AdministrativeDeleteQuotasProjectIDHandler == nil {
 		unregistered = append(unregistered, "administrative.DeleteQuotasProjectIDHandler")
 	}
+	if o.AdministrativeGetAgentsHandler == nil {
+		unregistered = append(unregistered, "administrative.GetAgentsHandler")
+	}
 	if o.AdministrativeGetCidrBlocksHandler == nil {
 		unregistered = append(unregistered, "administrative.GetCidrBlocksHandler")
 	}
@@ -401,6 +475,12 @@ func (o *AndromedaAPI) Validate() error {
 	if o.DomainsGetDomainsDomainIDHandler == nil {
 		unregistered = append(unregistered, "domains.GetDomainsDomainIDHandler")
 	}
+	if o.ConfigurationGetExportHandler == nil {
+		unregistered = append(unregistered, "configuration.GetExportHandler")
+	}
+	if o.AdministrativeGetF5DiffHandler == nil {
+		unregistered = append(unregistered, "administrative.GetF5DiffHandler")
+	}
 	if o.GeographicMapsGetGeomapsHandler == nil {
 		unregistered = append(unregistered, "geographic_maps.GetGeomapsHandler")
 	}
@@ -413,6 +493,9 @@ func (o *AndromedaAPI) Validate() error {
 	if o.MembersGetMembersMemberIDHandler == nil {
 		unregistered = append(unregistered, "members.GetMembersMemberIDHandler")
 	}
+	if o.MembersGetMembersMemberIDStatusHistoryHandler == nil {
+		unregistered = append(unregistered, "members.GetMembersMemberIDStatusHistoryHandler")
+	}
 	if o.MonitorsGetMonitorsHandler == nil {
 		unregistered = append(unregistered, "monitors.GetMonitorsHandler")
 	}
@@ -434,27 +517,57 @@ func (o *AndromedaAPI) Validate() error {
 	if o.AdministrativeGetQuotasProjectIDHandler == nil {
 		unregistered = append(unregistered, "administrative.GetQuotasProjectIDHandler")
 	}
+	if o.AdministrativeGetResetHandler == nil {
+		unregistered = append(unregistered, "administrative.GetResetHandler")
+	}
 	if o.AdministrativeGetServicesHandler == nil {
 		unregistered = append(unregistered, "administrative.GetServicesHandler")
 	}
 	if o.DatacentersPostDatacentersHandler == nil {
 		unregistered = append(unregistered, "datacenters.PostDatacentersHandler")
 	}
+	if o.DatacentersPostDatacentersDatacenterIDRetryHandler == nil {
+		unregistered = append(unregistered, "datacenters.PostDatacentersDatacenterIDRetryHandler")
+	}
 	if o.DomainsPostDomainsHandler == nil {
 		unregistered = append(unregistered, "domains.PostDomainsHandler")
 	}
+	if o.DomainsPostDomainsDomainIDRestoreHandler == nil {
+		unregistered = append(unregistered, "domains.PostDomainsDomainIDRestoreHandler")
+	}
+	if o.DomainsPostDomainsDomainIDRetryHandler == nil {
+		unregistered = append(unregistered, "domains.PostDomainsDomainIDRetryHandler")
+	}
 	if o.GeographicMapsPostGeomapsHandler == nil {
 		unregistered = append(unregistered, "geographic_maps.PostGeomapsHandler")
 	}
+	if o.GeographicMapsPostGeomapsGeomapIDRetryHandler == nil {
+		unregistered = append(unregistered, "geographic_maps.PostGeomapsGeomapIDRetryHandler")
+	}
+	if o.ConfigurationPostImportHandler == nil {
+		unregistered = append(unregistered, "configuration.PostImportHandler")
+	}
 	if o.MembersPostMembersHandler == nil {
 		unregistered = append(unregistered, "members.PostMembersHandler")
 	}
+	if o.MembersPostMembersMemberIDRetryHandler == nil {
+		unregistered = append(unregistered, "members.PostMembersMemberIDRetryHandler")
+	}
 	if o.MonitorsPostMonitorsHandler == nil {
 		unregistered = append(unregistered, "monitors.PostMonitorsHandler")
 	}
+	if o.MonitorsPostMonitorsMonitorIDRetryHandler == nil {
+		unregistered = append(unregistered, "monitors.PostMonitorsMonitorIDRetryHandler")
+	}
 	if o.PoolsPostPoolsHandler == nil {
 		unregistered = append(unregistered, "pools.PostPoolsHandler")
 	}
+	if o.PoolsPostPoolsPoolIDRetryHandler == nil {
+		unregistered = append(unregistered, "pools.PostPoolsPoolIDRetryHandler")
+	}
+	if o.AdministrativePostResetResourceTypeResourceIDHandler == nil {
+		unregistered = append(unregistered, "administrative.PostResetResourceTypeResourceIDHandler")
+	}
 	if o.AdministrativePostSyncHandler == nil {
 		unregistered = append(unregistered, "administrative.PostSyncHandler")
 	}
@@ -598,6 +711,10 @@ func (o *AndromedaAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/agents"] = administrative.NewGetAgents(o.context, o.AdministrativeGetAgentsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/cidr-blocks"] = administrative.NewGetCidrBlocks(o.context, o.AdministrativeGetCidrBlocksHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -618,6 +735,14 @@ func (o *AndromedaAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/export"] = configuration.NewGetExport(o.context, o.ConfigurationGetExportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/f5/diff"] = administrative.NewGetF5Diff(o.context, o.AdministrativeGetF5DiffHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/geomaps"] = geographic_maps.NewGetGeomaps(o.context, o.GeographicMapsGetGeomapsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -634,6 +759,10 @@ func (o *AndromedaAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/members/{member_id}/status-history"] = members.NewGetMembersMemberIDStatusHistory(o.context, o.MembersGetMembersMemberIDStatusHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/monitors"] = monitors.NewGetMonitors(o.context, o.MonitorsGetMonitorsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -662,6 +791,10 @@ func (o *AndromedaAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/reset"] = administrative.NewGetReset(o.context, o.AdministrativeGetResetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services"] = administrative.NewGetServices(o.context, o.AdministrativeGetServicesHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -670,26 +803,62 @@ func (o *AndromedaAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/datacenters/{datacenter_id}/retry"] = datacenters.NewPostDatacentersDatacenterIDRetry(o.context, o.DatacentersPostDatacentersDatacenterIDRetryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/domains"] = domains.NewPostDomains(o.context, o.DomainsPostDomainsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/domains/{domain_id}/restore"] = domains.NewPostDomainsDomainIDRestore(o.context, o.DomainsPostDomainsDomainIDRestoreHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/domains/{domain_id}/retry"] = domains.NewPostDomainsDomainIDRetry(o.context, o.DomainsPostDomainsDomainIDRetryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/geomaps"] = geographic_maps.NewPostGeomaps(o.context, o.GeographicMapsPostGeomapsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/geomaps/{geomap_id}/retry"] = geographic_maps.NewPostGeomapsGeomapIDRetry(o.context, o.GeographicMapsPostGeomapsGeomapIDRetryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/import"] = configuration.NewPostImport(o.context, o.ConfigurationPostImportHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/members"] = members.NewPostMembers(o.context, o.MembersPostMembersHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/members/{member_id}/retry"] = members.NewPostMembersMemberIDRetry(o.context, o.MembersPostMembersMemberIDRetryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/monitors"] = monitors.NewPostMonitors(o.context, o.MonitorsPostMonitorsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/monitors/{monitor_id}/retry"] = monitors.NewPostMonitorsMonitorIDRetry(o.context, o.MonitorsPostMonitorsMonitorIDRetryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/pools"] = pools.NewPostPools(o.context, o.PoolsPostPoolsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/pools/{pool_id}/retry"] = pools.NewPostPoolsPoolIDRetry(o.context, o.PoolsPostPoolsPoolIDRetryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/reset/{resource_type}/{resource_id}"] = administrative.NewPostResetResourceTypeResourceID(o.context, o.AdministrativePostResetResourceTypeResourceIDHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/sync"] = administrative.NewPostSync(o.context, o.AdministrativePostSyncHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
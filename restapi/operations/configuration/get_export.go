@@ -0,0 +1,62 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetExportHandlerFunc turns a function with the right signature into a get export handler
+type GetExportHandlerFunc func(GetExportParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetExportHandlerFunc) Handle(params GetExportParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetExportHandler interface for that can handle valid get export params
+type GetExportHandler interface {
+	Handle(GetExportParams) middleware.Responder
+}
+
+// NewGetExport creates a new http.Handler for the get export operation
+func NewGetExport(ctx *middleware.Context, handler GetExportHandler) *GetExport {
+	return &GetExport{Context: ctx, Handler: handler}
+}
+
+/*
+	GetExport swagger:route GET /export Configuration getExport
+
+# Export the configuration of a project
+
+Serialises all domains, pools, members, monitors, datacenters and geographic maps of a project into one versioned document. Resources reference each other by their ids within the document, public datacenters used by the project are included.
+*/
+type GetExport struct {
+	Context *middleware.Context
+	Handler GetExportHandler
+}
+
+func (o *GetExport) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetExportParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
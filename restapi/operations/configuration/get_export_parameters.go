@@ -0,0 +1,81 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetExportParams creates a new GetExportParams object
+//
+// There are no default values defined in the spec.
+func NewGetExportParams() GetExportParams {
+
+	return GetExportParams{}
+}
+
+// GetExportParams contains all the bound params for the get export operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetExport
+type GetExportParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Project to export, defaults to the project of the token.
+	  In: query
+	*/
+	ProjectID *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetExportParams() beforehand.
+func (o *GetExportParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qProjectID, qhkProjectID, _ := qs.GetOK("project_id")
+	if err := o.bindProjectID(qProjectID, qhkProjectID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindProjectID binds and validates parameter ProjectID from query.
+func (o *GetExportParams) bindProjectID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.ProjectID = &raw
+
+	return nil
+}
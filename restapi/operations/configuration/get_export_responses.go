@@ -0,0 +1,122 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetExportOKCode is the HTTP code returned for type GetExportOK
+const GetExportOKCode int = 200
+
+/*
+GetExportOK The configuration of the project.
+
+swagger:response getExportOK
+*/
+type GetExportOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Configuration `json:"body,omitempty"`
+}
+
+// NewGetExportOK creates GetExportOK with default headers values
+func NewGetExportOK() *GetExportOK {
+
+	return &GetExportOK{}
+}
+
+// WithPayload adds the payload to the get export o k response
+func (o *GetExportOK) WithPayload(payload *models.Configuration) *GetExportOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get export o k response
+func (o *GetExportOK) SetPayload(payload *models.Configuration) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetExportOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetExportDefault Unexpected Error
+
+swagger:response getExportDefault
+*/
+type GetExportDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetExportDefault creates GetExportDefault with default headers values
+func NewGetExportDefault(code int) *GetExportDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetExportDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get export default response
+func (o *GetExportDefault) WithStatusCode(code int) *GetExportDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get export default response
+func (o *GetExportDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get export default response
+func (o *GetExportDefault) WithPayload(payload *models.Error) *GetExportDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get export default response
+func (o *GetExportDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetExportDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostImportHandlerFunc turns a function with the right signature into a post import handler
+type PostImportHandlerFunc func(PostImportParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostImportHandlerFunc) Handle(params PostImportParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostImportHandler interface for that can handle valid post import params
+type PostImportHandler interface {
+	Handle(PostImportParams) middleware.Responder
+}
+
+// NewPostImport creates a new http.Handler for the post import operation
+func NewPostImport(ctx *middleware.Context, handler PostImportHandler) *PostImport {
+	return &PostImport{Context: ctx, Handler: handler}
+}
+
+/*
+	PostImport swagger:route POST /import Configuration postImport
+
+# Import the configuration of a project
+
+Creates or updates the resources of an exported configuration document in a project. Domains are matched by fqdn and provider, members by address and port within their pool, all other resources by name or, if unnamed, by their id. Public datacenters are referenced instead of copied if they exist.
+*/
+type PostImport struct {
+	Context *middleware.Context
+	Handler PostImportHandler
+}
+
+func (o *PostImport) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostImportParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// PostImportOKBody post import o k body
+//
+// swagger:model PostImportOKBody
+type PostImportOKBody struct {
+
+	// resources
+	Resources []*models.ImportedResource `json:"resources"`
+}
+
+// Validate validates this post import o k body
+func (o *PostImportOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateResources(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostImportOKBody) validateResources(formats strfmt.Registry) error {
+	if swag.IsZero(o.Resources) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Resources); i++ {
+		if swag.IsZero(o.Resources[i]) { // not required
+			continue
+		}
+
+		if o.Resources[i] != nil {
+			if err := o.Resources[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("postImportOK" + "." + "resources" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("postImportOK" + "." + "resources" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post import o k body based on the context it is used
+func (o *PostImportOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateResources(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostImportOKBody) contextValidateResources(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Resources); i++ {
+
+		if o.Resources[i] != nil {
+			if err := o.Resources[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("postImportOK" + "." + "resources" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("postImportOK" + "." + "resources" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostImportOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostImportOKBody) UnmarshalBinary(b []byte) error {
+	var res PostImportOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
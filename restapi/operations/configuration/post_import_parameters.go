@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// NewPostImportParams creates a new PostImportParams object
+//
+// There are no default values defined in the spec.
+func NewPostImportParams() PostImportParams {
+
+	return PostImportParams{}
+}
+
+// PostImportParams contains all the bound params for the post import operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters PostImport
+type PostImportParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: body
+	*/
+	Configuration *models.Configuration
+	/*Project to import into, defaults to the project of the token.
+	  In: query
+	*/
+	ProjectID *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPostImportParams() beforehand.
+func (o *PostImportParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.Configuration
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("configuration", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("configuration", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Configuration = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("configuration", "body", ""))
+	}
+
+	qProjectID, qhkProjectID, _ := qs.GetOK("project_id")
+	if err := o.bindProjectID(qProjectID, qhkProjectID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindProjectID binds and validates parameter ProjectID from query.
+func (o *PostImportParams) bindProjectID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.ProjectID = &raw
+
+	return nil
+}
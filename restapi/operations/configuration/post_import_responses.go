@@ -0,0 +1,212 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostImportOKCode is the HTTP code returned for type PostImportOK
+const PostImportOKCode int = 200
+
+/*
+PostImportOK The resources of the document and what the import did with them.
+
+swagger:response postImportOK
+*/
+type PostImportOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *PostImportOKBody `json:"body,omitempty"`
+}
+
+// NewPostImportOK creates PostImportOK with default headers values
+func NewPostImportOK() *PostImportOK {
+
+	return &PostImportOK{}
+}
+
+// WithPayload adds the payload to the post import o k response
+func (o *PostImportOK) WithPayload(payload *PostImportOKBody) *PostImportOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post import o k response
+func (o *PostImportOK) SetPayload(payload *PostImportOKBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostImportOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostImportBadRequestCode is the HTTP code returned for type PostImportBadRequest
+const PostImportBadRequestCode int = 400
+
+/*
+PostImportBadRequest Bad request
+
+swagger:response postImportBadRequest
+*/
+type PostImportBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostImportBadRequest creates PostImportBadRequest with default headers values
+func NewPostImportBadRequest() *PostImportBadRequest {
+
+	return &PostImportBadRequest{}
+}
+
+// WithPayload adds the payload to the post import bad request response
+func (o *PostImportBadRequest) WithPayload(payload *models.Error) *PostImportBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post import bad request response
+func (o *PostImportBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostImportBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostImportForbiddenCode is the HTTP code returned for type PostImportForbidden
+const PostImportForbiddenCode int = 403
+
+/*
+PostImportForbidden Quota exceeded
+
+swagger:response postImportForbidden
+*/
+type PostImportForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostImportForbidden creates PostImportForbidden with default headers values
+func NewPostImportForbidden() *PostImportForbidden {
+
+	return &PostImportForbidden{}
+}
+
+// WithPayload adds the payload to the post import forbidden response
+func (o *PostImportForbidden) WithPayload(payload *models.Error) *PostImportForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post import forbidden response
+func (o *PostImportForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostImportForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostImportDefault Unexpected Error
+
+swagger:response postImportDefault
+*/
+type PostImportDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostImportDefault creates PostImportDefault with default headers values
+func NewPostImportDefault(code int) *PostImportDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostImportDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post import default response
+func (o *PostImportDefault) WithStatusCode(code int) *PostImportDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post import default response
+func (o *PostImportDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post import default response
+func (o *PostImportDefault) WithPayload(payload *models.Error) *PostImportDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post import default response
+func (o *PostImportDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostImportDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datacenters
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostDatacentersDatacenterIDRetryHandlerFunc turns a function with the right signature into a post datacenters datacenter ID retry handler
+type PostDatacentersDatacenterIDRetryHandlerFunc func(PostDatacentersDatacenterIDRetryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostDatacentersDatacenterIDRetryHandlerFunc) Handle(params PostDatacentersDatacenterIDRetryParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostDatacentersDatacenterIDRetryHandler interface for that can handle valid post datacenters datacenter ID retry params
+type PostDatacentersDatacenterIDRetryHandler interface {
+	Handle(PostDatacentersDatacenterIDRetryParams) middleware.Responder
+}
+
+// NewPostDatacentersDatacenterIDRetry creates a new http.Handler for the post datacenters datacenter ID retry operation
+func NewPostDatacentersDatacenterIDRetry(ctx *middleware.Context, handler PostDatacentersDatacenterIDRetryHandler) *PostDatacentersDatacenterIDRetry {
+	return &PostDatacentersDatacenterIDRetry{Context: ctx, Handler: handler}
+}
+
+/*
+	PostDatacentersDatacenterIDRetry swagger:route POST /datacenters/{datacenter_id}/retry Datacenters postDatacentersDatacenterIdRetry
+
+# Retry provisioning of a datacenter
+
+Moves a datacenter with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.
+*/
+type PostDatacentersDatacenterIDRetry struct {
+	Context *middleware.Context
+	Handler PostDatacentersDatacenterIDRetryHandler
+}
+
+func (o *PostDatacentersDatacenterIDRetry) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostDatacentersDatacenterIDRetryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// PostDatacentersDatacenterIDRetryAcceptedBody post datacenters datacenter ID retry accepted body
+//
+// swagger:model PostDatacentersDatacenterIDRetryAcceptedBody
+type PostDatacentersDatacenterIDRetryAcceptedBody struct {
+
+	// datacenter
+	Datacenter *models.Datacenter `json:"datacenter,omitempty"`
+}
+
+// Validate validates this post datacenters datacenter ID retry accepted body
+func (o *PostDatacentersDatacenterIDRetryAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateDatacenter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostDatacentersDatacenterIDRetryAcceptedBody) validateDatacenter(formats strfmt.Registry) error {
+	if swag.IsZero(o.Datacenter) { // not required
+		return nil
+	}
+
+	if o.Datacenter != nil {
+		if err := o.Datacenter.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postDatacentersDatacenterIdRetryAccepted" + "." + "datacenter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postDatacentersDatacenterIdRetryAccepted" + "." + "datacenter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post datacenters datacenter ID retry accepted body based on the context it is used
+func (o *PostDatacentersDatacenterIDRetryAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateDatacenter(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostDatacentersDatacenterIDRetryAcceptedBody) contextValidateDatacenter(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Datacenter != nil {
+		if err := o.Datacenter.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postDatacentersDatacenterIdRetryAccepted" + "." + "datacenter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postDatacentersDatacenterIdRetryAccepted" + "." + "datacenter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostDatacentersDatacenterIDRetryAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostDatacentersDatacenterIDRetryAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostDatacentersDatacenterIDRetryAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
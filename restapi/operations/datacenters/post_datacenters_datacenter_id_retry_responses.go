@@ -0,0 +1,212 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datacenters
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostDatacentersDatacenterIDRetryAcceptedCode is the HTTP code returned for type PostDatacentersDatacenterIDRetryAccepted
+const PostDatacentersDatacenterIDRetryAcceptedCode int = 202
+
+/*
+PostDatacentersDatacenterIDRetryAccepted The datacenter will be provisioned again.
+
+swagger:response postDatacentersDatacenterIdRetryAccepted
+*/
+type PostDatacentersDatacenterIDRetryAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *PostDatacentersDatacenterIDRetryAcceptedBody `json:"body,omitempty"`
+}
+
+// NewPostDatacentersDatacenterIDRetryAccepted creates PostDatacentersDatacenterIDRetryAccepted with default headers values
+func NewPostDatacentersDatacenterIDRetryAccepted() *PostDatacentersDatacenterIDRetryAccepted {
+
+	return &PostDatacentersDatacenterIDRetryAccepted{}
+}
+
+// WithPayload adds the payload to the post datacenters datacenter Id retry accepted response
+func (o *PostDatacentersDatacenterIDRetryAccepted) WithPayload(payload *PostDatacentersDatacenterIDRetryAcceptedBody) *PostDatacentersDatacenterIDRetryAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post datacenters datacenter Id retry accepted response
+func (o *PostDatacentersDatacenterIDRetryAccepted) SetPayload(payload *PostDatacentersDatacenterIDRetryAcceptedBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostDatacentersDatacenterIDRetryAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostDatacentersDatacenterIDRetryNotFoundCode is the HTTP code returned for type PostDatacentersDatacenterIDRetryNotFound
+const PostDatacentersDatacenterIDRetryNotFoundCode int = 404
+
+/*
+PostDatacentersDatacenterIDRetryNotFound Not Found
+
+swagger:response postDatacentersDatacenterIdRetryNotFound
+*/
+type PostDatacentersDatacenterIDRetryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostDatacentersDatacenterIDRetryNotFound creates PostDatacentersDatacenterIDRetryNotFound with default headers values
+func NewPostDatacentersDatacenterIDRetryNotFound() *PostDatacentersDatacenterIDRetryNotFound {
+
+	return &PostDatacentersDatacenterIDRetryNotFound{}
+}
+
+// WithPayload adds the payload to the post datacenters datacenter Id retry not found response
+func (o *PostDatacentersDatacenterIDRetryNotFound) WithPayload(payload *models.Error) *PostDatacentersDatacenterIDRetryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post datacenters datacenter Id retry not found response
+func (o *PostDatacentersDatacenterIDRetryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostDatacentersDatacenterIDRetryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostDatacentersDatacenterIDRetryConflictCode is the HTTP code returned for type PostDatacentersDatacenterIDRetryConflict
+const PostDatacentersDatacenterIDRetryConflictCode int = 409
+
+/*
+PostDatacentersDatacenterIDRetryConflict Conflict
+
+swagger:response postDatacentersDatacenterIdRetryConflict
+*/
+type PostDatacentersDatacenterIDRetryConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostDatacentersDatacenterIDRetryConflict creates PostDatacentersDatacenterIDRetryConflict with default headers values
+func NewPostDatacentersDatacenterIDRetryConflict() *PostDatacentersDatacenterIDRetryConflict {
+
+	return &PostDatacentersDatacenterIDRetryConflict{}
+}
+
+// WithPayload adds the payload to the post datacenters datacenter Id retry conflict response
+func (o *PostDatacentersDatacenterIDRetryConflict) WithPayload(payload *models.Error) *PostDatacentersDatacenterIDRetryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post datacenters datacenter Id retry conflict response
+func (o *PostDatacentersDatacenterIDRetryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostDatacentersDatacenterIDRetryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostDatacentersDatacenterIDRetryDefault Unexpected Error
+
+swagger:response postDatacentersDatacenterIdRetryDefault
+*/
+type PostDatacentersDatacenterIDRetryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostDatacentersDatacenterIDRetryDefault creates PostDatacentersDatacenterIDRetryDefault with default headers values
+func NewPostDatacentersDatacenterIDRetryDefault(code int) *PostDatacentersDatacenterIDRetryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostDatacentersDatacenterIDRetryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post datacenters datacenter ID retry default response
+func (o *PostDatacentersDatacenterIDRetryDefault) WithStatusCode(code int) *PostDatacentersDatacenterIDRetryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post datacenters datacenter ID retry default response
+func (o *PostDatacentersDatacenterIDRetryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post datacenters datacenter ID retry default response
+func (o *PostDatacentersDatacenterIDRetryDefault) WithPayload(payload *models.Error) *PostDatacentersDatacenterIDRetryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post datacenters datacenter ID retry default response
+func (o *PostDatacentersDatacenterIDRetryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostDatacentersDatacenterIDRetryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
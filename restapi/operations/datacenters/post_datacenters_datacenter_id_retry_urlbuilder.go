@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datacenters
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// PostDatacentersDatacenterIDRetryURL generates an URL for the post datacenters datacenter ID retry operation
+type PostDatacentersDatacenterIDRetryURL struct {
+	DatacenterID strfmt.UUID
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *PostDatacentersDatacenterIDRetryURL) WithBasePath(bp string) *PostDatacentersDatacenterIDRetryURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *PostDatacentersDatacenterIDRetryURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *PostDatacentersDatacenterIDRetryURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/datacenters/{datacenter_id}/retry"
+
+	datacenterID := o.DatacenterID.String()
+	if datacenterID != "" {
+		_path = strings.Replace(_path, "{datacenter_id}", datacenterID, -1)
+	} else {
+		return nil, errors.New("datacenterId is required on PostDatacentersDatacenterIDRetryURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *PostDatacentersDatacenterIDRetryURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *PostDatacentersDatacenterIDRetryURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *PostDatacentersDatacenterIDRetryURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on PostDatacentersDatacenterIDRetryURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on PostDatacentersDatacenterIDRetryURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *PostDatacentersDatacenterIDRetryURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
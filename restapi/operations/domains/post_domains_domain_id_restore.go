@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostDomainsDomainIDRestoreHandlerFunc turns a function with the right signature into a post domains domain ID restore handler
+type PostDomainsDomainIDRestoreHandlerFunc func(PostDomainsDomainIDRestoreParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostDomainsDomainIDRestoreHandlerFunc) Handle(params PostDomainsDomainIDRestoreParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostDomainsDomainIDRestoreHandler interface for that can handle valid post domains domain ID restore params
+type PostDomainsDomainIDRestoreHandler interface {
+	Handle(PostDomainsDomainIDRestoreParams) middleware.Responder
+}
+
+// NewPostDomainsDomainIDRestore creates a new http.Handler for the post domains domain ID restore operation
+func NewPostDomainsDomainIDRestore(ctx *middleware.Context, handler PostDomainsDomainIDRestoreHandler) *PostDomainsDomainIDRestore {
+	return &PostDomainsDomainIDRestore{Context: ctx, Handler: handler}
+}
+
+/*
+	PostDomainsDomainIDRestore swagger:route POST /domains/{domain_id}/restore Domains postDomainsDomainIdRestore
+
+# Restore a deleted domain
+
+Restores a deleted domain together with its pool relations, as long as it has not been purged after the restore window (api_settings.domain_restore_window) elapsed. Restored domains count against the domain quota again.
+*/
+type PostDomainsDomainIDRestore struct {
+	Context *middleware.Context
+	Handler PostDomainsDomainIDRestoreHandler
+}
+
+func (o *PostDomainsDomainIDRestore) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostDomainsDomainIDRestoreParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// PostDomainsDomainIDRestoreAcceptedBody post domains domain ID restore accepted body
+//
+// swagger:model PostDomainsDomainIDRestoreAcceptedBody
+type PostDomainsDomainIDRestoreAcceptedBody struct {
+
+	// domain
+	Domain *models.Domain `json:"domain,omitempty"`
+}
+
+// Validate validates this post domains domain ID restore accepted body
+func (o *PostDomainsDomainIDRestoreAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateDomain(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostDomainsDomainIDRestoreAcceptedBody) validateDomain(formats strfmt.Registry) error {
+	if swag.IsZero(o.Domain) { // not required
+		return nil
+	}
+
+	if o.Domain != nil {
+		if err := o.Domain.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postDomainsDomainIdRestoreAccepted" + "." + "domain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postDomainsDomainIdRestoreAccepted" + "." + "domain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post domains domain ID restore accepted body based on the context it is used
+func (o *PostDomainsDomainIDRestoreAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateDomain(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostDomainsDomainIDRestoreAcceptedBody) contextValidateDomain(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Domain != nil {
+		if err := o.Domain.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postDomainsDomainIdRestoreAccepted" + "." + "domain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postDomainsDomainIdRestoreAccepted" + "." + "domain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostDomainsDomainIDRestoreAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostDomainsDomainIDRestoreAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostDomainsDomainIDRestoreAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,212 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostDomainsDomainIDRestoreAcceptedCode is the HTTP code returned for type PostDomainsDomainIDRestoreAccepted
+const PostDomainsDomainIDRestoreAcceptedCode int = 202
+
+/*
+PostDomainsDomainIDRestoreAccepted The domain will be provisioned again.
+
+swagger:response postDomainsDomainIdRestoreAccepted
+*/
+type PostDomainsDomainIDRestoreAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *PostDomainsDomainIDRestoreAcceptedBody `json:"body,omitempty"`
+}
+
+// NewPostDomainsDomainIDRestoreAccepted creates PostDomainsDomainIDRestoreAccepted with default headers values
+func NewPostDomainsDomainIDRestoreAccepted() *PostDomainsDomainIDRestoreAccepted {
+
+	return &PostDomainsDomainIDRestoreAccepted{}
+}
+
+// WithPayload adds the payload to the post domains domain Id restore accepted response
+func (o *PostDomainsDomainIDRestoreAccepted) WithPayload(payload *PostDomainsDomainIDRestoreAcceptedBody) *PostDomainsDomainIDRestoreAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post domains domain Id restore accepted response
+func (o *PostDomainsDomainIDRestoreAccepted) SetPayload(payload *PostDomainsDomainIDRestoreAcceptedBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostDomainsDomainIDRestoreAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostDomainsDomainIDRestoreNotFoundCode is the HTTP code returned for type PostDomainsDomainIDRestoreNotFound
+const PostDomainsDomainIDRestoreNotFoundCode int = 404
+
+/*
+PostDomainsDomainIDRestoreNotFound Not Found
+
+swagger:response postDomainsDomainIdRestoreNotFound
+*/
+type PostDomainsDomainIDRestoreNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostDomainsDomainIDRestoreNotFound creates PostDomainsDomainIDRestoreNotFound with default headers values
+func NewPostDomainsDomainIDRestoreNotFound() *PostDomainsDomainIDRestoreNotFound {
+
+	return &PostDomainsDomainIDRestoreNotFound{}
+}
+
+// WithPayload adds the payload to the post domains domain Id restore not found response
+func (o *PostDomainsDomainIDRestoreNotFound) WithPayload(payload *models.Error) *PostDomainsDomainIDRestoreNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post domains domain Id restore not found response
+func (o *PostDomainsDomainIDRestoreNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostDomainsDomainIDRestoreNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostDomainsDomainIDRestoreConflictCode is the HTTP code returned for type PostDomainsDomainIDRestoreConflict
+const PostDomainsDomainIDRestoreConflictCode int = 409
+
+/*
+PostDomainsDomainIDRestoreConflict Conflict
+
+swagger:response postDomainsDomainIdRestoreConflict
+*/
+type PostDomainsDomainIDRestoreConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostDomainsDomainIDRestoreConflict creates PostDomainsDomainIDRestoreConflict with default headers values
+func NewPostDomainsDomainIDRestoreConflict() *PostDomainsDomainIDRestoreConflict {
+
+	return &PostDomainsDomainIDRestoreConflict{}
+}
+
+// WithPayload adds the payload to the post domains domain Id restore conflict response
+func (o *PostDomainsDomainIDRestoreConflict) WithPayload(payload *models.Error) *PostDomainsDomainIDRestoreConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post domains domain Id restore conflict response
+func (o *PostDomainsDomainIDRestoreConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostDomainsDomainIDRestoreConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostDomainsDomainIDRestoreDefault Unexpected Error
+
+swagger:response postDomainsDomainIdRestoreDefault
+*/
+type PostDomainsDomainIDRestoreDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostDomainsDomainIDRestoreDefault creates PostDomainsDomainIDRestoreDefault with default headers values
+func NewPostDomainsDomainIDRestoreDefault(code int) *PostDomainsDomainIDRestoreDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostDomainsDomainIDRestoreDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post domains domain ID restore default response
+func (o *PostDomainsDomainIDRestoreDefault) WithStatusCode(code int) *PostDomainsDomainIDRestoreDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post domains domain ID restore default response
+func (o *PostDomainsDomainIDRestoreDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post domains domain ID restore default response
+func (o *PostDomainsDomainIDRestoreDefault) WithPayload(payload *models.Error) *PostDomainsDomainIDRestoreDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post domains domain ID restore default response
+func (o *PostDomainsDomainIDRestoreDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostDomainsDomainIDRestoreDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostDomainsDomainIDRetryHandlerFunc turns a function with the right signature into a post domains domain ID retry handler
+type PostDomainsDomainIDRetryHandlerFunc func(PostDomainsDomainIDRetryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostDomainsDomainIDRetryHandlerFunc) Handle(params PostDomainsDomainIDRetryParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostDomainsDomainIDRetryHandler interface for that can handle valid post domains domain ID retry params
+type PostDomainsDomainIDRetryHandler interface {
+	Handle(PostDomainsDomainIDRetryParams) middleware.Responder
+}
+
+// NewPostDomainsDomainIDRetry creates a new http.Handler for the post domains domain ID retry operation
+func NewPostDomainsDomainIDRetry(ctx *middleware.Context, handler PostDomainsDomainIDRetryHandler) *PostDomainsDomainIDRetry {
+	return &PostDomainsDomainIDRetry{Context: ctx, Handler: handler}
+}
+
+/*
+	PostDomainsDomainIDRetry swagger:route POST /domains/{domain_id}/retry Domains postDomainsDomainIdRetry
+
+# Retry provisioning of a domain
+
+Moves a domain with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.
+*/
+type PostDomainsDomainIDRetry struct {
+	Context *middleware.Context
+	Handler PostDomainsDomainIDRetryHandler
+}
+
+func (o *PostDomainsDomainIDRetry) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostDomainsDomainIDRetryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// PostDomainsDomainIDRetryAcceptedBody post domains domain ID retry accepted body
+//
+// swagger:model PostDomainsDomainIDRetryAcceptedBody
+type PostDomainsDomainIDRetryAcceptedBody struct {
+
+	// domain
+	Domain *models.Domain `json:"domain,omitempty"`
+}
+
+// Validate validates this post domains domain ID retry accepted body
+func (o *PostDomainsDomainIDRetryAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateDomain(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostDomainsDomainIDRetryAcceptedBody) validateDomain(formats strfmt.Registry) error {
+	if swag.IsZero(o.Domain) { // not required
+		return nil
+	}
+
+	if o.Domain != nil {
+		if err := o.Domain.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postDomainsDomainIdRetryAccepted" + "." + "domain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postDomainsDomainIdRetryAccepted" + "." + "domain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post domains domain ID retry accepted body based on the context it is used
+func (o *PostDomainsDomainIDRetryAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateDomain(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostDomainsDomainIDRetryAcceptedBody) contextValidateDomain(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Domain != nil {
+		if err := o.Domain.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postDomainsDomainIdRetryAccepted" + "." + "domain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postDomainsDomainIdRetryAccepted" + "." + "domain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostDomainsDomainIDRetryAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostDomainsDomainIDRetryAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostDomainsDomainIDRetryAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
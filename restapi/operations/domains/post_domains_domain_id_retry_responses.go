@@ -0,0 +1,212 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostDomainsDomainIDRetryAcceptedCode is the HTTP code returned for type PostDomainsDomainIDRetryAccepted
+const PostDomainsDomainIDRetryAcceptedCode int = 202
+
+/*
+PostDomainsDomainIDRetryAccepted The domain will be provisioned again.
+
+swagger:response postDomainsDomainIdRetryAccepted
+*/
+type PostDomainsDomainIDRetryAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *PostDomainsDomainIDRetryAcceptedBody `json:"body,omitempty"`
+}
+
+// NewPostDomainsDomainIDRetryAccepted creates PostDomainsDomainIDRetryAccepted with default headers values
+func NewPostDomainsDomainIDRetryAccepted() *PostDomainsDomainIDRetryAccepted {
+
+	return &PostDomainsDomainIDRetryAccepted{}
+}
+
+// WithPayload adds the payload to the post domains domain Id retry accepted response
+func (o *PostDomainsDomainIDRetryAccepted) WithPayload(payload *PostDomainsDomainIDRetryAcceptedBody) *PostDomainsDomainIDRetryAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post domains domain Id retry accepted response
+func (o *PostDomainsDomainIDRetryAccepted) SetPayload(payload *PostDomainsDomainIDRetryAcceptedBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostDomainsDomainIDRetryAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostDomainsDomainIDRetryNotFoundCode is the HTTP code returned for type PostDomainsDomainIDRetryNotFound
+const PostDomainsDomainIDRetryNotFoundCode int = 404
+
+/*
+PostDomainsDomainIDRetryNotFound Not Found
+
+swagger:response postDomainsDomainIdRetryNotFound
+*/
+type PostDomainsDomainIDRetryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostDomainsDomainIDRetryNotFound creates PostDomainsDomainIDRetryNotFound with default headers values
+func NewPostDomainsDomainIDRetryNotFound() *PostDomainsDomainIDRetryNotFound {
+
+	return &PostDomainsDomainIDRetryNotFound{}
+}
+
+// WithPayload adds the payload to the post domains domain Id retry not found response
+func (o *PostDomainsDomainIDRetryNotFound) WithPayload(payload *models.Error) *PostDomainsDomainIDRetryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post domains domain Id retry not found response
+func (o *PostDomainsDomainIDRetryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostDomainsDomainIDRetryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostDomainsDomainIDRetryConflictCode is the HTTP code returned for type PostDomainsDomainIDRetryConflict
+const PostDomainsDomainIDRetryConflictCode int = 409
+
+/*
+PostDomainsDomainIDRetryConflict Conflict
+
+swagger:response postDomainsDomainIdRetryConflict
+*/
+type PostDomainsDomainIDRetryConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostDomainsDomainIDRetryConflict creates PostDomainsDomainIDRetryConflict with default headers values
+func NewPostDomainsDomainIDRetryConflict() *PostDomainsDomainIDRetryConflict {
+
+	return &PostDomainsDomainIDRetryConflict{}
+}
+
+// WithPayload adds the payload to the post domains domain Id retry conflict response
+func (o *PostDomainsDomainIDRetryConflict) WithPayload(payload *models.Error) *PostDomainsDomainIDRetryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post domains domain Id retry conflict response
+func (o *PostDomainsDomainIDRetryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostDomainsDomainIDRetryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostDomainsDomainIDRetryDefault Unexpected Error
+
+swagger:response postDomainsDomainIdRetryDefault
+*/
+type PostDomainsDomainIDRetryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostDomainsDomainIDRetryDefault creates PostDomainsDomainIDRetryDefault with default headers values
+func NewPostDomainsDomainIDRetryDefault(code int) *PostDomainsDomainIDRetryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostDomainsDomainIDRetryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post domains domain ID retry default response
+func (o *PostDomainsDomainIDRetryDefault) WithStatusCode(code int) *PostDomainsDomainIDRetryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post domains domain ID retry default response
+func (o *PostDomainsDomainIDRetryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post domains domain ID retry default response
+func (o *PostDomainsDomainIDRetryDefault) WithPayload(payload *models.Error) *PostDomainsDomainIDRetryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post domains domain ID retry default response
+func (o *PostDomainsDomainIDRetryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostDomainsDomainIDRetryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
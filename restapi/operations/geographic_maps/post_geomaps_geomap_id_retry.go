@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package geographic_maps
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostGeomapsGeomapIDRetryHandlerFunc turns a function with the right signature into a post geomaps geomap ID retry handler
+type PostGeomapsGeomapIDRetryHandlerFunc func(PostGeomapsGeomapIDRetryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostGeomapsGeomapIDRetryHandlerFunc) Handle(params PostGeomapsGeomapIDRetryParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostGeomapsGeomapIDRetryHandler interface for that can handle valid post geomaps geomap ID retry params
+type PostGeomapsGeomapIDRetryHandler interface {
+	Handle(PostGeomapsGeomapIDRetryParams) middleware.Responder
+}
+
+// NewPostGeomapsGeomapIDRetry creates a new http.Handler for the post geomaps geomap ID retry operation
+func NewPostGeomapsGeomapIDRetry(ctx *middleware.Context, handler PostGeomapsGeomapIDRetryHandler) *PostGeomapsGeomapIDRetry {
+	return &PostGeomapsGeomapIDRetry{Context: ctx, Handler: handler}
+}
+
+/*
+	PostGeomapsGeomapIDRetry swagger:route POST /geomaps/{geomap_id}/retry Geographic maps postGeomapsGeomapIdRetry
+
+# Retry provisioning of a geographic map
+
+Moves a geographic map with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.
+*/
+type PostGeomapsGeomapIDRetry struct {
+	Context *middleware.Context
+	Handler PostGeomapsGeomapIDRetryHandler
+}
+
+func (o *PostGeomapsGeomapIDRetry) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostGeomapsGeomapIDRetryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// PostGeomapsGeomapIDRetryAcceptedBody post geomaps geomap ID retry accepted body
+//
+// swagger:model PostGeomapsGeomapIDRetryAcceptedBody
+type PostGeomapsGeomapIDRetryAcceptedBody struct {
+
+	// geomap
+	Geomap *models.Geomap `json:"geomap,omitempty"`
+}
+
+// Validate validates this post geomaps geomap ID retry accepted body
+func (o *PostGeomapsGeomapIDRetryAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateGeomap(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostGeomapsGeomapIDRetryAcceptedBody) validateGeomap(formats strfmt.Registry) error {
+	if swag.IsZero(o.Geomap) { // not required
+		return nil
+	}
+
+	if o.Geomap != nil {
+		if err := o.Geomap.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postGeomapsGeomapIdRetryAccepted" + "." + "geomap")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postGeomapsGeomapIdRetryAccepted" + "." + "geomap")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post geomaps geomap ID retry accepted body based on the context it is used
+func (o *PostGeomapsGeomapIDRetryAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateGeomap(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostGeomapsGeomapIDRetryAcceptedBody) contextValidateGeomap(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Geomap != nil {
+		if err := o.Geomap.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postGeomapsGeomapIdRetryAccepted" + "." + "geomap")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postGeomapsGeomapIdRetryAccepted" + "." + "geomap")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostGeomapsGeomapIDRetryAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostGeomapsGeomapIDRetryAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostGeomapsGeomapIDRetryAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
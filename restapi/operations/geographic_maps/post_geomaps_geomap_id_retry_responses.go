@@ -0,0 +1,212 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package geographic_maps
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostGeomapsGeomapIDRetryAcceptedCode is the HTTP code returned for type PostGeomapsGeomapIDRetryAccepted
+const PostGeomapsGeomapIDRetryAcceptedCode int = 202
+
+/*
+PostGeomapsGeomapIDRetryAccepted The geographic map will be provisioned again.
+
+swagger:response postGeomapsGeomapIdRetryAccepted
+*/
+type PostGeomapsGeomapIDRetryAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *PostGeomapsGeomapIDRetryAcceptedBody `json:"body,omitempty"`
+}
+
+// NewPostGeomapsGeomapIDRetryAccepted creates PostGeomapsGeomapIDRetryAccepted with default headers values
+func NewPostGeomapsGeomapIDRetryAccepted() *PostGeomapsGeomapIDRetryAccepted {
+
+	return &PostGeomapsGeomapIDRetryAccepted{}
+}
+
+// WithPayload adds the payload to the post geomaps geomap Id retry accepted response
+func (o *PostGeomapsGeomapIDRetryAccepted) WithPayload(payload *PostGeomapsGeomapIDRetryAcceptedBody) *PostGeomapsGeomapIDRetryAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post geomaps geomap Id retry accepted response
+func (o *PostGeomapsGeomapIDRetryAccepted) SetPayload(payload *PostGeomapsGeomapIDRetryAcceptedBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostGeomapsGeomapIDRetryAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostGeomapsGeomapIDRetryNotFoundCode is the HTTP code returned for type PostGeomapsGeomapIDRetryNotFound
+const PostGeomapsGeomapIDRetryNotFoundCode int = 404
+
+/*
+PostGeomapsGeomapIDRetryNotFound Not Found
+
+swagger:response postGeomapsGeomapIdRetryNotFound
+*/
+type PostGeomapsGeomapIDRetryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostGeomapsGeomapIDRetryNotFound creates PostGeomapsGeomapIDRetryNotFound with default headers values
+func NewPostGeomapsGeomapIDRetryNotFound() *PostGeomapsGeomapIDRetryNotFound {
+
+	return &PostGeomapsGeomapIDRetryNotFound{}
+}
+
+// WithPayload adds the payload to the post geomaps geomap Id retry not found response
+func (o *PostGeomapsGeomapIDRetryNotFound) WithPayload(payload *models.Error) *PostGeomapsGeomapIDRetryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post geomaps geomap Id retry not found response
+func (o *PostGeomapsGeomapIDRetryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostGeomapsGeomapIDRetryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostGeomapsGeomapIDRetryConflictCode is the HTTP code returned for type PostGeomapsGeomapIDRetryConflict
+const PostGeomapsGeomapIDRetryConflictCode int = 409
+
+/*
+PostGeomapsGeomapIDRetryConflict Conflict
+
+swagger:response postGeomapsGeomapIdRetryConflict
+*/
+type PostGeomapsGeomapIDRetryConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostGeomapsGeomapIDRetryConflict creates PostGeomapsGeomapIDRetryConflict with default headers values
+func NewPostGeomapsGeomapIDRetryConflict() *PostGeomapsGeomapIDRetryConflict {
+
+	return &PostGeomapsGeomapIDRetryConflict{}
+}
+
+// WithPayload adds the payload to the post geomaps geomap Id retry conflict response
+func (o *PostGeomapsGeomapIDRetryConflict) WithPayload(payload *models.Error) *PostGeomapsGeomapIDRetryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post geomaps geomap Id retry conflict response
+func (o *PostGeomapsGeomapIDRetryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostGeomapsGeomapIDRetryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostGeomapsGeomapIDRetryDefault Unexpected Error
+
+swagger:response postGeomapsGeomapIdRetryDefault
+*/
+type PostGeomapsGeomapIDRetryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostGeomapsGeomapIDRetryDefault creates PostGeomapsGeomapIDRetryDefault with default headers values
+func NewPostGeomapsGeomapIDRetryDefault(code int) *PostGeomapsGeomapIDRetryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostGeomapsGeomapIDRetryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post geomaps geomap ID retry default response
+func (o *PostGeomapsGeomapIDRetryDefault) WithStatusCode(code int) *PostGeomapsGeomapIDRetryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post geomaps geomap ID retry default response
+func (o *PostGeomapsGeomapIDRetryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post geomaps geomap ID retry default response
+func (o *PostGeomapsGeomapIDRetryDefault) WithPayload(payload *models.Error) *PostGeomapsGeomapIDRetryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post geomaps geomap ID retry default response
+func (o *PostGeomapsGeomapIDRetryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostGeomapsGeomapIDRetryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
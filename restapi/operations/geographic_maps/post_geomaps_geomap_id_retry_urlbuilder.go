@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package geographic_maps
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// PostGeomapsGeomapIDRetryURL generates an URL for the post geomaps geomap ID retry operation
+type PostGeomapsGeomapIDRetryURL struct {
+	GeomapID strfmt.UUID
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *PostGeomapsGeomapIDRetryURL) WithBasePath(bp string) *PostGeomapsGeomapIDRetryURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *PostGeomapsGeomapIDRetryURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *PostGeomapsGeomapIDRetryURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/geomaps/{geomap_id}/retry"
+
+	geomapID := o.GeomapID.String()
+	if geomapID != "" {
+		_path = strings.Replace(_path, "{geomap_id}", geomapID, -1)
+	} else {
+		return nil, errors.New("geomapId is required on PostGeomapsGeomapIDRetryURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *PostGeomapsGeomapIDRetryURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *PostGeomapsGeomapIDRetryURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *PostGeomapsGeomapIDRetryURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on PostGeomapsGeomapIDRetryURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on PostGeomapsGeomapIDRetryURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *PostGeomapsGeomapIDRetryURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
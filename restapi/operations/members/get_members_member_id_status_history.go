@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package members
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetMembersMemberIDStatusHistoryHandlerFunc turns a function with the right signature into a get members member ID status history handler
+type GetMembersMemberIDStatusHistoryHandlerFunc func(GetMembersMemberIDStatusHistoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetMembersMemberIDStatusHistoryHandlerFunc) Handle(params GetMembersMemberIDStatusHistoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetMembersMemberIDStatusHistoryHandler interface for that can handle valid get members member ID status history params
+type GetMembersMemberIDStatusHistoryHandler interface {
+	Handle(GetMembersMemberIDStatusHistoryParams) middleware.Responder
+}
+
+// NewGetMembersMemberIDStatusHistory creates a new http.Handler for the get members member ID status history operation
+func NewGetMembersMemberIDStatusHistory(ctx *middleware.Context, handler GetMembersMemberIDStatusHistoryHandler) *GetMembersMemberIDStatusHistory {
+	return &GetMembersMemberIDStatusHistory{Context: ctx, Handler: handler}
+}
+
+/*
+	GetMembersMemberIDStatusHistory swagger:route GET /members/{member_id}/status-history Members getMembersMemberIdStatusHistory
+
+# Show member status history
+
+Lists the status transitions of a member, newest first.
+*/
+type GetMembersMemberIDStatusHistory struct {
+	Context *middleware.Context
+	Handler GetMembersMemberIDStatusHistoryHandler
+}
+
+func (o *GetMembersMemberIDStatusHistory) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetMembersMemberIDStatusHistoryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// GetMembersMemberIDStatusHistoryOKBody get members member ID status history o k body
+//
+// swagger:model GetMembersMemberIDStatusHistoryOKBody
+type GetMembersMemberIDStatusHistoryOKBody struct {
+
+	// status history
+	StatusHistory []*models.MemberStatusTransition `json:"status_history"`
+}
+
+// Validate validates this get members member ID status history o k body
+func (o *GetMembersMemberIDStatusHistoryOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatusHistory(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetMembersMemberIDStatusHistoryOKBody) validateStatusHistory(formats strfmt.Registry) error {
+	if swag.IsZero(o.StatusHistory) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.StatusHistory); i++ {
+		if swag.IsZero(o.StatusHistory[i]) { // not required
+			continue
+		}
+
+		if o.StatusHistory[i] != nil {
+			if err := o.StatusHistory[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getMembersMemberIdStatusHistoryOK" + "." + "status_history" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("getMembersMemberIdStatusHistoryOK" + "." + "status_history" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get members member ID status history o k body based on the context it is used
+func (o *GetMembersMemberIDStatusHistoryOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateStatusHistory(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetMembersMemberIDStatusHistoryOKBody) contextValidateStatusHistory(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.StatusHistory); i++ {
+
+		if o.StatusHistory[i] != nil {
+			if err := o.StatusHistory[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getMembersMemberIdStatusHistoryOK" + "." + "status_history" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("getMembersMemberIdStatusHistoryOK" + "." + "status_history" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetMembersMemberIDStatusHistoryOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetMembersMemberIDStatusHistoryOKBody) UnmarshalBinary(b []byte) error {
+	var res GetMembersMemberIDStatusHistoryOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,223 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package members
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetMembersMemberIDStatusHistoryParams creates a new GetMembersMemberIDStatusHistoryParams object
+//
+// There are no default values defined in the spec.
+func NewGetMembersMemberIDStatusHistoryParams() GetMembersMemberIDStatusHistoryParams {
+
+	return GetMembersMemberIDStatusHistoryParams{}
+}
+
+// GetMembersMemberIDStatusHistoryParams contains all the bound params for the get members member ID status history operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetMembersMemberIDStatusHistory
+type GetMembersMemberIDStatusHistoryParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Sets the page size.
+	  In: query
+	*/
+	Limit *int64
+	/*The UUID of the member
+	  Required: true
+	  In: path
+	*/
+	MemberID strfmt.UUID
+	/*Only transitions at or after this UTC date and timestamp.
+	  In: query
+	*/
+	Since *strfmt.DateTime
+	/*Only transitions before this UTC date and timestamp.
+	  In: query
+	*/
+	Until *strfmt.DateTime
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetMembersMemberIDStatusHistoryParams() beforehand.
+func (o *GetMembersMemberIDStatusHistoryParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rMemberID, rhkMemberID, _ := route.Params.GetOK("member_id")
+	if err := o.bindMemberID(rMemberID, rhkMemberID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSince, qhkSince, _ := qs.GetOK("since")
+	if err := o.bindSince(qSince, qhkSince, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qUntil, qhkUntil, _ := qs.GetOK("until")
+	if err := o.bindUntil(qUntil, qhkUntil, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *GetMembersMemberIDStatusHistoryParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	return nil
+}
+
+// bindMemberID binds and validates parameter MemberID from path.
+func (o *GetMembersMemberIDStatusHistoryParams) bindMemberID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("member_id", "path", "strfmt.UUID", raw)
+	}
+	o.MemberID = *(value.(*strfmt.UUID))
+
+	if err := o.validateMemberID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMemberID carries on validations for parameter MemberID
+func (o *GetMembersMemberIDStatusHistoryParams) validateMemberID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("member_id", "path", "uuid", o.MemberID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindSince binds and validates parameter Since from query.
+func (o *GetMembersMemberIDStatusHistoryParams) bindSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("since", "query", "strfmt.DateTime", raw)
+	}
+	o.Since = (value.(*strfmt.DateTime))
+
+	if err := o.validateSince(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSince carries on validations for parameter Since
+func (o *GetMembersMemberIDStatusHistoryParams) validateSince(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("since", "query", "date-time", o.Since.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindUntil binds and validates parameter Until from query.
+func (o *GetMembersMemberIDStatusHistoryParams) bindUntil(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("until", "query", "strfmt.DateTime", raw)
+	}
+	o.Until = (value.(*strfmt.DateTime))
+
+	if err := o.validateUntil(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateUntil carries on validations for parameter Until
+func (o *GetMembersMemberIDStatusHistoryParams) validateUntil(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("until", "query", "date-time", o.Until.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package members
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// GetMembersMemberIDStatusHistoryOKCode is the HTTP code returned for type GetMembersMemberIDStatusHistoryOK
+const GetMembersMemberIDStatusHistoryOKCode int = 200
+
+/*
+GetMembersMemberIDStatusHistoryOK A JSON array of status transitions.
+
+swagger:response getMembersMemberIdStatusHistoryOK
+*/
+type GetMembersMemberIDStatusHistoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *GetMembersMemberIDStatusHistoryOKBody `json:"body,omitempty"`
+}
+
+// NewGetMembersMemberIDStatusHistoryOK creates GetMembersMemberIDStatusHistoryOK with default headers values
+func NewGetMembersMemberIDStatusHistoryOK() *GetMembersMemberIDStatusHistoryOK {
+
+	return &GetMembersMemberIDStatusHistoryOK{}
+}
+
+// WithPayload adds the payload to the get members member Id status history o k response
+func (o *GetMembersMemberIDStatusHistoryOK) WithPayload(payload *GetMembersMemberIDStatusHistoryOKBody) *GetMembersMemberIDStatusHistoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get members member Id status history o k response
+func (o *GetMembersMemberIDStatusHistoryOK) SetPayload(payload *GetMembersMemberIDStatusHistoryOKBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetMembersMemberIDStatusHistoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetMembersMemberIDStatusHistoryNotFoundCode is the HTTP code returned for type GetMembersMemberIDStatusHistoryNotFound
+const GetMembersMemberIDStatusHistoryNotFoundCode int = 404
+
+/*
+GetMembersMemberIDStatusHistoryNotFound Not Found
+
+swagger:response getMembersMemberIdStatusHistoryNotFound
+*/
+type GetMembersMemberIDStatusHistoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetMembersMemberIDStatusHistoryNotFound creates GetMembersMemberIDStatusHistoryNotFound with default headers values
+func NewGetMembersMemberIDStatusHistoryNotFound() *GetMembersMemberIDStatusHistoryNotFound {
+
+	return &GetMembersMemberIDStatusHistoryNotFound{}
+}
+
+// WithPayload adds the payload to the get members member Id status history not found response
+func (o *GetMembersMemberIDStatusHistoryNotFound) WithPayload(payload *models.Error) *GetMembersMemberIDStatusHistoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get members member Id status history not found response
+func (o *GetMembersMemberIDStatusHistoryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetMembersMemberIDStatusHistoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetMembersMemberIDStatusHistoryDefault Unexpected Error
+
+swagger:response getMembersMemberIdStatusHistoryDefault
+*/
+type GetMembersMemberIDStatusHistoryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetMembersMemberIDStatusHistoryDefault creates GetMembersMemberIDStatusHistoryDefault with default headers values
+func NewGetMembersMemberIDStatusHistoryDefault(code int) *GetMembersMemberIDStatusHistoryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetMembersMemberIDStatusHistoryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get members member ID status history default response
+func (o *GetMembersMemberIDStatusHistoryDefault) WithStatusCode(code int) *GetMembersMemberIDStatusHistoryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get members member ID status history default response
+func (o *GetMembersMemberIDStatusHistoryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get members member ID status history default response
+func (o *GetMembersMemberIDStatusHistoryDefault) WithPayload(payload *models.Error) *GetMembersMemberIDStatusHistoryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get members member ID status history default response
+func (o *GetMembersMemberIDStatusHistoryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetMembersMemberIDStatusHistoryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package members
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// GetMembersMemberIDStatusHistoryURL generates an URL for the get members member ID status history operation
+type GetMembersMemberIDStatusHistoryURL struct {
+	MemberID strfmt.UUID
+
+	Limit *int64
+	Since *strfmt.DateTime
+	Until *strfmt.DateTime
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetMembersMemberIDStatusHistoryURL) WithBasePath(bp string) *GetMembersMemberIDStatusHistoryURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetMembersMemberIDStatusHistoryURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *GetMembersMemberIDStatusHistoryURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/members/{member_id}/status-history"
+
+	memberID := o.MemberID.String()
+	if memberID != "" {
+		_path = strings.Replace(_path, "{member_id}", memberID, -1)
+	} else {
+		return nil, errors.New("memberId is required on GetMembersMemberIDStatusHistoryURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var sinceQ string
+	if o.Since != nil {
+		sinceQ = o.Since.String()
+	}
+	if sinceQ != "" {
+		qs.Set("since", sinceQ)
+	}
+
+	var untilQ string
+	if o.Until != nil {
+		untilQ = o.Until.String()
+	}
+	if untilQ != "" {
+		qs.Set("until", untilQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *GetMembersMemberIDStatusHistoryURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *GetMembersMemberIDStatusHistoryURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *GetMembersMemberIDStatusHistoryURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on GetMembersMemberIDStatusHistoryURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on GetMembersMemberIDStatusHistoryURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *GetMembersMemberIDStatusHistoryURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package members
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostMembersMemberIDRetryHandlerFunc turns a function with the right signature into a post members member ID retry handler
+type PostMembersMemberIDRetryHandlerFunc func(PostMembersMemberIDRetryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostMembersMemberIDRetryHandlerFunc) Handle(params PostMembersMemberIDRetryParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostMembersMemberIDRetryHandler interface for that can handle valid post members member ID retry params
+type PostMembersMemberIDRetryHandler interface {
+	Handle(PostMembersMemberIDRetryParams) middleware.Responder
+}
+
+// NewPostMembersMemberIDRetry creates a new http.Handler for the post members member ID retry operation
+func NewPostMembersMemberIDRetry(ctx *middleware.Context, handler PostMembersMemberIDRetryHandler) *PostMembersMemberIDRetry {
+	return &PostMembersMemberIDRetry{Context: ctx, Handler: handler}
+}
+
+/*
+	PostMembersMemberIDRetry swagger:route POST /members/{member_id}/retry Members postMembersMemberIdRetry
+
+# Retry provisioning of a member
+
+Moves a member with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.
+*/
+type PostMembersMemberIDRetry struct {
+	Context *middleware.Context
+	Handler PostMembersMemberIDRetryHandler
+}
+
+func (o *PostMembersMemberIDRetry) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostMembersMemberIDRetryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// PostMembersMemberIDRetryAcceptedBody post members member ID retry accepted body
+//
+// swagger:model PostMembersMemberIDRetryAcceptedBody
+type PostMembersMemberIDRetryAcceptedBody struct {
+
+	// member
+	Member *models.Member `json:"member,omitempty"`
+}
+
+// Validate validates this post members member ID retry accepted body
+func (o *PostMembersMemberIDRetryAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateMember(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostMembersMemberIDRetryAcceptedBody) validateMember(formats strfmt.Registry) error {
+	if swag.IsZero(o.Member) { // not required
+		return nil
+	}
+
+	if o.Member != nil {
+		if err := o.Member.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postMembersMemberIdRetryAccepted" + "." + "member")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postMembersMemberIdRetryAccepted" + "." + "member")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post members member ID retry accepted body based on the context it is used
+func (o *PostMembersMemberIDRetryAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateMember(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostMembersMemberIDRetryAcceptedBody) contextValidateMember(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Member != nil {
+		if err := o.Member.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postMembersMemberIdRetryAccepted" + "." + "member")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postMembersMemberIdRetryAccepted" + "." + "member")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostMembersMemberIDRetryAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostMembersMemberIDRetryAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostMembersMemberIDRetryAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,212 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package members
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostMembersMemberIDRetryAcceptedCode is the HTTP code returned for type PostMembersMemberIDRetryAccepted
+const PostMembersMemberIDRetryAcceptedCode int = 202
+
+/*
+PostMembersMemberIDRetryAccepted The member will be provisioned again.
+
+swagger:response postMembersMemberIdRetryAccepted
+*/
+type PostMembersMemberIDRetryAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *PostMembersMemberIDRetryAcceptedBody `json:"body,omitempty"`
+}
+
+// NewPostMembersMemberIDRetryAccepted creates PostMembersMemberIDRetryAccepted with default headers values
+func NewPostMembersMemberIDRetryAccepted() *PostMembersMemberIDRetryAccepted {
+
+	return &PostMembersMemberIDRetryAccepted{}
+}
+
+// WithPayload adds the payload to the post members member Id retry accepted response
+func (o *PostMembersMemberIDRetryAccepted) WithPayload(payload *PostMembersMemberIDRetryAcceptedBody) *PostMembersMemberIDRetryAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post members member Id retry accepted response
+func (o *PostMembersMemberIDRetryAccepted) SetPayload(payload *PostMembersMemberIDRetryAcceptedBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostMembersMemberIDRetryAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostMembersMemberIDRetryNotFoundCode is the HTTP code returned for type PostMembersMemberIDRetryNotFound
+const PostMembersMemberIDRetryNotFoundCode int = 404
+
+/*
+PostMembersMemberIDRetryNotFound Not Found
+
+swagger:response postMembersMemberIdRetryNotFound
+*/
+type PostMembersMemberIDRetryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostMembersMemberIDRetryNotFound creates PostMembersMemberIDRetryNotFound with default headers values
+func NewPostMembersMemberIDRetryNotFound() *PostMembersMemberIDRetryNotFound {
+
+	return &PostMembersMemberIDRetryNotFound{}
+}
+
+// WithPayload adds the payload to the post members member Id retry not found response
+func (o *PostMembersMemberIDRetryNotFound) WithPayload(payload *models.Error) *PostMembersMemberIDRetryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post members member Id retry not found response
+func (o *PostMembersMemberIDRetryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostMembersMemberIDRetryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostMembersMemberIDRetryConflictCode is the HTTP code returned for type PostMembersMemberIDRetryConflict
+const PostMembersMemberIDRetryConflictCode int = 409
+
+/*
+PostMembersMemberIDRetryConflict Conflict
+
+swagger:response postMembersMemberIdRetryConflict
+*/
+type PostMembersMemberIDRetryConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostMembersMemberIDRetryConflict creates PostMembersMemberIDRetryConflict with default headers values
+func NewPostMembersMemberIDRetryConflict() *PostMembersMemberIDRetryConflict {
+
+	return &PostMembersMemberIDRetryConflict{}
+}
+
+// WithPayload adds the payload to the post members member Id retry conflict response
+func (o *PostMembersMemberIDRetryConflict) WithPayload(payload *models.Error) *PostMembersMemberIDRetryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post members member Id retry conflict response
+func (o *PostMembersMemberIDRetryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostMembersMemberIDRetryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostMembersMemberIDRetryDefault Unexpected Error
+
+swagger:response postMembersMemberIdRetryDefault
+*/
+type PostMembersMemberIDRetryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostMembersMemberIDRetryDefault creates PostMembersMemberIDRetryDefault with default headers values
+func NewPostMembersMemberIDRetryDefault(code int) *PostMembersMemberIDRetryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostMembersMemberIDRetryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post members member ID retry default response
+func (o *PostMembersMemberIDRetryDefault) WithStatusCode(code int) *PostMembersMemberIDRetryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post members member ID retry default response
+func (o *PostMembersMemberIDRetryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post members member ID retry default response
+func (o *PostMembersMemberIDRetryDefault) WithPayload(payload *models.Error) *PostMembersMemberIDRetryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post members member ID retry default response
+func (o *PostMembersMemberIDRetryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostMembersMemberIDRetryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
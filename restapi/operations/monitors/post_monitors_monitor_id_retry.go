@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package monitors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostMonitorsMonitorIDRetryHandlerFunc turns a function with the right signature into a post monitors monitor ID retry handler
+type PostMonitorsMonitorIDRetryHandlerFunc func(PostMonitorsMonitorIDRetryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostMonitorsMonitorIDRetryHandlerFunc) Handle(params PostMonitorsMonitorIDRetryParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostMonitorsMonitorIDRetryHandler interface for that can handle valid post monitors monitor ID retry params
+type PostMonitorsMonitorIDRetryHandler interface {
+	Handle(PostMonitorsMonitorIDRetryParams) middleware.Responder
+}
+
+// NewPostMonitorsMonitorIDRetry creates a new http.Handler for the post monitors monitor ID retry operation
+func NewPostMonitorsMonitorIDRetry(ctx *middleware.Context, handler PostMonitorsMonitorIDRetryHandler) *PostMonitorsMonitorIDRetry {
+	return &PostMonitorsMonitorIDRetry{Context: ctx, Handler: handler}
+}
+
+/*
+	PostMonitorsMonitorIDRetry swagger:route POST /monitors/{monitor_id}/retry Monitors postMonitorsMonitorIdRetry
+
+# Retry provisioning of a monitor
+
+Moves a monitor with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.
+*/
+type PostMonitorsMonitorIDRetry struct {
+	Context *middleware.Context
+	Handler PostMonitorsMonitorIDRetryHandler
+}
+
+func (o *PostMonitorsMonitorIDRetry) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostMonitorsMonitorIDRetryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// PostMonitorsMonitorIDRetryAcceptedBody post monitors monitor ID retry accepted body
+//
+// swagger:model PostMonitorsMonitorIDRetryAcceptedBody
+type PostMonitorsMonitorIDRetryAcceptedBody struct {
+
+	// monitor
+	Monitor *models.Monitor `json:"monitor,omitempty"`
+}
+
+// Validate validates this post monitors monitor ID retry accepted body
+func (o *PostMonitorsMonitorIDRetryAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateMonitor(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostMonitorsMonitorIDRetryAcceptedBody) validateMonitor(formats strfmt.Registry) error {
+	if swag.IsZero(o.Monitor) { // not required
+		return nil
+	}
+
+	if o.Monitor != nil {
+		if err := o.Monitor.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postMonitorsMonitorIdRetryAccepted" + "." + "monitor")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postMonitorsMonitorIdRetryAccepted" + "." + "monitor")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post monitors monitor ID retry accepted body based on the context it is used
+func (o *PostMonitorsMonitorIDRetryAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateMonitor(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostMonitorsMonitorIDRetryAcceptedBody) contextValidateMonitor(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Monitor != nil {
+		if err := o.Monitor.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postMonitorsMonitorIdRetryAccepted" + "." + "monitor")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postMonitorsMonitorIdRetryAccepted" + "." + "monitor")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostMonitorsMonitorIDRetryAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostMonitorsMonitorIDRetryAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostMonitorsMonitorIDRetryAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package monitors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewPostMonitorsMonitorIDRetryParams creates a new PostMonitorsMonitorIDRetryParams object
+//
+// There are no default values defined in the spec.
+func NewPostMonitorsMonitorIDRetryParams() PostMonitorsMonitorIDRetryParams {
+
+	return PostMonitorsMonitorIDRetryParams{}
+}
+
+// PostMonitorsMonitorIDRetryParams contains all the bound params for the post monitors monitor ID retry operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters PostMonitorsMonitorIDRetry
+type PostMonitorsMonitorIDRetryParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The UUID of the monitor
+	  Required: true
+	  In: path
+	*/
+	MonitorID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPostMonitorsMonitorIDRetryParams() beforehand.
+func (o *PostMonitorsMonitorIDRetryParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rMonitorID, rhkMonitorID, _ := route.Params.GetOK("monitor_id")
+	if err := o.bindMonitorID(rMonitorID, rhkMonitorID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindMonitorID binds and validates parameter MonitorID from path.
+func (o *PostMonitorsMonitorIDRetryParams) bindMonitorID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("monitor_id", "path", "strfmt.UUID", raw)
+	}
+	o.MonitorID = *(value.(*strfmt.UUID))
+
+	if err := o.validateMonitorID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMonitorID carries on validations for parameter MonitorID
+func (o *PostMonitorsMonitorIDRetryParams) validateMonitorID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("monitor_id", "path", "uuid", o.MonitorID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package monitors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostMonitorsMonitorIDRetryAcceptedCode is the HTTP code returned for type PostMonitorsMonitorIDRetryAccepted
+const PostMonitorsMonitorIDRetryAcceptedCode int = 202
+
+/*
+PostMonitorsMonitorIDRetryAccepted The monitor will be provisioned again.
+
+swagger:response postMonitorsMonitorIdRetryAccepted
+*/
+type PostMonitorsMonitorIDRetryAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *PostMonitorsMonitorIDRetryAcceptedBody `json:"body,omitempty"`
+}
+
+// NewPostMonitorsMonitorIDRetryAccepted creates PostMonitorsMonitorIDRetryAccepted with default headers values
+func NewPostMonitorsMonitorIDRetryAccepted() *PostMonitorsMonitorIDRetryAccepted {
+
+	return &PostMonitorsMonitorIDRetryAccepted{}
+}
+
+// WithPayload adds the payload to the post monitors monitor Id retry accepted response
+func (o *PostMonitorsMonitorIDRetryAccepted) WithPayload(payload *PostMonitorsMonitorIDRetryAcceptedBody) *PostMonitorsMonitorIDRetryAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post monitors monitor Id retry accepted response
+func (o *PostMonitorsMonitorIDRetryAccepted) SetPayload(payload *PostMonitorsMonitorIDRetryAcceptedBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostMonitorsMonitorIDRetryAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostMonitorsMonitorIDRetryNotFoundCode is the HTTP code returned for type PostMonitorsMonitorIDRetryNotFound
+const PostMonitorsMonitorIDRetryNotFoundCode int = 404
+
+/*
+PostMonitorsMonitorIDRetryNotFound Not Found
+
+swagger:response postMonitorsMonitorIdRetryNotFound
+*/
+type PostMonitorsMonitorIDRetryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostMonitorsMonitorIDRetryNotFound creates PostMonitorsMonitorIDRetryNotFound with default headers values
+func NewPostMonitorsMonitorIDRetryNotFound() *PostMonitorsMonitorIDRetryNotFound {
+
+	return &PostMonitorsMonitorIDRetryNotFound{}
+}
+
+// WithPayload adds the payload to the post monitors monitor Id retry not found response
+func (o *PostMonitorsMonitorIDRetryNotFound) WithPayload(payload *models.Error) *PostMonitorsMonitorIDRetryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post monitors monitor Id retry not found response
+func (o *PostMonitorsMonitorIDRetryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostMonitorsMonitorIDRetryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostMonitorsMonitorIDRetryConflictCode is the HTTP code returned for type PostMonitorsMonitorIDRetryConflict
+const PostMonitorsMonitorIDRetryConflictCode int = 409
+
+/*
+PostMonitorsMonitorIDRetryConflict Conflict
+
+swagger:response postMonitorsMonitorIdRetryConflict
+*/
+type PostMonitorsMonitorIDRetryConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostMonitorsMonitorIDRetryConflict creates PostMonitorsMonitorIDRetryConflict with default headers values
+func NewPostMonitorsMonitorIDRetryConflict() *PostMonitorsMonitorIDRetryConflict {
+
+	return &PostMonitorsMonitorIDRetryConflict{}
+}
+
+// WithPayload adds the payload to the post monitors monitor Id retry conflict response
+func (o *PostMonitorsMonitorIDRetryConflict) WithPayload(payload *models.Error) *PostMonitorsMonitorIDRetryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post monitors monitor Id retry conflict response
+func (o *PostMonitorsMonitorIDRetryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostMonitorsMonitorIDRetryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostMonitorsMonitorIDRetryDefault Unexpected Error
+
+swagger:response postMonitorsMonitorIdRetryDefault
+*/
+type PostMonitorsMonitorIDRetryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostMonitorsMonitorIDRetryDefault creates PostMonitorsMonitorIDRetryDefault with default headers values
+func NewPostMonitorsMonitorIDRetryDefault(code int) *PostMonitorsMonitorIDRetryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostMonitorsMonitorIDRetryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post monitors monitor ID retry default response
+func (o *PostMonitorsMonitorIDRetryDefault) WithStatusCode(code int) *PostMonitorsMonitorIDRetryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post monitors monitor ID retry default response
+func (o *PostMonitorsMonitorIDRetryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post monitors monitor ID retry default response
+func (o *PostMonitorsMonitorIDRetryDefault) WithPayload(payload *models.Error) *PostMonitorsMonitorIDRetryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post monitors monitor ID retry default response
+func (o *PostMonitorsMonitorIDRetryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostMonitorsMonitorIDRetryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pools
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostPoolsPoolIDRetryHandlerFunc turns a function with the right signature into a post pools pool ID retry handler
+type PostPoolsPoolIDRetryHandlerFunc func(PostPoolsPoolIDRetryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostPoolsPoolIDRetryHandlerFunc) Handle(params PostPoolsPoolIDRetryParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostPoolsPoolIDRetryHandler interface for that can handle valid post pools pool ID retry params
+type PostPoolsPoolIDRetryHandler interface {
+	Handle(PostPoolsPoolIDRetryParams) middleware.Responder
+}
+
+// NewPostPoolsPoolIDRetry creates a new http.Handler for the post pools pool ID retry operation
+func NewPostPoolsPoolIDRetry(ctx *middleware.Context, handler PostPoolsPoolIDRetryHandler) *PostPoolsPoolIDRetry {
+	return &PostPoolsPoolIDRetry{Context: ctx, Handler: handler}
+}
+
+/*
+	PostPoolsPoolIDRetry swagger:route POST /pools/{pool_id}/retry Pools postPoolsPoolIdRetry
+
+# Retry provisioning of a pool
+
+Moves a pool with provisioning status ERROR back to PENDING_UPDATE, so that it is provisioned again.
+*/
+type PostPoolsPoolIDRetry struct {
+	Context *middleware.Context
+	Handler PostPoolsPoolIDRetryHandler
+}
+
+func (o *PostPoolsPoolIDRetry) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostPoolsPoolIDRetryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// PostPoolsPoolIDRetryAcceptedBody post pools pool ID retry accepted body
+//
+// swagger:model PostPoolsPoolIDRetryAcceptedBody
+type PostPoolsPoolIDRetryAcceptedBody struct {
+
+	// pool
+	Pool *models.Pool `json:"pool,omitempty"`
+}
+
+// Validate validates this post pools pool ID retry accepted body
+func (o *PostPoolsPoolIDRetryAcceptedBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validatePool(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostPoolsPoolIDRetryAcceptedBody) validatePool(formats strfmt.Registry) error {
+	if swag.IsZero(o.Pool) { // not required
+		return nil
+	}
+
+	if o.Pool != nil {
+		if err := o.Pool.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postPoolsPoolIdRetryAccepted" + "." + "pool")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postPoolsPoolIdRetryAccepted" + "." + "pool")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post pools pool ID retry accepted body based on the context it is used
+func (o *PostPoolsPoolIDRetryAcceptedBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidatePool(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostPoolsPoolIDRetryAcceptedBody) contextValidatePool(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Pool != nil {
+		if err := o.Pool.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("postPoolsPoolIdRetryAccepted" + "." + "pool")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("postPoolsPoolIdRetryAccepted" + "." + "pool")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PostPoolsPoolIDRetryAcceptedBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PostPoolsPoolIDRetryAcceptedBody) UnmarshalBinary(b []byte) error {
+	var res PostPoolsPoolIDRetryAcceptedBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pools
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewPostPoolsPoolIDRetryParams creates a new PostPoolsPoolIDRetryParams object
+//
+// There are no default values defined in the spec.
+func NewPostPoolsPoolIDRetryParams() PostPoolsPoolIDRetryParams {
+
+	return PostPoolsPoolIDRetryParams{}
+}
+
+// PostPoolsPoolIDRetryParams contains all the bound params for the post pools pool ID retry operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters PostPoolsPoolIDRetry
+type PostPoolsPoolIDRetryParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The UUID of the pool
+	  Required: true
+	  In: path
+	*/
+	PoolID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPostPoolsPoolIDRetryParams() beforehand.
+func (o *PostPoolsPoolIDRetryParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rPoolID, rhkPoolID, _ := route.Params.GetOK("pool_id")
+	if err := o.bindPoolID(rPoolID, rhkPoolID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindPoolID binds and validates parameter PoolID from path.
+func (o *PostPoolsPoolIDRetryParams) bindPoolID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("pool_id", "path", "strfmt.UUID", raw)
+	}
+	o.PoolID = *(value.(*strfmt.UUID))
+
+	if err := o.validatePoolID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePoolID carries on validations for parameter PoolID
+func (o *PostPoolsPoolIDRetryParams) validatePoolID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("pool_id", "path", "uuid", o.PoolID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
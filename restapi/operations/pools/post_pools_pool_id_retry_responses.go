@@ -0,0 +1,212 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// SPDX-FileCopyrightText: Copyright 2025 SAP SE or an SAP affiliate company
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pools
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/andromeda/models"
+)
+
+// PostPoolsPoolIDRetryAcceptedCode is the HTTP code returned for type PostPoolsPoolIDRetryAccepted
+const PostPoolsPoolIDRetryAcceptedCode int = 202
+
+/*
+PostPoolsPoolIDRetryAccepted The pool will be provisioned again.
+
+swagger:response postPoolsPoolIdRetryAccepted
+*/
+type PostPoolsPoolIDRetryAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *PostPoolsPoolIDRetryAcceptedBody `json:"body,omitempty"`
+}
+
+// NewPostPoolsPoolIDRetryAccepted creates PostPoolsPoolIDRetryAccepted with default headers values
+func NewPostPoolsPoolIDRetryAccepted() *PostPoolsPoolIDRetryAccepted {
+
+	return &PostPoolsPoolIDRetryAccepted{}
+}
+
+// WithPayload adds the payload to the post pools pool Id retry accepted response
+func (o *PostPoolsPoolIDRetryAccepted) WithPayload(payload *PostPoolsPoolIDRetryAcceptedBody) *PostPoolsPoolIDRetryAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post pools pool Id retry accepted response
+func (o *PostPoolsPoolIDRetryAccepted) SetPayload(payload *PostPoolsPoolIDRetryAcceptedBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostPoolsPoolIDRetryAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostPoolsPoolIDRetryNotFoundCode is the HTTP code returned for type PostPoolsPoolIDRetryNotFound
+const PostPoolsPoolIDRetryNotFoundCode int = 404
+
+/*
+PostPoolsPoolIDRetryNotFound Not Found
+
+swagger:response postPoolsPoolIdRetryNotFound
+*/
+type PostPoolsPoolIDRetryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostPoolsPoolIDRetryNotFound creates PostPoolsPoolIDRetryNotFound with default headers values
+func NewPostPoolsPoolIDRetryNotFound() *PostPoolsPoolIDRetryNotFound {
+
+	return &PostPoolsPoolIDRetryNotFound{}
+}
+
+// WithPayload adds the payload to the post pools pool Id retry not found response
+func (o *PostPoolsPoolIDRetryNotFound) WithPayload(payload *models.Error) *PostPoolsPoolIDRetryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post pools pool Id retry not found response
+func (o *PostPoolsPoolIDRetryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostPoolsPoolIDRetryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostPoolsPoolIDRetryConflictCode is the HTTP code returned for type PostPoolsPoolIDRetryConflict
+const PostPoolsPoolIDRetryConflictCode int = 409
+
+/*
+PostPoolsPoolIDRetryConflict Conflict
+
+swagger:response postPoolsPoolIdRetryConflict
+*/
+type PostPoolsPoolIDRetryConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostPoolsPoolIDRetryConflict creates PostPoolsPoolIDRetryConflict with default headers values
+func NewPostPoolsPoolIDRetryConflict() *PostPoolsPoolIDRetryConflict {
+
+	return &PostPoolsPoolIDRetryConflict{}
+}
+
+// WithPayload adds the payload to the post pools pool Id retry conflict response
+func (o *PostPoolsPoolIDRetryConflict) WithPayload(payload *models.Error) *PostPoolsPoolIDRetryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post pools pool Id retry conflict response
+func (o *PostPoolsPoolIDRetryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostPoolsPoolIDRetryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostPoolsPoolIDRetryDefault Unexpected Error
+
+swagger:response postPoolsPoolIdRetryDefault
+*/
+type PostPoolsPoolIDRetryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostPoolsPoolIDRetryDefault creates PostPoolsPoolIDRetryDefault with default headers values
+func NewPostPoolsPoolIDRetryDefault(code int) *PostPoolsPoolIDRetryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostPoolsPoolIDRetryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post pools pool ID retry default response
+func (o *PostPoolsPoolIDRetryDefault) WithStatusCode(code int) *PostPoolsPoolIDRetryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post pools pool ID retry default response
+func (o *PostPoolsPoolIDRetryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post pools pool ID retry default response
+func (o *PostPoolsPoolIDRetryDefault) WithPayload(payload *models.Error) *PostPoolsPoolIDRetryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post pools pool ID retry default response
+func (o *PostPoolsPoolIDRetryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostPoolsPoolIDRetryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}